@@ -24,6 +24,12 @@ func main() {
 		command.NewUpdateCommand(),
 		command.NewProceedUpdateCommand(),
 		command.NewCancelUpdateCommand(),
+		command.NewPauseUpdateCommand(),
+		command.NewResumeUpdateCommand(),
+		command.NewRollbackCommand(),
+		command.NewCanaryCommand(),
+		command.NewPromoteCanaryCommand(),
+		command.NewAbortCanaryCommand(),
 	}
 
 	if err := swan.Run(os.Args); err != nil {
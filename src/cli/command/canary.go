@@ -0,0 +1,119 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+// NewCanaryCommand implement the CLI command for "canary"
+func NewCanaryCommand() cli.Command {
+	return cli.Command{
+		Name:      "canary",
+		Usage:     "Run canary instances of a new app version",
+		ArgsUsage: "[name]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "version",
+				Usage: "version to run canary instances with",
+			},
+			cli.IntFlag{
+				Name:  "instances",
+				Value: 1,
+				Usage: "canary instances",
+			},
+			cli.Float64Flag{
+				Name:  "weight",
+				Usage: "percentage of traffic sent to canary instances",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if err := canaryApp(c); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+}
+
+func NewPromoteCanaryCommand() cli.Command {
+	return cli.Command{
+		Name:      "canary-promote",
+		Usage:     "Promote canary version to all instances",
+		ArgsUsage: "[name]",
+		Action: func(c *cli.Context) error {
+			if err := patchCanary(c, "promote-canary"); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+}
+
+func NewAbortCanaryCommand() cli.Command {
+	return cli.Command{
+		Name:      "canary-abort",
+		Usage:     "Abort canary and roll back canary instances",
+		ArgsUsage: "[name]",
+		Action: func(c *cli.Context) error {
+			if err := patchCanary(c, "abort-canary"); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+}
+
+func canaryApp(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("App ID required")
+	}
+
+	if c.String("version") == "" {
+		return fmt.Errorf("Version must be specified. --version")
+	}
+
+	file, err := ioutil.ReadFile(c.String("version"))
+	if err != nil {
+		return fmt.Errorf("Read json file failed: %s", err.Error())
+	}
+
+	var data struct {
+		Version   json.RawMessage `json:"version"`
+		Instances int             `json:"instances"`
+		Weight    float64         `json:"weight"`
+	}
+
+	data.Version = file
+	data.Instances = c.Int("instances")
+	data.Weight = c.Float64("weight")
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("Marshal error: %s", err.Error())
+	}
+
+	httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/canary", c.Args()[0]))
+	_, err = httpClient.Post(payload)
+	if err != nil {
+		return fmt.Errorf("Unable to do request: %s", err.Error())
+	}
+
+	return nil
+}
+
+func patchCanary(c *cli.Context, action string) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("App ID required")
+	}
+
+	httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/%s", c.Args()[0], action))
+	_, err := httpClient.Patch(nil)
+	if err != nil {
+		return fmt.Errorf("Unable to do request: %s", err.Error())
+	}
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/Dataman-Cloud/swan/src/manager/framework/api"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 	"os"
+	"strings"
 )
 
 // NewInspectCommand returns the CLI command for "show"
@@ -24,6 +26,10 @@ func NewInspectCommand() cli.Command {
 				Name:  "history",
 				Usage: "List task histories",
 			},
+			cli.BoolFlag{
+				Name:  "placement",
+				Usage: "Show why tasks pending for offers are not launched",
+			},
 		},
 
 		Action: func(c *cli.Context) error {
@@ -58,6 +64,10 @@ func inspectApplication(c *cli.Context) error {
 		return err
 	}
 
+	if c.IsSet("placement") {
+		return inspectPlacements(c.Args()[0], app.Tasks, c.IsSet("json"))
+	}
+
 	if c.IsSet("json") {
 		fmt.Fprintln(os.Stdout, string(data))
 	} else {
@@ -67,6 +77,79 @@ func inspectApplication(c *cli.Context) error {
 	return nil
 }
 
+// inspectPlacements shows placement diagnosis of the tasks pending for offers.
+func inspectPlacements(appId string, tasks []*api.Task, asJson bool) error {
+	placements := make([]*api.Placement, 0)
+	for _, task := range tasks {
+		if task.Status != state.SLOT_STATE_PENDING_OFFER {
+			continue
+		}
+
+		index := strings.SplitN(task.ID, "-", 2)[0]
+		httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/tasks/%s/placement", appId, index))
+		resp, err := httpClient.Get()
+		if err != nil {
+			return fmt.Errorf("Unable to do request: %s", err.Error())
+		}
+
+		var placement *api.Placement
+		err = json.NewDecoder(resp.Body).Decode(&placement)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		placements = append(placements, placement)
+	}
+
+	if asJson {
+		data, err := json.Marshal(placements)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(data))
+		return nil
+	}
+
+	printPlacementTable(placements)
+	return nil
+}
+
+// printPlacementTable output rejections of pending tasks as table format.
+func printPlacementTable(placements []*api.Placement) {
+	tb := tablewriter.NewWriter(os.Stdout)
+	tb.SetHeader([]string{
+		"Name",
+		"CONSIDERED",
+		"MATCHED",
+		"REASON",
+		"AGENTS",
+	})
+	for _, placement := range placements {
+		if len(placement.Rejections) == 0 {
+			tb.Append([]string{
+				placement.TaskId,
+				fmt.Sprintf("%d", placement.Considered),
+				fmt.Sprintf("%d", placement.Matched),
+				"",
+				"",
+			})
+		}
+
+		for _, rejection := range placement.Rejections {
+			tb.Append([]string{
+				placement.TaskId,
+				fmt.Sprintf("%d", placement.Considered),
+				fmt.Sprintf("%d", placement.Matched),
+				rejection.Reason,
+				strings.Join(rejection.Agents, ","),
+			})
+		}
+	}
+	tb.Render()
+}
+
 // printTable output tasks list as table format.
 func printTaskTable(tasks []*api.Task) {
 	tb := tablewriter.NewWriter(os.Stdout)
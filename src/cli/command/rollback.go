@@ -0,0 +1,52 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+// NewRollbackCommand implement the CLI command for "rollback"
+func NewRollbackCommand() cli.Command {
+	return cli.Command{
+		Name:      "rollback",
+		Usage:     "Rollback app to a historical version",
+		ArgsUsage: "[name]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "version",
+				Value: "previous",
+				Usage: "version id to rollback to, previous version by default",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			if err := rollbackApp(c); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+}
+
+func rollbackApp(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("App ID required")
+	}
+
+	var data struct {
+		Version string `json:"version"`
+	}
+
+	data.Version = c.String("version")
+	payload, _ := json.Marshal(data)
+
+	httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/rollback", c.Args()[0]))
+	_, err := httpClient.Post(payload)
+	if err != nil {
+		return fmt.Errorf("Unable to do request: %s", err.Error())
+	}
+
+	return nil
+}
@@ -66,6 +66,36 @@ func NewCancelUpdateCommand() cli.Command {
 
 }
 
+func NewPauseUpdateCommand() cli.Command {
+	return cli.Command{
+		Name:      "update-pause",
+		Usage:     "Pause unattended app update",
+		ArgsUsage: "[name]",
+		Action: func(c *cli.Context) error {
+			if err := pauseUpdateApp(c); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+
+}
+
+func NewResumeUpdateCommand() cli.Command {
+	return cli.Command{
+		Name:      "update-resume",
+		Usage:     "Resume unattended app update",
+		ArgsUsage: "[name]",
+		Action: func(c *cli.Context) error {
+			if err := resumeUpdateApp(c); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+			}
+			return nil
+		},
+	}
+
+}
+
 func updateApp(c *cli.Context) error {
 	if len(c.Args()) == 0 {
 		return fmt.Errorf("App ID required")
@@ -133,3 +163,31 @@ func proceedUpdateApp(c *cli.Context) error {
 
 	return nil
 }
+
+func pauseUpdateApp(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("App ID required")
+	}
+
+	httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/pause-update", c.Args()[0]))
+	_, err := httpClient.Patch(nil)
+	if err != nil {
+		return fmt.Errorf("Unable to do request: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resumeUpdateApp(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("App ID required")
+	}
+
+	httpClient := NewHTTPClient(fmt.Sprintf("/apps/%s/resume-update", c.Args()[0]))
+	_, err := httpClient.Patch(nil)
+	if err != nil {
+		return fmt.Errorf("Unable to do request: %s", err.Error())
+	}
+
+	return nil
+}
@@ -33,7 +33,22 @@ type Scheduler struct {
 	MesosFrameworkUser     string `json:"mesos-framwork-user"`
 	Hostname               string `json:"hostname"`
 	EnableLocalHealthcheck bool   `json:"local-healthcheck"`
+	PlacementStrategy      string `json:"placement-strategy"`       // binpack, spread or first-fit
+	UnreachableGracePeriod int    `json:"unreachable-grace-period"` // seconds before replacing unreachable tasks
 	UnixAddr               string
+
+	// seconds mesos holds back resources of offers declined, while slots
+	// pending or not, 0 for the defaults
+	OfferRefuseSeconds     float64 `json:"offer-refuse-seconds"`
+	IdleOfferRefuseSeconds float64 `json:"idle-offer-refuse-seconds"`
+
+	// mesos roles swan registers with as a multi-role framework, RunAs of
+	// apps should be one of them. registers with no role if empty
+	MesosRoles []string `json:"mesos-roles"`
+
+	// where autoscalers read task statistics and prometheus queries from
+	MesosAgentPort int    `json:"mesos-agent-port"` // 5051 by default
+	PrometheusUrl  string `json:"prometheus-url"`
 }
 
 type DNS struct {
@@ -61,5 +61,9 @@ func (subscriber *DNSSubscriber) InterestIn(e *Event) bool {
 		return true
 	}
 
+	if e.Type == EventTypeTaskUnhealthy {
+		return true
+	}
+
 	return false
 }
@@ -3,6 +3,13 @@ package event
 const (
 	EventTypeTaskAdd = "task_add"
 	EventTypeTaskRm  = "task_rm"
+	// task still running but failing health checks, out of service until healthy again
+	EventTypeTaskUnhealthy = "task_unhealthy"
+
+	EventTypeOfferRescinded = "offer_rescinded"
+	EventTypeAppRollback    = "app_rollback"
+	EventTypeAppCanary      = "app_canary"
+	EventTypeAppAutoscale   = "app_autoscale"
 )
 
 type Event struct {
@@ -18,8 +25,44 @@ func NewEvent(t string, payload interface{}) *Event {
 }
 
 type TaskInfo struct {
-	Ip     string
-	TaskId string
-	Port   string
-	Type   string // a or srv
+	Ip        string
+	TaskId    string
+	Port      string
+	Type      string // a or srv
+	AppId     string
+	VersionId string
+}
+
+// slot relaunched because the offer it was launched with got rescinded
+type OfferRescindInfo struct {
+	OfferId string
+	SlotId  string
+	TaskId  string
+}
+
+// rolling update cancelled automatically as tasks of new version keep failing
+type AppRollbackInfo struct {
+	AppId       string
+	FromVersion string
+	ToVersion   string
+	Reason      string
+}
+
+// share of traffic the canary version of app takes, no version if app not in canary
+type AppCanaryInfo struct {
+	AppId     string
+	VersionId string
+	Weight    float64 // percentage
+}
+
+// instances of app the autoscaler decided on, not applied if scaling failed
+type AppAutoscaleInfo struct {
+	AppId         string
+	Metric        string
+	Value         float64 // per instance
+	Target        float64
+	FromInstances int32
+	ToInstances   int32
+	Applied       bool
+	Reason        string // why not applied
 }
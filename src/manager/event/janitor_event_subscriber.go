@@ -10,12 +10,21 @@ import (
 type JanitorSubscriber struct {
 	Key      string
 	Resolver *janitor.JanitorServer
+
+	// running tasks by target of each app, to weight them when app in canary,
+	// and the weight last sent for each target
+	tasks    map[string]map[string]*TaskInfo
+	weights  map[string]map[string]float64
+	canaries map[string]*AppCanaryInfo
 }
 
 func NewJanitorSubscriber(resolver *janitor.JanitorServer) *JanitorSubscriber {
 	janitorSubscriber := &JanitorSubscriber{
 		Resolver: resolver,
 		Key:      "janitor",
+		tasks:    make(map[string]map[string]*TaskInfo),
+		weights:  make(map[string]map[string]float64),
+		canaries: make(map[string]*AppCanaryInfo),
 	}
 	return janitorSubscriber
 }
@@ -31,23 +40,50 @@ func (js *JanitorSubscriber) Unsubscribe(bus *EventBus) error {
 }
 
 func (js *JanitorSubscriber) Write(e *Event) error {
+	if e.Type == EventTypeAppCanary {
+		canary, ok := e.Payload.(*AppCanaryInfo)
+		if !ok {
+			return errors.New("payload type error")
+		}
+
+		if len(canary.VersionId) == 0 {
+			delete(js.canaries, canary.AppId)
+		} else {
+			js.canaries[canary.AppId] = canary
+		}
+
+		js.reweight(canary.AppId)
+		return nil
+	}
+
 	payload, ok := e.Payload.(*TaskInfo)
 	if !ok {
 		return errors.New("payload type error")
 	}
 
-	rgevent := &upstream.TargetChangeEvent{}
+	if _, found := js.tasks[payload.AppId]; !found {
+		js.tasks[payload.AppId] = make(map[string]*TaskInfo)
+		js.weights[payload.AppId] = make(map[string]float64)
+	}
+
+	// added again, send it whatever its weight
+	target := targetKey(payload)
+	delete(js.weights[payload.AppId], target)
+
 	if e.Type == EventTypeTaskAdd {
-		rgevent.Change = "add"
-	} else {
-		rgevent.Change = "del"
+		js.tasks[payload.AppId][target] = payload
+		js.reweight(payload.AppId)
+		return nil
 	}
 
-	rgevent.TargetIP = payload.Ip
-	rgevent.TargetPort = payload.Port
-	rgevent.TargetName = payload.TaskId
+	delete(js.tasks[payload.AppId], target)
+	js.send("del", payload, 0)
+	js.reweight(payload.AppId)
 
-	js.Resolver.SwanEventChan() <- rgevent
+	if len(js.tasks[payload.AppId]) == 0 {
+		delete(js.tasks, payload.AppId)
+		delete(js.weights, payload.AppId)
+	}
 	return nil
 }
 
@@ -60,5 +96,82 @@ func (js *JanitorSubscriber) InterestIn(e *Event) bool {
 		return true
 	}
 
+	if e.Type == EventTypeTaskUnhealthy {
+		return true
+	}
+
+	if e.Type == EventTypeAppCanary {
+		return true
+	}
+
 	return false
 }
+
+// send tasks of the app whose weight changed, or not sent yet
+func (js *JanitorSubscriber) reweight(appId string) {
+	for target, weight := range js.changedWeights(appId) {
+		js.send("add", js.tasks[appId][target], weight)
+	}
+}
+
+// weights of the targets of the app that differ from those last sent, which
+// are then recorded as sent
+func (js *JanitorSubscriber) changedWeights(appId string) map[string]float64 {
+	changed := make(map[string]float64)
+	if _, found := js.tasks[appId]; !found {
+		return changed
+	}
+
+	weights := TaskWeights(js.tasks[appId], js.canaries[appId])
+	for target := range js.tasks[appId] {
+		if sent, found := js.weights[appId][target]; !found || sent != weights[target] {
+			changed[target] = weights[target]
+			js.weights[appId][target] = weights[target]
+		}
+	}
+
+	return changed
+}
+
+// a task has a target for each of its ports, all with the same task id
+func targetKey(task *TaskInfo) string {
+	return task.TaskId + ":" + task.Port
+}
+
+func (js *JanitorSubscriber) send(change string, task *TaskInfo, weight float64) {
+	js.Resolver.SwanEventChan() <- &upstream.TargetChangeEvent{
+		Change:       change,
+		TargetIP:     task.Ip,
+		TargetPort:   task.Port,
+		TargetName:   task.TaskId,
+		TargetWeight: weight,
+	}
+}
+
+// TaskWeights splits canary weight evenly among targets of the canary version
+// and the rest among the other targets, zero weights if app not in canary.
+func TaskWeights(tasks map[string]*TaskInfo, canary *AppCanaryInfo) map[string]float64 {
+	weights := make(map[string]float64)
+	if canary == nil {
+		return weights
+	}
+
+	canaryTasks, otherTasks := 0, 0
+	for _, task := range tasks {
+		if task.VersionId == canary.VersionId {
+			canaryTasks += 1
+		} else {
+			otherTasks += 1
+		}
+	}
+
+	for target, task := range tasks {
+		if task.VersionId == canary.VersionId {
+			weights[target] = canary.Weight / float64(canaryTasks)
+		} else {
+			weights[target] = (100 - canary.Weight) / float64(otherTasks)
+		}
+	}
+
+	return weights
+}
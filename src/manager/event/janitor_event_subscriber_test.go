@@ -0,0 +1,55 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskWeights(t *testing.T) {
+	tasks := map[string]*TaskInfo{
+		"0.app": {TaskId: "0.app", VersionId: "2"},
+		"1.app": {TaskId: "1.app", VersionId: "1"},
+		"2.app": {TaskId: "2.app", VersionId: "1"},
+		"3.app": {TaskId: "3.app", VersionId: "1"},
+		"4.app": {TaskId: "4.app", VersionId: "1"},
+	}
+
+	assert.Len(t, TaskWeights(tasks, nil), 0)
+
+	weights := TaskWeights(tasks, &AppCanaryInfo{AppId: "app", VersionId: "2", Weight: 20})
+	assert.Equal(t, 20.0, weights["0.app"])
+	for _, taskId := range []string{"1.app", "2.app", "3.app", "4.app"} {
+		assert.Equal(t, 20.0, weights[taskId])
+	}
+
+	weights = TaskWeights(tasks, &AppCanaryInfo{AppId: "app", VersionId: "2", Weight: 100})
+	assert.Equal(t, 100.0, weights["0.app"])
+	assert.Equal(t, 0.0, weights["1.app"])
+}
+
+func TestChangedWeights(t *testing.T) {
+	js := NewJanitorSubscriber(nil)
+	js.tasks["app"] = make(map[string]*TaskInfo)
+	js.weights["app"] = make(map[string]float64)
+	for _, task := range []*TaskInfo{
+		{TaskId: "0.app", Port: "31000", VersionId: "1"},
+		{TaskId: "0.app", Port: "31001", VersionId: "1"},
+		{TaskId: "1.app", Port: "31002", VersionId: "1"},
+	} {
+		js.tasks["app"][targetKey(task)] = task
+	}
+
+	// each port of a task is a target
+	assert.Len(t, js.changedWeights("app"), 3)
+
+	// weights unchanged out of canary, nothing resent
+	added := &TaskInfo{TaskId: "2.app", Port: "31003", VersionId: "1"}
+	js.tasks["app"][targetKey(added)] = added
+	assert.Equal(t, map[string]float64{"2.app:31003": 0}, js.changedWeights("app"))
+
+	js.canaries["app"] = &AppCanaryInfo{AppId: "app", VersionId: "2", Weight: 40}
+	added.VersionId = "2"
+	assert.Len(t, js.changedWeights("app"), 4)
+	assert.Len(t, js.changedWeights("app"), 0)
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+type AutoscalerService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallAutoscalerService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *AutoscalerService {
+	autoscalerService := &AutoscalerService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, autoscalerService)
+	return autoscalerService
+}
+
+func (api *AutoscalerService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/autoscalers").
+		Doc("Autoscale policies of apps").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "Autoscalers", api.ListAutoscalers)).
+		// docs
+		Doc("List Autoscalers").
+		Operation("listAutoscalers").
+		Returns(200, "OK", []Autoscaler{}))
+	ws.Route(ws.POST("/").To(metrics.InstrumentRouteFunc("POST", "Autoscaler", api.CreateAutoscaler)).
+		// docs
+		Doc("Create Autoscaler of an app").
+		Operation("createAutoscaler").
+		Returns(201, "OK", Autoscaler{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.AutoscalePolicy{}).
+		Writes(Autoscaler{}))
+	ws.Route(ws.GET("/{app_id}").To(metrics.InstrumentRouteFunc("GET", "Autoscaler", api.GetAutoscaler)).
+		// docs
+		Doc("Get the Autoscaler of an app along with its recent decisions").
+		Operation("getAutoscaler").
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")).
+		Returns(200, "OK", Autoscaler{}).
+		Returns(404, "NotFound", nil))
+	ws.Route(ws.PUT("/{app_id}").To(metrics.InstrumentRouteFunc("PUT", "Autoscaler", api.UpdateAutoscaler)).
+		// docs
+		Doc("Update Autoscaler of an app").
+		Operation("updateAutoscaler").
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")).
+		Returns(200, "OK", Autoscaler{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.AutoscalePolicy{}).
+		Writes(Autoscaler{}))
+	ws.Route(ws.DELETE("/{app_id}").To(metrics.InstrumentRouteFunc("DELETE", "Autoscaler", api.DeleteAutoscaler)).
+		// docs
+		Doc("Delete Autoscaler of an app, instances are left as they are").
+		Operation("deleteAutoscaler").
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")).
+		Returns(204, "OK", nil).
+		Returns(404, "NotFound", nil))
+
+	container.Add(ws)
+}
+
+func (api *AutoscalerService) CreateAutoscaler(request *restful.Request, response *restful.Response) {
+	var policy types.AutoscalePolicy
+
+	if err := request.ReadEntity(&policy); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	autoscaler, err := api.Scheduler.CreateAutoscaler(&policy)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, autoscalerFromState(autoscaler))
+}
+
+func (api *AutoscalerService) ListAutoscalers(request *restful.Request, response *restful.Response) {
+	autoscalers := make([]*Autoscaler, 0)
+	for _, autoscaler := range api.Scheduler.ListAutoscalers() {
+		autoscalers = append(autoscalers, autoscalerFromState(autoscaler))
+	}
+
+	response.WriteEntity(autoscalers)
+}
+
+func (api *AutoscalerService) GetAutoscaler(request *restful.Request, response *restful.Response) {
+	autoscaler, err := api.Scheduler.InspectAutoscaler(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteEntity(autoscalerFromState(autoscaler))
+}
+
+func (api *AutoscalerService) UpdateAutoscaler(request *restful.Request, response *restful.Response) {
+	var policy types.AutoscalePolicy
+
+	if err := request.ReadEntity(&policy); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+	policy.AppId = request.PathParameter("app_id")
+
+	autoscaler, err := api.Scheduler.UpdateAutoscaler(&policy)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteEntity(autoscalerFromState(autoscaler))
+}
+
+func (api *AutoscalerService) DeleteAutoscaler(request *restful.Request, response *restful.Response) {
+	if err := api.Scheduler.DeleteAutoscaler(request.PathParameter("app_id")); err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func autoscalerFromState(autoscaler *state.Autoscaler) *Autoscaler {
+	spec := autoscaler.Spec
+	result := &Autoscaler{
+		AppId:                    autoscaler.AppId,
+		MinInstances:             spec.MinInstances,
+		MaxInstances:             spec.MaxInstances,
+		Metric:                   spec.Metric,
+		Target:                   spec.Target,
+		Query:                    spec.Query,
+		IntervalSeconds:          spec.IntervalSeconds,
+		ScaleUpCooldownSeconds:   spec.ScaleUpCooldownSeconds,
+		ScaleDownCooldownSeconds: spec.ScaleDownCooldownSeconds,
+		Created:                  autoscaler.Created,
+		LastScaled:               autoscaler.LastScaled,
+		Decisions:                make([]*AutoscaleDecision, 0),
+	}
+
+	for _, decision := range autoscaler.RecentDecisions() {
+		result.Decisions = append(result.Decisions, &AutoscaleDecision{
+			Time:          decision.Time,
+			Value:         decision.Value,
+			FromInstances: decision.FromInstances,
+			ToInstances:   decision.ToInstances,
+			Applied:       decision.Applied,
+			Reason:        decision.Reason,
+		})
+	}
+
+	return result
+}
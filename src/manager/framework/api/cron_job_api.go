@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+type CronJobService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallCronJobService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *CronJobService {
+	cronJobService := &CronJobService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, cronJobService)
+	return cronJobService
+}
+
+func (api *CronJobService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/cronjobs").
+		Doc("Cron job management").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "CronJobs", api.ListCronJobs)).
+		// docs
+		Doc("List Cron Jobs").
+		Operation("listCronJobs").
+		Returns(200, "OK", []CronJob{}))
+	ws.Route(ws.POST("/").To(metrics.InstrumentRouteFunc("POST", "CronJob", api.CreateCronJob)).
+		// docs
+		Doc("Create Cron Job").
+		Operation("createCronJob").
+		Returns(201, "OK", CronJob{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.CronJob{}).
+		Writes(CronJob{}))
+	ws.Route(ws.GET("/{cronjob_id}").To(metrics.InstrumentRouteFunc("GET", "CronJob", api.GetCronJob)).
+		// docs
+		Doc("Get a Cron Job").
+		Operation("getCronJob").
+		Param(ws.PathParameter("cronjob_id", "identifier of the cron job").DataType("string")).
+		Returns(200, "OK", CronJob{}).
+		Returns(404, "NotFound", nil))
+	ws.Route(ws.DELETE("/{cronjob_id}").To(metrics.InstrumentRouteFunc("DELETE", "CronJob", api.DeleteCronJob)).
+		// docs
+		Doc("Delete Cron Job along with its jobs").
+		Operation("deleteCronJob").
+		Param(ws.PathParameter("cronjob_id", "identifier of the cron job").DataType("string")).
+		Returns(204, "OK", nil).
+		Returns(404, "NotFound", nil))
+
+	container.Add(ws)
+}
+
+func (api *CronJobService) CreateCronJob(request *restful.Request, response *restful.Response) {
+	var spec types.CronJob
+
+	if err := request.ReadEntity(&spec); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if spec.JobTemplate == nil || spec.JobTemplate.Template == nil || CheckVersion(spec.JobTemplate.Template) != nil {
+		response.WriteErrorString(http.StatusBadRequest, "Invalid Template.")
+		return
+	}
+
+	cronJob, err := api.Scheduler.CreateCronJob(&spec)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, cronJobFromState(cronJob))
+}
+
+func (api *CronJobService) ListCronJobs(request *restful.Request, response *restful.Response) {
+	cronJobs := make([]*CronJob, 0)
+	for _, cronJob := range api.Scheduler.ListCronJobs() {
+		cronJobs = append(cronJobs, cronJobFromState(cronJob))
+	}
+
+	response.WriteEntity(cronJobs)
+}
+
+func (api *CronJobService) GetCronJob(request *restful.Request, response *restful.Response) {
+	cronJob, err := api.Scheduler.InspectCronJob(request.PathParameter("cronjob_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteEntity(cronJobFromState(cronJob))
+}
+
+func (api *CronJobService) DeleteCronJob(request *restful.Request, response *restful.Response) {
+	if err := api.Scheduler.DeleteCronJob(request.PathParameter("cronjob_id")); err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func cronJobFromState(cronJob *state.CronJob) *CronJob {
+	return &CronJob{
+		ID:                      cronJob.CronJobId,
+		Schedule:                cronJob.Spec.Schedule,
+		TimeZone:                cronJob.Spec.TimeZone,
+		ConcurrencyPolicy:       cronJob.Spec.ConcurrencyPolicy,
+		HistoryLimit:            cronJob.Spec.HistoryLimit,
+		StartingDeadlineSeconds: cronJob.Spec.StartingDeadlineSeconds,
+		Created:                 cronJob.Created,
+		LastScheduled:           cronJob.LastScheduled,
+		NextScheduled:           cronJob.Next(),
+		Active:                  cronJob.ActiveRuns(),
+		Runs:                    cronJob.Runs,
+	}
+}
@@ -74,7 +74,8 @@ func (api *AppService) Register(container *restful.Container) {
 		Operation("deleteApp").
 		Returns(204, "OK", nil).
 		Returns(404, "NotFound", nil).
-		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")).
+		Param(ws.QueryParameter("destroyVolumes", "destroy persistent volumes of the app, e.g. destroyVolumes=true").DataType("boolean")))
 	ws.Route(ws.PATCH("/{app_id}/scale-up").To(metrics.InstrumentRouteFunc("PATCH", "App", api.ScaleUp)).
 		// docs
 		Doc("Scale Up App").
@@ -105,6 +106,42 @@ func (api *AppService) Register(container *restful.Container) {
 		Operation("cancelUpdateApp").
 		Returns(400, "BadRequest", nil).
 		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.PATCH("/{app_id}/pause-update").To(metrics.InstrumentRouteFunc("PATCH", "App", api.PauseUpdate)).
+		// docs
+		Doc("Pause Unattended Update App").
+		Operation("pauseUpdateApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.PATCH("/{app_id}/resume-update").To(metrics.InstrumentRouteFunc("PATCH", "App", api.ResumeUpdate)).
+		// docs
+		Doc("Resume Unattended Update App").
+		Operation("resumeUpdateApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.POST("/{app_id}/canary").To(metrics.InstrumentRouteFunc("POST", "App", api.Canary)).
+		// docs
+		Doc("Run Canary Instances Of A New Version").
+		Operation("canaryApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.PATCH("/{app_id}/promote-canary").To(metrics.InstrumentRouteFunc("PATCH", "App", api.PromoteCanary)).
+		// docs
+		Doc("Promote Canary Version To All Instances").
+		Operation("promoteCanaryApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.PATCH("/{app_id}/abort-canary").To(metrics.InstrumentRouteFunc("PATCH", "App", api.AbortCanary)).
+		// docs
+		Doc("Abort Canary And Roll Back Canary Instances").
+		Operation("abortCanaryApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
+	ws.Route(ws.POST("/{app_id}/rollback").To(metrics.InstrumentRouteFunc("POST", "App", api.Rollback)).
+		// docs
+		Doc("Rollback App To A Historical Version").
+		Operation("rollbackApp").
+		Returns(400, "BadRequest", nil).
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")))
 
 	ws.Route(ws.GET("/{app_id}/tasks/{task_id}").To(metrics.InstrumentRouteFunc("GET", "AppTask", api.GetAppTask)).
 		// docs
@@ -114,6 +151,14 @@ func (api *AppService) Register(container *restful.Container) {
 		Param(ws.PathParameter("task_id", "identifier of the task").DataType("int")).
 		Returns(200, "OK", Task{}).
 		Returns(404, "NotFound", nil))
+	ws.Route(ws.GET("/{app_id}/tasks/{task_id}/placement").To(metrics.InstrumentRouteFunc("GET", "AppTaskPlacement", api.GetAppTaskPlacement)).
+		// docs
+		Doc("Get why a task is pending for offers").
+		Operation("getAppTaskPlacement").
+		Param(ws.PathParameter("app_id", "identifier of the app").DataType("string")).
+		Param(ws.PathParameter("task_id", "index of the task").DataType("int")).
+		Returns(200, "OK", Placement{}).
+		Returns(404, "NotFound", nil))
 
 	container.Add(ws)
 }
@@ -146,6 +191,9 @@ func (api *AppService) CreateApp(request *restful.Request, response *restful.Res
 			Updated:          app.Updated,
 			Mode:             string(app.Mode),
 			State:            app.State,
+			StateMessage:     app.StateMessage,
+			UpdatePaused:     app.UpdatePaused,
+			CanaryWeight:     app.CanaryWeight,
 			Labels:           version.Labels,
 			Env:              version.Env,
 			Constraints:      version.Constraints,
@@ -198,6 +246,9 @@ func (api *AppService) ListApp(request *restful.Request, response *restful.Respo
 			Updated:          app.Updated,
 			Mode:             string(app.Mode),
 			State:            app.State,
+			StateMessage:     app.StateMessage,
+			UpdatePaused:     app.UpdatePaused,
+			CanaryWeight:     app.CanaryWeight,
 			Labels:           version.Labels,
 			Env:              version.Env,
 			Constraints:      version.Constraints,
@@ -225,6 +276,9 @@ func (api *AppService) GetApp(request *restful.Request, response *restful.Respon
 			Updated:          app.Updated,
 			Mode:             string(app.Mode),
 			State:            app.State,
+			StateMessage:     app.StateMessage,
+			UpdatePaused:     app.UpdatePaused,
+			CanaryWeight:     app.CanaryWeight,
 			Labels:           version.Labels,
 			Env:              version.Env,
 			Constraints:      version.Constraints,
@@ -243,7 +297,8 @@ func (api *AppService) GetApp(request *restful.Request, response *restful.Respon
 }
 
 func (api *AppService) DeleteApp(request *restful.Request, response *restful.Response) {
-	err := api.Scheduler.DeleteApp(request.PathParameter("app_id"))
+	destroyVolumes := request.QueryParameter("destroyVolumes") == "true"
+	err := api.Scheduler.DeleteApp(request.PathParameter("app_id"), destroyVolumes)
 	if err != nil {
 		response.WriteErrorString(http.StatusNotFound, err.Error())
 	} else {
@@ -335,6 +390,24 @@ func (api *AppService) CancelUpdate(request *restful.Request, response *restful.
 	}
 }
 
+func (api *AppService) PauseUpdate(request *restful.Request, response *restful.Response) {
+	err := api.Scheduler.PauseUpdate(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"update paused"}, restful.MIME_JSON)
+	}
+}
+
+func (api *AppService) ResumeUpdate(request *restful.Request, response *restful.Response) {
+	err := api.Scheduler.ResumeUpdate(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"update resumed"}, restful.MIME_JSON)
+	}
+}
+
 func (api *AppService) GetAppTask(request *restful.Request, response *restful.Response) {
 	app, err := api.Scheduler.InspectApp(request.PathParameter("app_id"))
 	if err != nil {
@@ -355,6 +428,53 @@ func (api *AppService) GetAppTask(request *restful.Request, response *restful.Re
 	}
 }
 
+func (api *AppService) GetAppTaskPlacement(request *restful.Request, response *restful.Response) {
+	app, err := api.Scheduler.InspectApp(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	index, err := strconv.Atoi(request.PathParameter("task_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, "Get task index err: "+err.Error())
+		return
+	}
+
+	slot, found := app.GetSlot(index)
+	if !found {
+		response.WriteErrorString(http.StatusNotFound, "task not exists")
+		return
+	}
+
+	response.WriteEntity(placementFromSlot(slot))
+}
+
+func placementFromSlot(slot *state.Slot) *Placement {
+	placement := &Placement{
+		TaskId:     slot.Id,
+		Status:     slot.State,
+		Rejections: make([]*PlacementRejection, 0),
+	}
+
+	diagnosis := slot.Placement()
+	if diagnosis == nil {
+		return placement
+	}
+
+	placement.Tested = diagnosis.Tested
+	placement.Considered = diagnosis.Considered
+	placement.Matched = diagnosis.Matched
+	for _, rejection := range diagnosis.Rejections {
+		placement.Rejections = append(placement.Rejections, &PlacementRejection{
+			Reason: rejection.Reason,
+			Agents: rejection.Agents,
+		})
+	}
+
+	return placement
+}
+
 func CheckVersion(version *types.Version) error {
 	// image format
 	// mode valid
@@ -362,6 +482,15 @@ func CheckVersion(version *types.Version) error {
 	return nil
 }
 
+// empty for command-only app
+func versionImage(version *types.Version) string {
+	if version.Container == nil || version.Container.Docker == nil {
+		return ""
+	}
+
+	return version.Container.Docker.Image
+}
+
 func FilterTasksFromApp(app *state.App) []*Task {
 	tasks := make([]*Task, 0)
 	for _, slot := range app.GetSlots() {
@@ -370,6 +499,7 @@ func FilterTasksFromApp(app *state.App) []*Task {
 			AppId:         slot.App.AppId, // either Name or Id, rename AppId later
 			VersionId:     slot.Version.ID,
 			Healthy:       slot.Healthy(),
+			Ready:         slot.Ready(),
 			Status:        string(slot.State),
 			OfferId:       slot.OfferId,
 			AgentId:       slot.AgentId,
@@ -380,7 +510,8 @@ func FilterTasksFromApp(app *state.App) []*Task {
 			Disk:          slot.Version.Disk,
 			IP:            slot.Ip,
 			Created:       slot.CurrentTask.Created,
-			Image:         slot.Version.Container.Docker.Image,
+			Image:         versionImage(slot.Version),
+			Restarts:      slot.Restarts(),
 		}
 
 		if len(slot.TaskHistory) > 0 {
@@ -415,7 +546,7 @@ func FilterTasksFromApp(app *state.App) []*Task {
 func GetTaskFromApp(app *state.App, task_index int) (*Task, error) {
 	slots := app.GetSlots()
 	if task_index >= len(slots)-1 || task_index < 0 {
-		logrus.Errorf("slot not found: %s", task_index)
+		logrus.Errorf("slot not found: %d", task_index)
 		return nil, errors.New("slot task found")
 	}
 
@@ -435,7 +566,8 @@ func GetTaskFromApp(app *state.App, task_index int) (*Task, error) {
 		Disk:          slot.Version.Disk,
 		IP:            slot.Ip,
 		Created:       slot.CurrentTask.Created,
-		Image:         slot.Version.Container.Docker.Image,
+		Image:         versionImage(slot.Version),
+		Restarts:      slot.Restarts(),
 	}
 
 	if len(slot.TaskHistory) > 0 {
@@ -463,3 +595,69 @@ func GetTaskFromApp(app *state.App, task_index int) (*Task, error) {
 
 	return task, nil
 }
+
+func (api *AppService) Canary(request *restful.Request, response *restful.Response) {
+	var param struct {
+		Version   *types.Version `json:"version"`
+		Instances int            `json:"instances"`
+		Weight    float64        `json:"weight"`
+	}
+
+	if err := request.ReadEntity(&param); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if param.Version == nil || CheckVersion(param.Version) != nil {
+		response.WriteErrorString(http.StatusBadRequest, "Invalid Version.")
+		return
+	}
+
+	err := api.Scheduler.Canary(request.PathParameter("app_id"), param.Version, param.Instances, param.Weight)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"version accepted"}, restful.MIME_JSON)
+	}
+}
+
+func (api *AppService) PromoteCanary(request *restful.Request, response *restful.Response) {
+	err := api.Scheduler.PromoteCanary(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"canary promoted"}, restful.MIME_JSON)
+	}
+}
+
+func (api *AppService) AbortCanary(request *restful.Request, response *restful.Response) {
+	err := api.Scheduler.AbortCanary(request.PathParameter("app_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"canary aborted"}, restful.MIME_JSON)
+	}
+}
+
+func (api *AppService) Rollback(request *restful.Request, response *restful.Response) {
+	var param struct {
+		Version string `json:"version"`
+	}
+
+	if err := request.ReadEntity(&param); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(param.Version) == 0 {
+		param.Version = state.ROLLBACK_TO_PREVIOUS_VERSION
+	}
+
+	err := api.Scheduler.Rollback(request.PathParameter("app_id"), param.Version)
+	if err != nil {
+		logrus.Errorf("%s", err)
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+	} else {
+		response.WriteHeaderAndJson(http.StatusOK, []string{"version accepted"}, restful.MIME_JSON)
+	}
+}
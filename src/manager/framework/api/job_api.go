@@ -0,0 +1,136 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+type JobService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallJobService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *JobService {
+	jobService := &JobService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, jobService)
+	return jobService
+}
+
+func (api *JobService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/jobs").
+		Doc("Batch job management").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "Jobs", api.ListJobs)).
+		// docs
+		Doc("List Jobs").
+		Operation("listJobs").
+		Returns(200, "OK", []Job{}))
+	ws.Route(ws.POST("/").To(metrics.InstrumentRouteFunc("POST", "Job", api.CreateJob)).
+		// docs
+		Doc("Create Job").
+		Operation("createJob").
+		Returns(201, "OK", Job{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.Job{}).
+		Writes(Job{}))
+	ws.Route(ws.GET("/{job_id}").To(metrics.InstrumentRouteFunc("GET", "Job", api.GetJob)).
+		// docs
+		Doc("Get a Job").
+		Operation("getJob").
+		Param(ws.PathParameter("job_id", "identifier of the job").DataType("string")).
+		Returns(200, "OK", Job{}).
+		Returns(404, "NotFound", nil))
+	ws.Route(ws.DELETE("/{job_id}").To(metrics.InstrumentRouteFunc("DELETE", "Job", api.DeleteJob)).
+		// docs
+		Doc("Delete Job").
+		Operation("deleteJob").
+		Param(ws.PathParameter("job_id", "identifier of the job").DataType("string")).
+		Returns(204, "OK", nil).
+		Returns(404, "NotFound", nil))
+
+	container.Add(ws)
+}
+
+func (api *JobService) CreateJob(request *restful.Request, response *restful.Response) {
+	var spec types.Job
+
+	if err := request.ReadEntity(&spec); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if spec.Template == nil || CheckVersion(spec.Template) != nil {
+		response.WriteErrorString(http.StatusBadRequest, "Invalid Template.")
+		return
+	}
+
+	job, err := api.Scheduler.CreateJob(&spec)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, jobFromState(job))
+}
+
+func (api *JobService) ListJobs(request *restful.Request, response *restful.Response) {
+	jobs := make([]*Job, 0)
+	for _, job := range api.Scheduler.ListJobs() {
+		jobs = append(jobs, jobFromState(job))
+	}
+
+	response.WriteEntity(jobs)
+}
+
+func (api *JobService) GetJob(request *restful.Request, response *restful.Response) {
+	job, err := api.Scheduler.InspectJob(request.PathParameter("job_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	jobRet := jobFromState(job)
+	jobRet.Tasks = FilterTasksFromApp(job.App)
+
+	response.WriteEntity(jobRet)
+}
+
+func (api *JobService) DeleteJob(request *restful.Request, response *restful.Response) {
+	if err := api.Scheduler.DeleteJob(request.PathParameter("job_id")); err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func jobFromState(job *state.Job) *Job {
+	return &Job{
+		ID:                    job.JobId,
+		State:                 job.State,
+		Message:               job.Message,
+		Completions:           job.Completions,
+		Parallelism:           job.Parallelism,
+		BackoffLimit:          job.BackoffLimit,
+		ActiveDeadlineSeconds: job.ActiveDeadlineSeconds,
+		Succeeded:             job.Succeeded,
+		Failed:                job.Failed,
+		Active:                job.Active(),
+		Started:               job.Started,
+		Completed:             job.Completed,
+	}
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+type QuotaService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallQuotaService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *QuotaService {
+	quotaService := &QuotaService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, quotaService)
+	return quotaService
+}
+
+func (api *QuotaService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/quotas").
+		Doc("Quota management of resources per runAs").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "Quotas", api.ListQuotas)).
+		// docs
+		Doc("List Quotas").
+		Operation("listQuotas").
+		Returns(200, "OK", []Quota{}))
+	ws.Route(ws.POST("/").To(metrics.InstrumentRouteFunc("POST", "Quota", api.CreateQuota)).
+		// docs
+		Doc("Create Quota").
+		Operation("createQuota").
+		Returns(201, "OK", Quota{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.Quota{}).
+		Writes(Quota{}))
+	ws.Route(ws.GET("/{run_as}").To(metrics.InstrumentRouteFunc("GET", "Quota", api.GetQuota)).
+		// docs
+		Doc("Get a Quota").
+		Operation("getQuota").
+		Param(ws.PathParameter("run_as", "runAs of the quota").DataType("string")).
+		Returns(200, "OK", Quota{}).
+		Returns(404, "NotFound", nil))
+	ws.Route(ws.PUT("/{run_as}").To(metrics.InstrumentRouteFunc("PUT", "Quota", api.UpdateQuota)).
+		// docs
+		Doc("Update Quota").
+		Operation("updateQuota").
+		Param(ws.PathParameter("run_as", "runAs of the quota").DataType("string")).
+		Returns(200, "OK", Quota{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.Quota{}).
+		Writes(Quota{}))
+	ws.Route(ws.DELETE("/{run_as}").To(metrics.InstrumentRouteFunc("DELETE", "Quota", api.DeleteQuota)).
+		// docs
+		Doc("Delete Quota").
+		Operation("deleteQuota").
+		Param(ws.PathParameter("run_as", "runAs of the quota").DataType("string")).
+		Returns(204, "OK", nil).
+		Returns(404, "NotFound", nil))
+
+	container.Add(ws)
+}
+
+func (api *QuotaService) CreateQuota(request *restful.Request, response *restful.Response) {
+	var quota types.Quota
+
+	if err := request.ReadEntity(&quota); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := api.Scheduler.CreateQuota(&quota); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, quotaFromScheduler(&quota, api.Scheduler.QuotaUsage(quota.RunAs)))
+}
+
+func (api *QuotaService) ListQuotas(request *restful.Request, response *restful.Response) {
+	quotas := make([]*Quota, 0)
+	for _, quota := range api.Scheduler.ListQuotas() {
+		quotas = append(quotas, quotaFromScheduler(quota, api.Scheduler.QuotaUsage(quota.RunAs)))
+	}
+
+	response.WriteEntity(quotas)
+}
+
+func (api *QuotaService) GetQuota(request *restful.Request, response *restful.Response) {
+	quota, err := api.Scheduler.InspectQuota(request.PathParameter("run_as"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteEntity(quotaFromScheduler(quota, api.Scheduler.QuotaUsage(quota.RunAs)))
+}
+
+func (api *QuotaService) UpdateQuota(request *restful.Request, response *restful.Response) {
+	var quota types.Quota
+
+	if err := request.ReadEntity(&quota); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+	quota.RunAs = request.PathParameter("run_as")
+
+	if err := api.Scheduler.UpdateQuota(&quota); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteEntity(quotaFromScheduler(&quota, api.Scheduler.QuotaUsage(quota.RunAs)))
+}
+
+func (api *QuotaService) DeleteQuota(request *restful.Request, response *restful.Response) {
+	if err := api.Scheduler.DeleteQuota(request.PathParameter("run_as")); err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func quotaFromScheduler(quota, usage *types.Quota) *Quota {
+	return &Quota{
+		RunAs:     quota.RunAs,
+		Cpus:      quota.Cpus,
+		Mem:       quota.Mem,
+		Disk:      quota.Disk,
+		Instances: quota.Instances,
+		Ips:       quota.Ips,
+		Usage: &QuotaUsage{
+			Cpus:      usage.Cpus,
+			Mem:       usage.Mem,
+			Disk:      usage.Disk,
+			Instances: usage.Instances,
+			Ips:       usage.Ips,
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+type ReservationService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallReservationService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *ReservationService {
+	reservationService := &ReservationService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, reservationService)
+	return reservationService
+}
+
+func (api *ReservationService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/reservations").
+		Doc("Dynamic reservation of agent resources for mesos roles").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "Reservations", api.ListPending)).
+		// docs
+		Doc("List reservation operations waiting for offers").
+		Operation("listReservations").
+		Returns(200, "OK", []ReservationOperation{}))
+	ws.Route(ws.POST("/reserve").To(metrics.InstrumentRouteFunc("POST", "Reservation", api.Reserve)).
+		// docs
+		Doc("Reserve resources on an agent for a role").
+		Operation("reserve").
+		Returns(202, "Accepted", ReservationOperation{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.Reservation{}).
+		Writes(ReservationOperation{}))
+	ws.Route(ws.POST("/unreserve").To(metrics.InstrumentRouteFunc("POST", "Reservation", api.Unreserve)).
+		// docs
+		Doc("Unreserve resources reserved on an agent for a role").
+		Operation("unreserve").
+		Returns(202, "Accepted", ReservationOperation{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.Reservation{}).
+		Writes(ReservationOperation{}))
+
+	container.Add(ws)
+}
+
+func (api *ReservationService) ListPending(request *restful.Request, response *restful.Response) {
+	operations := make([]*ReservationOperation, 0)
+	for _, operation := range api.Scheduler.Reserver.Pending() {
+		operations = append(operations, reservationOperationFromScheduler(operation))
+	}
+
+	response.WriteEntity(operations)
+}
+
+func (api *ReservationService) Reserve(request *restful.Request, response *restful.Response) {
+	api.operate(request, response, api.Scheduler.Reserver.Reserve)
+}
+
+func (api *ReservationService) Unreserve(request *restful.Request, response *restful.Response) {
+	api.operate(request, response, api.Scheduler.Reserver.Unreserve)
+}
+
+// operations are applied once offers of the agent come
+func (api *ReservationService) operate(request *restful.Request, response *restful.Response,
+	operate func(*types.Reservation) (*scheduler.ReservationOperation, error)) {
+	var spec types.Reservation
+
+	if err := request.ReadEntity(&spec); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	operation, err := operate(&spec)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusAccepted, reservationOperationFromScheduler(operation))
+}
+
+func reservationOperationFromScheduler(operation *scheduler.ReservationOperation) *ReservationOperation {
+	volumes := make([]string, 0)
+	for _, volume := range operation.Volumes {
+		volumes = append(volumes, volume.Id)
+	}
+
+	return &ReservationOperation{
+		Id:      operation.Id,
+		AppId:   operation.AppId,
+		Action:  operation.Action,
+		AgentId: operation.Spec.AgentId,
+		Role:    operation.Spec.Role,
+		Cpus:    operation.Spec.Cpus,
+		Mem:     operation.Spec.Mem,
+		Disk:    operation.Spec.Disk,
+		Volumes: volumes,
+		Created: operation.Created,
+	}
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
+	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/emicklei/go-restful"
+)
+
+const (
+	DEFAULT_SCALING_PLAN_HOURS = 24
+	DEFAULT_SCALING_PLAN_LIMIT = 20
+)
+
+type ScalingScheduleService struct {
+	Scheduler *scheduler.Scheduler
+	apiserver.ApiRegister
+}
+
+func NewAndInstallScalingScheduleService(apiServer *apiserver.ApiServer, eng *scheduler.Scheduler) *ScalingScheduleService {
+	scalingScheduleService := &ScalingScheduleService{
+		Scheduler: eng,
+	}
+	apiserver.Install(apiServer, scalingScheduleService)
+	return scalingScheduleService
+}
+
+func (api *ScalingScheduleService) Register(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.
+		ApiVersion(API_PREFIX).
+		Path("/" + API_PREFIX + "/scalingschedules").
+		Doc("Time based scaling schedules of apps").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/").To(metrics.InstrumentRouteFunc("GET", "ScalingSchedules", api.ListScalingSchedules)).
+		// docs
+		Doc("List Scaling Schedules").
+		Operation("listScalingSchedules").
+		Param(ws.QueryParameter("appId", "scaling schedules of the app only").DataType("string")).
+		Returns(200, "OK", []ScalingSchedule{}))
+	ws.Route(ws.POST("/").To(metrics.InstrumentRouteFunc("POST", "ScalingSchedule", api.CreateScalingSchedule)).
+		// docs
+		Doc("Create Scaling Schedule").
+		Operation("createScalingSchedule").
+		Returns(201, "OK", ScalingSchedule{}).
+		Returns(400, "BadRequest", nil).
+		Reads(types.ScalingSchedule{}).
+		Writes(ScalingSchedule{}))
+	ws.Route(ws.GET("/plan").To(metrics.InstrumentRouteFunc("GET", "ScalingPlan", api.GetScalingPlan)).
+		// docs
+		Doc("List the scaling planned by the schedules, earliest first").
+		Operation("getScalingPlan").
+		Param(ws.QueryParameter("appId", "scaling planned for the app only").DataType("string")).
+		Param(ws.QueryParameter("hours", "hours from now planned, 24 by default").DataType("integer")).
+		Param(ws.QueryParameter("limit", "max number of scaling listed, 20 by default").DataType("integer")).
+		Returns(200, "OK", []PlannedScaling{}).
+		Returns(400, "BadRequest", nil))
+	ws.Route(ws.GET("/{schedule_id}").To(metrics.InstrumentRouteFunc("GET", "ScalingSchedule", api.GetScalingSchedule)).
+		// docs
+		Doc("Get a Scaling Schedule").
+		Operation("getScalingSchedule").
+		Param(ws.PathParameter("schedule_id", "identifier of the scaling schedule").DataType("string")).
+		Returns(200, "OK", ScalingSchedule{}).
+		Returns(404, "NotFound", nil))
+	ws.Route(ws.DELETE("/{schedule_id}").To(metrics.InstrumentRouteFunc("DELETE", "ScalingSchedule", api.DeleteScalingSchedule)).
+		// docs
+		Doc("Delete Scaling Schedule, instances are left as they are").
+		Operation("deleteScalingSchedule").
+		Param(ws.PathParameter("schedule_id", "identifier of the scaling schedule").DataType("string")).
+		Returns(204, "OK", nil).
+		Returns(404, "NotFound", nil))
+
+	container.Add(ws)
+}
+
+func (api *ScalingScheduleService) CreateScalingSchedule(request *restful.Request, response *restful.Response) {
+	var spec types.ScalingSchedule
+
+	if err := request.ReadEntity(&spec); err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scalingSchedule, err := api.Scheduler.CreateScalingSchedule(&spec)
+	if err != nil {
+		response.WriteErrorString(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, scalingScheduleFromState(scalingSchedule))
+}
+
+func (api *ScalingScheduleService) ListScalingSchedules(request *restful.Request, response *restful.Response) {
+	scalingSchedules := make([]*ScalingSchedule, 0)
+	for _, scalingSchedule := range api.Scheduler.ListScalingSchedules(request.QueryParameter("appId")) {
+		scalingSchedules = append(scalingSchedules, scalingScheduleFromState(scalingSchedule))
+	}
+
+	response.WriteEntity(scalingSchedules)
+}
+
+func (api *ScalingScheduleService) GetScalingPlan(request *restful.Request, response *restful.Response) {
+	hours, limit := DEFAULT_SCALING_PLAN_HOURS, DEFAULT_SCALING_PLAN_LIMIT
+
+	var err error
+	if param := request.QueryParameter("hours"); len(param) > 0 {
+		if hours, err = strconv.Atoi(param); err != nil || hours <= 0 {
+			response.WriteErrorString(http.StatusBadRequest, "hours should be a positive integer")
+			return
+		}
+	}
+
+	if param := request.QueryParameter("limit"); len(param) > 0 {
+		if limit, err = strconv.Atoi(param); err != nil || limit <= 0 {
+			response.WriteErrorString(http.StatusBadRequest, "limit should be a positive integer")
+			return
+		}
+	}
+
+	planned := make([]*PlannedScaling, 0)
+	for _, run := range api.Scheduler.PlanScaling(request.QueryParameter("appId"), time.Duration(hours)*time.Hour, limit) {
+		planned = append(planned, &PlannedScaling{
+			Time:         run.Time,
+			ScheduleId:   run.ScheduleId,
+			AppId:        run.AppId,
+			Instances:    run.Instances,
+			MinInstances: run.MinInstances,
+			MaxInstances: run.MaxInstances,
+		})
+	}
+
+	response.WriteEntity(planned)
+}
+
+func (api *ScalingScheduleService) GetScalingSchedule(request *restful.Request, response *restful.Response) {
+	scalingSchedule, err := api.Scheduler.InspectScalingSchedule(request.PathParameter("schedule_id"))
+	if err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteEntity(scalingScheduleFromState(scalingSchedule))
+}
+
+func (api *ScalingScheduleService) DeleteScalingSchedule(request *restful.Request, response *restful.Response) {
+	if err := api.Scheduler.DeleteScalingSchedule(request.PathParameter("schedule_id")); err != nil {
+		response.WriteErrorString(http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func scalingScheduleFromState(scalingSchedule *state.ScalingSchedule) *ScalingSchedule {
+	return &ScalingSchedule{
+		ID:            scalingSchedule.ScheduleId,
+		AppId:         scalingSchedule.Spec.AppId,
+		Schedule:      scalingSchedule.Spec.Schedule,
+		TimeZone:      scalingSchedule.Spec.TimeZone,
+		Instances:     scalingSchedule.Spec.Instances,
+		MinInstances:  scalingSchedule.Spec.MinInstances,
+		MaxInstances:  scalingSchedule.Spec.MaxInstances,
+		Created:       scalingSchedule.Created,
+		LastScheduled: scalingSchedule.LastScheduled,
+		LastError:     scalingSchedule.LastError,
+		NextScheduled: scalingSchedule.Next(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Dataman-Cloud/swan/src/manager/apiserver"
 	"github.com/Dataman-Cloud/swan/src/manager/apiserver/metrics"
 	"github.com/Dataman-Cloud/swan/src/manager/framework/scheduler"
+	"github.com/Dataman-Cloud/swan/src/types"
 
 	"github.com/emicklei/go-restful"
 )
@@ -59,5 +60,16 @@ func (api *StatsService) Stats(request *restful.Request, response *restful.Respo
 		}
 	}
 
+	// usage of each runAs with apps or quota, limits of the quota if any
+	stats.QuotaStats = make(map[string]*Quota)
+	for _, quota := range api.Scheduler.ListQuotas() {
+		stats.QuotaStats[quota.RunAs] = quotaFromScheduler(quota, api.Scheduler.QuotaUsage(quota.RunAs))
+	}
+	for runAs := range stats.AppStats {
+		if _, found := stats.QuotaStats[runAs]; !found {
+			stats.QuotaStats[runAs] = quotaFromScheduler(&types.Quota{RunAs: runAs}, api.Scheduler.QuotaUsage(runAs))
+		}
+	}
+
 	response.WriteEntity(stats)
 }
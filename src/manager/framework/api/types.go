@@ -17,6 +17,9 @@ type App struct {
 	Updated          time.Time `json:"updated,omitempty"`
 	Mode             string    `json:"mode,omitempty"`
 	State            string    `json:"state"`
+	StateMessage     string    `json:"stateMessage,omitempty"`
+	UpdatePaused     bool      `json:"updatePaused,omitempty"`
+	CanaryWeight     float64   `json:"canaryWeight,omitempty"`
 
 	// use task for compatability now, should be slot here
 	Tasks    []*Task  `json:"tasks,omitempty"`
@@ -33,6 +36,130 @@ type App struct {
 	//UpdatePolicy      *types.UpdatePolicy
 }
 
+type Job struct {
+	ID                    string    `json:"id"`
+	State                 string    `json:"state"`
+	Message               string    `json:"message,omitempty"`
+	Completions           int       `json:"completions"`
+	Parallelism           int       `json:"parallelism"`
+	BackoffLimit          int       `json:"backoffLimit"`
+	ActiveDeadlineSeconds int64     `json:"activeDeadlineSeconds,omitempty"`
+	Succeeded             int       `json:"succeeded"`
+	Failed                int       `json:"failed"`
+	Active                int       `json:"active"`
+	Started               time.Time `json:"started,omitempty"`
+	Completed             time.Time `json:"completed,omitempty"`
+
+	Tasks []*Task `json:"tasks,omitempty"`
+}
+
+type CronJob struct {
+	ID                      string    `json:"id"`
+	Schedule                string    `json:"schedule"`
+	TimeZone                string    `json:"timeZone,omitempty"`
+	ConcurrencyPolicy       string    `json:"concurrencyPolicy"`
+	HistoryLimit            int32     `json:"historyLimit"`
+	StartingDeadlineSeconds int64     `json:"startingDeadlineSeconds,omitempty"`
+	Created                 time.Time `json:"created"`
+	LastScheduled           time.Time `json:"lastScheduled,omitempty"`
+	NextScheduled           time.Time `json:"nextScheduled,omitempty"`
+	Active                  []string  `json:"active"`
+	Runs                    []string  `json:"runs"`
+}
+
+type Placement struct {
+	TaskId     string                `json:"taskId"`
+	Status     string                `json:"status"`
+	Tested     time.Time             `json:"tested,omitempty"`
+	Considered int                   `json:"offersConsidered"`
+	Matched    int                   `json:"offersMatched"`
+	Rejections []*PlacementRejection `json:"rejections"`
+}
+
+type PlacementRejection struct {
+	Reason string   `json:"reason"`
+	Agents []string `json:"agents"`
+}
+
+// limits of zero are not enforced
+type Quota struct {
+	RunAs     string      `json:"runAs"`
+	Cpus      float64     `json:"cpus,omitempty"`
+	Mem       float64     `json:"mem,omitempty"`
+	Disk      float64     `json:"disk,omitempty"`
+	Instances int32       `json:"instances,omitempty"`
+	Ips       int32       `json:"ips,omitempty"`
+	Usage     *QuotaUsage `json:"usage"`
+}
+
+type QuotaUsage struct {
+	Cpus      float64 `json:"cpus"`
+	Mem       float64 `json:"mem"`
+	Disk      float64 `json:"disk"`
+	Instances int32   `json:"instances"`
+	Ips       int32   `json:"ips"`
+}
+
+type Autoscaler struct {
+	AppId                    string               `json:"appId"`
+	MinInstances             int32                `json:"minInstances"`
+	MaxInstances             int32                `json:"maxInstances"`
+	Metric                   string               `json:"metric"`
+	Target                   float64              `json:"target"`
+	Query                    string               `json:"query,omitempty"`
+	IntervalSeconds          int64                `json:"intervalSeconds"`
+	ScaleUpCooldownSeconds   int64                `json:"scaleUpCooldownSeconds"`
+	ScaleDownCooldownSeconds int64                `json:"scaleDownCooldownSeconds"`
+	Created                  time.Time            `json:"created"`
+	LastScaled               time.Time            `json:"lastScaled,omitempty"`
+	Decisions                []*AutoscaleDecision `json:"decisions"`
+}
+
+type AutoscaleDecision struct {
+	Time          time.Time `json:"time"`
+	Value         float64   `json:"value"`
+	FromInstances int32     `json:"fromInstances"`
+	ToInstances   int32     `json:"toInstances"`
+	Applied       bool      `json:"applied"`
+	Reason        string    `json:"reason,omitempty"`
+}
+
+type ScalingSchedule struct {
+	ID            string    `json:"id"`
+	AppId         string    `json:"appId"`
+	Schedule      string    `json:"schedule"`
+	TimeZone      string    `json:"timeZone,omitempty"`
+	Instances     int32     `json:"instances"`
+	MinInstances  int32     `json:"minInstances,omitempty"`
+	MaxInstances  int32     `json:"maxInstances,omitempty"`
+	Created       time.Time `json:"created"`
+	LastScheduled time.Time `json:"lastScheduled,omitempty"`
+	LastError     string    `json:"lastError,omitempty"`
+	NextScheduled time.Time `json:"nextScheduled,omitempty"`
+}
+
+type PlannedScaling struct {
+	Time         time.Time `json:"time"`
+	ScheduleId   string    `json:"scheduleId"`
+	AppId        string    `json:"appId"`
+	Instances    int32     `json:"instances"`
+	MinInstances int32     `json:"minInstances,omitempty"`
+	MaxInstances int32     `json:"maxInstances,omitempty"`
+}
+
+type ReservationOperation struct {
+	Id      string    `json:"id,omitempty"`
+	AppId   string    `json:"appId,omitempty"`
+	Action  string    `json:"action"`
+	AgentId string    `json:"agentId"`
+	Role    string    `json:"role"`
+	Cpus    float64   `json:"cpus,omitempty"`
+	Mem     float64   `json:"mem,omitempty"`
+	Disk    float64   `json:"disk,omitempty"`
+	Volumes []string  `json:"volumes,omitempty"`
+	Created time.Time `json:"created"`
+}
+
 // use task for compatability now, should be slot here
 // and together with task history
 type Task struct {
@@ -58,6 +185,9 @@ type Task struct {
 
 	Image   string `json:"image,omitempty"`
 	Healthy bool   `json:"healthy,omitempty"`
+	Ready   bool   `json:"ready,omitempty"`
+
+	Restarts int `json:"restarts"`
 }
 
 type TaskHistory struct {
@@ -91,5 +221,6 @@ type Stats struct {
 	MemTotalUsed  float64 `json:"memTotalUsed,omitempty"`
 	DiskTotalUsed float64 `json:"diskTotalUsed,omitempty"`
 
-	AppStats map[string]int `json:"appStats,omitempty"`
+	AppStats   map[string]int    `json:"appStats,omitempty"`
+	QuotaStats map[string]*Quota `json:"quotaStats,omitempty"`
 }
@@ -14,10 +14,16 @@ import (
 )
 
 type Framework struct {
-	Scheduler   *scheduler.Scheduler
-	SwanContext *swancontext.SwanContext
-	RestApi     *api.AppService
-	StatsApi    *api.StatsService
+	Scheduler    *scheduler.Scheduler
+	SwanContext  *swancontext.SwanContext
+	RestApi      *api.AppService
+	StatsApi     *api.StatsService
+	JobApi       *api.JobService
+	CronJobApi   *api.CronJobService
+	ReserveApi   *api.ReservationService
+	QuotaApi     *api.QuotaService
+	AutoscaleApi *api.AutoscalerService
+	ScalingApi   *api.ScalingScheduleService
 
 	StopC chan struct{}
 }
@@ -31,6 +37,12 @@ func New(SwanContext *swancontext.SwanContext, config config.SwanConfig, store s
 	f.Scheduler = scheduler.NewScheduler(config, SwanContext, store)
 	f.RestApi = api.NewAndInstallAppService(apiServer, f.Scheduler)
 	f.StatsApi = api.NewAndInstallStatsService(apiServer, f.Scheduler)
+	f.JobApi = api.NewAndInstallJobService(apiServer, f.Scheduler)
+	f.CronJobApi = api.NewAndInstallCronJobService(apiServer, f.Scheduler)
+	f.ReserveApi = api.NewAndInstallReservationService(apiServer, f.Scheduler)
+	f.QuotaApi = api.NewAndInstallQuotaService(apiServer, f.Scheduler)
+	f.AutoscaleApi = api.NewAndInstallAutoscalerService(apiServer, f.Scheduler)
+	f.ScalingApi = api.NewAndInstallScalingScheduleService(apiServer, f.Scheduler)
 	return f, nil
 }
 
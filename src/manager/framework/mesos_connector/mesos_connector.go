@@ -163,6 +163,17 @@ func CreateOrLoadFrameworkInfo(config config.Scheduler) (*mesos.FrameworkInfo, e
 		Name:            proto.String("swan"),
 		Hostname:        proto.String(config.Hostname),
 		FailoverTimeout: proto.Float64(60 * 60 * 24 * 7),
+		Capabilities: []*mesos.FrameworkInfo_Capability{
+			{Type: mesos.FrameworkInfo_Capability_PARTITION_AWARE.Enum()},
+		},
+	}
+
+	// offers are allocated to one of the roles, apps run by the RunAs role
+	if len(config.MesosRoles) > 0 {
+		fw.Roles = config.MesosRoles
+		fw.Capabilities = append(fw.Capabilities, &mesos.FrameworkInfo_Capability{
+			Type: mesos.FrameworkInfo_Capability_MULTI_ROLE.Enum(),
+		})
 	}
 
 	return fw, nil
@@ -1 +1,24 @@
 package metrics
+
+// metrics of the offers swan got from mesos, served along with the api
+// server metrics
+
+func OffersReceived(n int) {
+	offersReceived.Add(float64(n))
+}
+
+func OfferDeclined() {
+	offersDeclined.Inc()
+}
+
+func OfferUsed() {
+	offersUsed.Inc()
+}
+
+func OffersSuppressed(suppressed bool) {
+	if suppressed {
+		offersSuppressed.Set(1)
+	} else {
+		offersSuppressed.Set(0)
+	}
+}
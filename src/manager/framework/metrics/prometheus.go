@@ -1 +1,31 @@
 package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	offersReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swan_offers_received_total",
+		Help: "Counter of offers received from mesos.",
+	})
+	offersDeclined = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swan_offers_declined_total",
+		Help: "Counter of offers declined as no pending slot matched.",
+	})
+	offersUsed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swan_offers_used_total",
+		Help: "Counter of offers accepted to launch tasks.",
+	})
+	offersSuppressed = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "swan_offers_suppressed",
+		Help: "1 while offers are suppressed as no slot is pending, 0 otherwise.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(offersReceived)
+	prometheus.MustRegister(offersDeclined)
+	prometheus.MustRegister(offersUsed)
+	prometheus.MustRegister(offersSuppressed)
+}
@@ -0,0 +1,305 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/config"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Dataman-Cloud/swan-janitor/src/upstream"
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	DEFAULT_MESOS_AGENT_PORT = 5051
+	METRICS_REQUEST_TIMEOUT  = 5 * time.Second
+)
+
+// autoscaleMetrics measures metrics of autoscale policies. cpu and request
+// rates are counted between two evaluations, so the first evaluation of an
+// app measures none of them.
+type autoscaleMetrics struct {
+	agentPort     int
+	prometheusUrl string
+	client        *http.Client
+
+	// last samples by app, cpu ones by task too
+	cpuSamples     map[string]map[string]*cpuSample
+	requestSamples map[string]*requestSample
+	lock           sync.Mutex
+}
+
+type cpuSample struct {
+	cpuSeconds float64
+	timestamp  float64
+}
+
+type requestSample struct {
+	requests uint64
+	time     time.Time
+}
+
+// statistics of an executor served by mesos agents, executor of the task
+// has the same id as the task
+type executorStatistics struct {
+	ExecutorId string `json:"executor_id"`
+	Statistics struct {
+		CpusLimit          float64 `json:"cpus_limit"`
+		CpusUserTimeSecs   float64 `json:"cpus_user_time_secs"`
+		CpusSystemTimeSecs float64 `json:"cpus_system_time_secs"`
+		MemLimitBytes      float64 `json:"mem_limit_bytes"`
+		MemRssBytes        float64 `json:"mem_rss_bytes"`
+		Timestamp          float64 `json:"timestamp"`
+	} `json:"statistics"`
+}
+
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+func newAutoscaleMetrics(config config.Scheduler) *autoscaleMetrics {
+	metrics := &autoscaleMetrics{
+		agentPort:      config.MesosAgentPort,
+		prometheusUrl:  strings.TrimSuffix(config.PrometheusUrl, "/"),
+		client:         &http.Client{Timeout: METRICS_REQUEST_TIMEOUT},
+		cpuSamples:     make(map[string]map[string]*cpuSample),
+		requestSamples: make(map[string]*requestSample),
+	}
+
+	if metrics.agentPort == 0 {
+		metrics.agentPort = DEFAULT_MESOS_AGENT_PORT
+	}
+
+	return metrics
+}
+
+func (metrics *autoscaleMetrics) Measure(app *state.App, policy *types.AutoscalePolicy) (float64, error) {
+	switch policy.Metric {
+	case state.AUTOSCALE_METRIC_CPU, state.AUTOSCALE_METRIC_MEM:
+		return metrics.measureUtilization(app, policy.Metric)
+	case state.AUTOSCALE_METRIC_REQUESTS:
+		return metrics.measureRequests(app)
+	case state.AUTOSCALE_METRIC_PROMETHEUS:
+		return metrics.measurePrometheus(app, policy.Query)
+	}
+
+	return 0, errors.New(fmt.Sprintf("unknown autoscale metric %s", policy.Metric))
+}
+
+// drop the samples of the app no longer autoscaled
+func (metrics *autoscaleMetrics) forget(appId string) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	delete(metrics.cpuSamples, appId)
+	delete(metrics.requestSamples, appId)
+}
+
+// average utilization in percentage of the running tasks
+func (metrics *autoscaleMetrics) measureUtilization(app *state.App, metric string) (float64, error) {
+	tasks := make(map[string][]*state.Task) // by agent hostname
+	for _, slot := range app.GetSlots() {
+		if slot.StateIs(state.SLOT_STATE_TASK_RUNNING) && slot.CurrentTask != nil {
+			tasks[slot.AgentHostName] = append(tasks[slot.AgentHostName], slot.CurrentTask)
+		}
+	}
+
+	statistics := make(map[string]*executorStatistics) // by task
+	for hostname := range tasks {
+		agentStatistics, err := metrics.agentStatistics(hostname)
+		if err != nil {
+			logrus.Warnf("get statistics of agent %s got error: %s", hostname, err)
+			continue
+		}
+
+		for _, task := range tasks[hostname] {
+			if executor, found := agentStatistics[task.TaskInfoId]; found {
+				statistics[task.TaskInfoId] = executor
+			}
+		}
+	}
+
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	lastSamples := metrics.cpuSamples[app.AppId]
+	samples := make(map[string]*cpuSample)
+
+	var sum float64
+	var measured int
+	for _, agentTasks := range tasks {
+		for _, task := range agentTasks {
+			executor, found := statistics[task.TaskInfoId]
+			if !found {
+				continue
+			}
+			stats := executor.Statistics
+
+			if metric == state.AUTOSCALE_METRIC_MEM {
+				if stats.MemLimitBytes > 0 {
+					sum += stats.MemRssBytes / stats.MemLimitBytes * 100
+					measured++
+				}
+				continue
+			}
+
+			sample := &cpuSample{
+				cpuSeconds: stats.CpusUserTimeSecs + stats.CpusSystemTimeSecs,
+				timestamp:  stats.Timestamp,
+			}
+			samples[task.TaskInfoId] = sample
+
+			last, found := lastSamples[task.TaskInfoId]
+			if found && sample.timestamp > last.timestamp && stats.CpusLimit > 0 {
+				sum += (sample.cpuSeconds - last.cpuSeconds) / (sample.timestamp - last.timestamp) / stats.CpusLimit * 100
+				measured++
+			}
+		}
+	}
+
+	if metric == state.AUTOSCALE_METRIC_CPU {
+		metrics.cpuSamples[app.AppId] = samples
+	}
+
+	if measured == 0 {
+		return 0, errors.New("no statistics of running tasks measured yet")
+	}
+
+	return sum / float64(measured), nil
+}
+
+// statistics of the executors on the agent by executor id
+func (metrics *autoscaleMetrics) agentStatistics(hostname string) (map[string]*executorStatistics, error) {
+	resp, err := metrics.client.Get(fmt.Sprintf("http://%s:%d/monitor/statistics", hostname, metrics.agentPort))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(fmt.Sprintf("unexpected status %d", resp.StatusCode))
+	}
+
+	var executors []*executorStatistics
+	if err := json.NewDecoder(resp.Body).Decode(&executors); err != nil {
+		return nil, err
+	}
+
+	statistics := make(map[string]*executorStatistics)
+	for _, executor := range executors {
+		statistics[executor.ExecutorId] = executor
+	}
+
+	return statistics, nil
+}
+
+// requests per second per instance proxied by the janitor of this manager,
+// the leader
+func (metrics *autoscaleMetrics) measureRequests(app *state.App) (float64, error) {
+	sample := &requestSample{
+		requests: upstream.Requests(janitorServiceName(app)),
+		time:     time.Now(),
+	}
+
+	metrics.lock.Lock()
+	last, found := metrics.requestSamples[app.AppId]
+	metrics.requestSamples[app.AppId] = sample
+	metrics.lock.Unlock()
+
+	if !found || sample.requests < last.requests {
+		return 0, errors.New("no request rate measured yet")
+	}
+
+	rate := float64(sample.requests-last.requests) / sample.time.Sub(last.time).Seconds()
+
+	return rate / float64(app.CurrentVersion.Instances), nil
+}
+
+// name of the app the janitor proxies requests by, as the task names of the
+// app sent to it without the index
+func janitorServiceName(app *state.App) string {
+	name := fmt.Sprintf("%s-%s-%s", app.AppId, app.CurrentVersion.RunAs, app.ClusterId)
+	return strings.ToLower(strings.Replace(name, "-", ".", -1))
+}
+
+// value of the query per instance, values of the series returned summed up
+func (metrics *autoscaleMetrics) measurePrometheus(app *state.App, query string) (float64, error) {
+	if len(metrics.prometheusUrl) == 0 {
+		return 0, errors.New("prometheus url not configured")
+	}
+
+	resp, err := metrics.client.Get(metrics.prometheusUrl + "/api/v1/query?query=" + url.QueryEscape(query))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result prometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+
+	if result.Status != "success" {
+		return 0, errors.New(fmt.Sprintf("prometheus query failed: %s", result.Error))
+	}
+
+	samples := make([][]interface{}, 0) // [timestamp, "value"]
+	switch result.Data.ResultType {
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(result.Data.Result, &sample); err != nil {
+			return 0, err
+		}
+		samples = append(samples, sample)
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result.Data.Result, &vector); err != nil {
+			return 0, err
+		}
+		for _, series := range vector {
+			samples = append(samples, series.Value)
+		}
+	default:
+		return 0, errors.New(fmt.Sprintf("result type %s of prometheus query not supported", result.Data.ResultType))
+	}
+
+	if len(samples) == 0 {
+		return 0, errors.New("prometheus query returned no series")
+	}
+
+	var sum float64
+	for _, sample := range samples {
+		if len(sample) != 2 {
+			return 0, errors.New("invalid sample of prometheus query")
+		}
+
+		value, ok := sample[1].(string)
+		if !ok {
+			return 0, errors.New("invalid sample of prometheus query")
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += v
+	}
+
+	return sum / float64(app.CurrentVersion.Instances), nil
+}
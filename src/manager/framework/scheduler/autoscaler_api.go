@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+func (scheduler *Scheduler) CreateAutoscaler(policy *types.AutoscalePolicy) (*state.Autoscaler, error) {
+	scheduler.autoscalersLock.Lock()
+	defer scheduler.autoscalersLock.Unlock()
+
+	if _, found := scheduler.autoscalers[policy.AppId]; found {
+		return nil, errors.New("autoscaler of the app already exists")
+	}
+
+	if err := scheduler.validateScaledApp(policy.AppId); err != nil {
+		return nil, err
+	}
+
+	autoscaler, err := state.NewAutoscaler(policy, scheduler, scheduler.autoscaleMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler.autoscalers[autoscaler.AppId] = autoscaler
+
+	return autoscaler, nil
+}
+
+func (scheduler *Scheduler) UpdateAutoscaler(policy *types.AutoscalePolicy) (*state.Autoscaler, error) {
+	scheduler.autoscalersLock.RLock()
+	defer scheduler.autoscalersLock.RUnlock()
+
+	autoscaler, found := scheduler.autoscalers[policy.AppId]
+	if !found {
+		return nil, errors.New("autoscaler not exists")
+	}
+
+	if err := autoscaler.Update(policy); err != nil {
+		return nil, err
+	}
+
+	return autoscaler, nil
+}
+
+func (scheduler *Scheduler) InspectAutoscaler(appId string) (*state.Autoscaler, error) {
+	scheduler.autoscalersLock.RLock()
+	defer scheduler.autoscalersLock.RUnlock()
+
+	autoscaler, found := scheduler.autoscalers[appId]
+	if !found {
+		return nil, errors.New("autoscaler not exists")
+	}
+
+	return autoscaler, nil
+}
+
+func (scheduler *Scheduler) ListAutoscalers() []*state.Autoscaler {
+	scheduler.autoscalersLock.RLock()
+	defer scheduler.autoscalersLock.RUnlock()
+
+	autoscalers := make([]*state.Autoscaler, 0)
+	for _, autoscaler := range scheduler.autoscalers {
+		autoscalers = append(autoscalers, autoscaler)
+	}
+
+	return autoscalers
+}
+
+func (scheduler *Scheduler) DeleteAutoscaler(appId string) error {
+	scheduler.autoscalersLock.Lock()
+	defer scheduler.autoscalersLock.Unlock()
+
+	autoscaler, found := scheduler.autoscalers[appId]
+	if !found {
+		return errors.New("autoscaler not exists")
+	}
+
+	if err := autoscaler.Delete(); err != nil {
+		return err
+	}
+
+	delete(scheduler.autoscalers, appId)
+	scheduler.autoscaleMetrics.forget(appId)
+
+	return nil
+}
+
+// instances of fixed mode apps come with their ips, which autoscalers and
+// scaling schedules can't make up, and jobs run to completion
+func (scheduler *Scheduler) validateScaledApp(appId string) error {
+	app, err := scheduler.InspectApp(appId)
+	if err != nil {
+		return err
+	}
+
+	if app.IsFixed() || app.IsJob() {
+		return errors.New("only apps of replicates mode can be scaled automatically")
+	}
+
+	return nil
+}
+
+// autoscalers are evaluated by the leader only
+func (scheduler *Scheduler) stopAutoscalers() {
+	scheduler.autoscalersLock.RLock()
+	defer scheduler.autoscalersLock.RUnlock()
+
+	for _, autoscaler := range scheduler.autoscalers {
+		autoscaler.Stop()
+	}
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+func (scheduler *Scheduler) CreateCronJob(spec *types.CronJob) (*state.CronJob, error) {
+	scheduler.cronJobsLock.Lock()
+	defer scheduler.cronJobsLock.Unlock()
+
+	if _, found := scheduler.cronJobs[spec.ID]; found {
+		return nil, errors.New("cron job with the same id already exists")
+	}
+
+	if spec.JobTemplate != nil {
+		if err := scheduler.validateRole(spec.JobTemplate.Template); err != nil {
+			return nil, err
+		}
+	}
+
+	cronJob, err := state.NewCronJob(spec, scheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler.cronJobs[cronJob.CronJobId] = cronJob
+
+	return cronJob, nil
+}
+
+func (scheduler *Scheduler) InspectCronJob(cronJobId string) (*state.CronJob, error) {
+	scheduler.cronJobsLock.RLock()
+	defer scheduler.cronJobsLock.RUnlock()
+
+	cronJob, found := scheduler.cronJobs[cronJobId]
+	if !found {
+		return nil, errors.New("cron job not exists")
+	}
+
+	return cronJob, nil
+}
+
+func (scheduler *Scheduler) ListCronJobs() []*state.CronJob {
+	scheduler.cronJobsLock.RLock()
+	defer scheduler.cronJobsLock.RUnlock()
+
+	cronJobs := make([]*state.CronJob, 0)
+	for _, cronJob := range scheduler.cronJobs {
+		cronJobs = append(cronJobs, cronJob)
+	}
+
+	return cronJobs
+}
+
+func (scheduler *Scheduler) DeleteCronJob(cronJobId string) error {
+	scheduler.cronJobsLock.Lock()
+	defer scheduler.cronJobsLock.Unlock()
+
+	cronJob, found := scheduler.cronJobs[cronJobId]
+	if !found {
+		return errors.New("cron job not exists")
+	}
+
+	if err := cronJob.Delete(); err != nil {
+		return err
+	}
+
+	delete(scheduler.cronJobs, cronJobId)
+
+	return nil
+}
+
+// cron jobs are scheduled by the leader only
+func (scheduler *Scheduler) stopCronJobs() {
+	scheduler.cronJobsLock.RLock()
+	defer scheduler.cronJobsLock.RUnlock()
+
+	for _, cronJob := range scheduler.cronJobs {
+		cronJob.Stop()
+	}
+}
@@ -1,55 +1,107 @@
 package scheduler
 
 import (
+	"github.com/Dataman-Cloud/swan/src/manager/framework/metrics"
 	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
 	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
 	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+	"github.com/Dataman-Cloud/swan/src/types"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/golang/protobuf/proto"
 )
 
+const (
+	DEFAULT_OFFER_REFUSE_SECONDS      = 1
+	DEFAULT_IDLE_OFFER_REFUSE_SECONDS = 300
+)
+
 func OfferHandler(h *Handler) (*Handler, error) {
 	logrus.WithFields(logrus.Fields{"handler": "offer"}).Debugf("")
 
+	allocator := h.Manager.SchedulerRef.Allocator
+	reserver := h.Manager.SchedulerRef.Reserver
+	metrics.OffersReceived(len(h.MesosEvent.Event.Offers.Offers))
+
+	offerWrappers := make([]*state.OfferWrapper, 0)
 	for _, offer := range h.MesosEvent.Event.Offers.Offers {
-		// when no pending offer slot
-		if len(h.Manager.SchedulerRef.Allocator.PendingOfferSlots) == 0 {
-			RejectOffer(h, offer)
+		offerWrapper := state.NewOfferWrapper(offer)
 
-		} else {
-			offerWrapper := state.NewOfferWrapper(offer)
-			taskInfos := make([]*mesos.TaskInfo, 0)
-			for {
-				// loop through all pending offer slots
-				slot := h.Manager.SchedulerRef.Allocator.NextPendingOffer()
-				if slot == nil {
-					break
-				}
-
-				match := slot.TestOfferMatch(offerWrapper)
-				if match {
-					// TODO the following code logic complex, need improvement
-					// offerWrapper cpu/mem/disk deduction recorded within the obj itself
-					_, taskInfo := slot.ReserveOfferAndPrepareTaskInfo(offerWrapper)
-					h.Manager.SchedulerRef.Allocator.SetOfferIdForSlotId(offer.GetId(), slot.Id)
-					taskInfos = append(taskInfos, taskInfo)
-				} else {
-					// put the slot back into the queue, in the end
-					h.Manager.SchedulerRef.Allocator.PutSlotBackToPendingQueue(slot)
-				}
-			}
+		// offers used by reservation operations are not used to launch tasks
+		if operations := reserver.Operate(offerWrapper); len(operations) > 0 {
+			AcceptOfferOperations(h, offer, operations)
+			continue
+		}
 
-			if len(taskInfos) > 0 {
-				LaunchTaskInfos(h, offer, taskInfos)
+		offerWrappers = append(offerWrappers, offerWrapper)
+	}
+
+	taskInfos := make(map[string][]*mesos.TaskInfo) // taskInfos to launch by offer id
+	unmatchedSlots := make([]*state.Slot, 0)
+	unmatchedVersions := make(map[*types.Version]*state.Slot) // first slot not matched
+	for {
+		// loop through all pending offer slots
+		slot := allocator.NextPendingOffer()
+		if slot == nil {
+			break
+		}
+
+		// slots of a version not matched fail alike on the offers left, unless
+		// bound to the agent of their persistent volumes
+		if unmatched, found := unmatchedVersions[slot.Version]; found && len(slot.Volumes) == 0 {
+			slot.SharePlacement(unmatched)
+			unmatchedSlots = append(unmatchedSlots, slot)
+			continue
+		}
+
+		candidates := slot.MatchOffers(offerWrappers)
+		offerWrapper := state.BestOffer(h.Manager.SchedulerRef.PlacementStrategy(slot), slot, candidates)
+		if offerWrapper == nil {
+			unmatchedSlots = append(unmatchedSlots, slot)
+			if _, found := unmatchedVersions[slot.Version]; !found && len(slot.Volumes) == 0 {
+				unmatchedVersions[slot.Version] = slot
 			}
+			continue
+		}
+
+		// offerWrapper cpu/mem/disk deduction recorded within the obj itself
+		_, taskInfo := slot.ReserveOfferAndPrepareTaskInfo(offerWrapper)
+		allocator.SetOfferIdForSlotId(offerWrapper.Offer.GetId(), slot.Id)
+
+		offerId := offerWrapper.Offer.GetId().GetValue()
+		taskInfos[offerId] = append(taskInfos[offerId], taskInfo)
+	}
+
+	// put slots not matched back into the queue for the next offers
+	for _, slot := range unmatchedSlots {
+		allocator.PutSlotBackToPendingQueue(slot)
+	}
+
+	idle := len(unmatchedSlots) == 0
+	for _, offerWrapper := range offerWrappers {
+		if launching, found := taskInfos[offerWrapper.Offer.GetId().GetValue()]; found {
+			LaunchTaskInfos(h, offerWrapper, launching)
+		} else {
+			RejectOffer(h, offerWrapper.Offer, idle)
 		}
 	}
 
+	reserver.SuppressOffersIfIdle(allocator)
+
 	return h, nil
 }
 
-func LaunchTaskInfos(h *Handler, offer *mesos.Offer, taskInfos []*mesos.TaskInfo) {
+// launch after the operations tasks depend on, e.g. creating persistent volumes
+func LaunchTaskInfos(h *Handler, offerWrapper *state.OfferWrapper, taskInfos []*mesos.TaskInfo) {
+	AcceptOfferOperations(h, offerWrapper.Offer, append(offerWrapper.Operations, &mesos.Offer_Operation{
+		Type: mesos.Offer_Operation_LAUNCH.Enum(),
+		Launch: &mesos.Offer_Operation_Launch{
+			TaskInfos: taskInfos,
+		},
+	}))
+}
+
+func AcceptOfferOperations(h *Handler, offer *mesos.Offer, operations []*mesos.Offer_Operation) {
 	call := &sched.Call{
 		FrameworkId: h.Manager.SchedulerRef.MesosConnector.Framework.GetId(),
 		Type:        sched.Call_ACCEPT.Enum(),
@@ -57,22 +109,17 @@ func LaunchTaskInfos(h *Handler, offer *mesos.Offer, taskInfos []*mesos.TaskInfo
 			OfferIds: []*mesos.OfferID{
 				offer.GetId(),
 			},
-			Operations: []*mesos.Offer_Operation{
-				&mesos.Offer_Operation{
-					Type: mesos.Offer_Operation_LAUNCH.Enum(),
-					Launch: &mesos.Offer_Operation_Launch{
-						TaskInfos: taskInfos,
-					},
-				},
-			},
-			Filters: &mesos.Filters{RefuseSeconds: proto.Float64(1)},
+			Operations: operations,
+			Filters:    &mesos.Filters{RefuseSeconds: proto.Float64(h.Manager.SchedulerRef.OfferRefuseSeconds(false))},
 		},
 	}
 
+	metrics.OfferUsed()
 	h.Response.Calls = append(h.Response.Calls, call)
 }
 
-func RejectOffer(h *Handler, offer *mesos.Offer) {
+// decline the offer no pending slot matched, idle if no slot pending at all
+func RejectOffer(h *Handler, offer *mesos.Offer, idle bool) {
 	call := &sched.Call{
 		FrameworkId: h.Manager.SchedulerRef.MesosConnector.Framework.GetId(),
 		Type:        sched.Call_DECLINE.Enum(),
@@ -83,10 +130,11 @@ func RejectOffer(h *Handler, offer *mesos.Offer) {
 				},
 			},
 			Filters: &mesos.Filters{
-				RefuseSeconds: proto.Float64(1),
+				RefuseSeconds: proto.Float64(h.Manager.SchedulerRef.OfferRefuseSeconds(idle)),
 			},
 		},
 	}
 
+	metrics.OfferDeclined()
 	h.Response.Calls = append(h.Response.Calls, call)
 }
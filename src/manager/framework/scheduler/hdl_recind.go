@@ -1,10 +1,73 @@
 package scheduler
 
 import (
+	"strconv"
+	"strings"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+
 	"github.com/Sirupsen/logrus"
 )
 
 func RecindHandler(h *Handler) (*Handler, error) {
 	logrus.WithFields(logrus.Fields{"handler": "recind"}).Debugf("logger handler report got event type: %s", h.MesosEvent.EventType)
+
+	offerId := h.MesosEvent.Event.GetRescind().GetOfferId()
+	allocator := h.Manager.SchedulerRef.Allocator
+
+	slotIds := allocator.RetriveSlotIdsByOfferId(offerId)
+	allocator.DeleteSlotIdOfferIdMap(offerId)
+
+	for _, slotId := range slotIds {
+		slot := findSlotById(h, slotId)
+		if slot == nil {
+			logrus.Errorf("slot not found: %s", slotId)
+			continue
+		}
+
+		// task already got status from mesos, the offer was consumed before rescind
+		if !slot.StateIs(state.SLOT_STATE_PENDING_OFFER) || slot.OfferId != offerId.GetValue() {
+			continue
+		}
+
+		logrus.Infof("offer %s rescinded, relaunch slot %s", offerId.GetValue(), slot.Id)
+
+		h.Manager.SchedulerRef.EmitEvent(swanevent.NewEvent(swanevent.EventTypeOfferRescinded, &swanevent.OfferRescindInfo{
+			OfferId: offerId.GetValue(),
+			SlotId:  slot.Id,
+			TaskId:  slot.CurrentTask.TaskInfoId,
+		}))
+
+		slot.CurrentTask.Message = "offer rescinded before task launched"
+		slot.Archive()
+		slot.DispatchNewTask(slot.Version)
+	}
+
 	return h, nil
 }
+
+// slot id is formatted as index-appId-runAs-clusterId
+func findSlotById(h *Handler, slotId string) *state.Slot {
+	parts := strings.Split(slotId, "-")
+	if len(parts) < 2 {
+		return nil
+	}
+
+	slotIndex, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil
+	}
+
+	app := h.Manager.SchedulerRef.AppStorage.Get(parts[1])
+	if app == nil {
+		return nil
+	}
+
+	slot, found := app.GetSlot(slotIndex)
+	if !found || slot.Id != slotId {
+		return nil
+	}
+
+	return slot
+}
@@ -12,5 +12,12 @@ func SubscribedHandler(h *Handler) (*Handler, error) {
 		return nil, err
 	}
 
+	// suppression is reset by subscribing, suppressed again on next offers
+	// if nothing pending
+	h.Manager.SchedulerRef.offerFlow.Reset()
+
+	// slot states may drift while swan is disconnected or after failover
+	h.Manager.SchedulerRef.reconciler.Reconcile()
+
 	return h, nil
 }
@@ -11,11 +11,27 @@ import (
 	"github.com/Sirupsen/logrus"
 )
 
+// UNREACHABLE and UNKNOWN are not terminal in mesos, but the task is not
+// usable either, slot decides whether and when to replace it
+var terminalSlotStates = map[mesos.TaskState]string{
+	mesos.TaskState_TASK_FINISHED:         state.SLOT_STATE_TASK_FINISHED,
+	mesos.TaskState_TASK_FAILED:           state.SLOT_STATE_TASK_FAILED,
+	mesos.TaskState_TASK_KILLED:           state.SLOT_STATE_TASK_KILLED,
+	mesos.TaskState_TASK_ERROR:            state.SLOT_STATE_TASK_ERROR,
+	mesos.TaskState_TASK_LOST:             state.SLOT_STATE_TASK_LOST,
+	mesos.TaskState_TASK_DROPPED:          state.SLOT_STATE_TASK_DROPPED,
+	mesos.TaskState_TASK_UNREACHABLE:      state.SLOT_STATE_TASK_UNREACHABLE,
+	mesos.TaskState_TASK_GONE:             state.SLOT_STATE_TASK_GONE,
+	mesos.TaskState_TASK_GONE_BY_OPERATOR: state.SLOT_STATE_TASK_GONE_BY_OPERATOR,
+	mesos.TaskState_TASK_UNKNOWN:          state.SLOT_STATE_TASK_UNKNOWN,
+}
+
 func UpdateHandler(h *Handler) (*Handler, error) {
 	logrus.WithFields(logrus.Fields{"handler": "update"}).Debugf("logger handler report got event type: %s", h.MesosEvent.EventType)
 
 	taskStatus := h.MesosEvent.Event.GetUpdate().GetStatus()
 	AckUpdateEvent(h, taskStatus)
+	h.Manager.SchedulerRef.reconciler.Observe(taskStatus.TaskId.GetValue())
 
 	slotName := taskStatus.TaskId.GetValue()
 	taskState := taskStatus.GetState()
@@ -33,54 +49,57 @@ func UpdateHandler(h *Handler) (*Handler, error) {
 	app := h.Manager.SchedulerRef.AppStorage.Get(appId)
 	if app == nil {
 		logrus.Errorf("app not found: %s", appId)
+		KillUnknownTask(h, taskStatus)
 		return h, nil
 	}
 	logrus.Debugf("found app %s", app.AppId)
 
 	slot, found := app.GetSlot(int(slotIndex))
 	if !found {
-		logrus.Errorf("slot not found: %s", slotIndex)
+		logrus.Errorf("slot not found: %d", slotIndex)
+		KillUnknownTask(h, taskStatus)
 		return h, nil
 	}
 	logrus.Debugf("found slot %s", slot.Id)
 
-	slot.SetHealthy(healthy)
+	// task replaced already, e.g. relaunched after its offer got rescinded
+	if slot.CurrentTask == nil || slot.CurrentTask.TaskInfoId != slotName {
+		logrus.Infof("ignore status %s of stale task %s", taskState, slotName)
+		KillUnknownTask(h, taskStatus)
+		return h, nil
+	}
+
+	h.Manager.SchedulerRef.Allocator.DeleteOfferIdForSlotId(slot.Id)
+
+	// health reported only by tasks with health checks
+	if taskStatus.Healthy != nil {
+		slot.SetHealthy(healthy)
+	}
 
 	switch taskState {
 	case mesos.TaskState_TASK_STAGING:
+		slot.SetState(state.SLOT_STATE_TASK_STAGING)
 	case mesos.TaskState_TASK_STARTING:
+		slot.SetState(state.SLOT_STATE_TASK_STARTING)
 	case mesos.TaskState_TASK_RUNNING:
 		if !slot.StateIs(state.SLOT_STATE_TASK_RUNNING) { // set state to running only if is not previously marked as running
 			slot.SetState(state.SLOT_STATE_TASK_RUNNING)
 		}
+	case mesos.TaskState_TASK_KILLING:
+		slot.SetState(state.SLOT_STATE_TASK_KILLING)
+
+	default:
+		slotState, found := terminalSlotStates[taskState]
+		if !found {
+			logrus.Errorf("unexpected state %s of task %s", taskState, slotName)
+			break
+		}
 
-	case mesos.TaskState_TASK_FINISHED:
-		slot.CurrentTask.Reason = mesos.TaskStatus_Reason_name[int32(reason)]
-		slot.CurrentTask.Message = message
-		slot.CurrentTask.Source = mesos.TaskStatus_Source_name[int32(source)]
-
-		slot.SetState(state.SLOT_STATE_TASK_FINISHED)
-
-	case mesos.TaskState_TASK_FAILED:
-		slot.CurrentTask.Reason = mesos.TaskStatus_Reason_name[int32(reason)]
-		slot.CurrentTask.Message = message
-		slot.CurrentTask.Source = mesos.TaskStatus_Source_name[int32(source)]
-
-		slot.SetState(state.SLOT_STATE_TASK_FAILED)
-
-	case mesos.TaskState_TASK_KILLED:
-		slot.CurrentTask.Reason = mesos.TaskStatus_Reason_name[int32(reason)]
-		slot.CurrentTask.Message = message
-		slot.CurrentTask.Source = mesos.TaskStatus_Source_name[int32(source)]
-
-		slot.SetState(state.SLOT_STATE_TASK_KILLED)
-
-	case mesos.TaskState_TASK_LOST:
 		slot.CurrentTask.Reason = mesos.TaskStatus_Reason_name[int32(reason)]
 		slot.CurrentTask.Message = message
 		slot.CurrentTask.Source = mesos.TaskStatus_Source_name[int32(source)]
 
-		slot.SetState(state.SLOT_STATE_TASK_LOST)
+		slot.SetState(slotState)
 	}
 
 	h.Manager.SchedulerRef.InvalidateApps()
@@ -104,6 +123,29 @@ func AckUpdateEvent(h *Handler, taskStatus *mesos.TaskStatus) {
 	}
 }
 
+// tasks swan doesn't know about any more, reported by implicit reconciliation
+// or coming back from an unreachable agent after replaced, kill them if alive.
+func KillUnknownTask(h *Handler, taskStatus *mesos.TaskStatus) {
+	switch taskStatus.GetState() {
+	case mesos.TaskState_TASK_STAGING, mesos.TaskState_TASK_STARTING,
+		mesos.TaskState_TASK_RUNNING, mesos.TaskState_TASK_KILLING:
+	default:
+		return
+	}
+
+	logrus.Warnf("kill unknown task %s found by reconciliation", taskStatus.TaskId.GetValue())
+	call := &sched.Call{
+		FrameworkId: h.Manager.SchedulerRef.MesosConnector.Framework.GetId(),
+		Type:        sched.Call_KILL.Enum(),
+		Kill: &sched.Call_Kill{
+			TaskId:  taskStatus.GetTaskId(),
+			AgentId: taskStatus.GetAgentId(),
+		},
+	}
+
+	h.Response.Calls = append(h.Response.Calls, call)
+}
+
 //     	TaskState_TASK_STAGING  TaskState = 6
 //     	TaskState_TASK_STARTING TaskState = 0
 //     	TaskState_TASK_RUNNING  TaskState = 1
@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+func (scheduler *Scheduler) CreateJob(spec *types.Job) (*state.Job, error) {
+	scheduler.jobsLock.Lock()
+	defer scheduler.jobsLock.Unlock()
+
+	if scheduler.AppStorage.Get(spec.ID) != nil {
+		return nil, errors.New("job or app with the same id already exists")
+	}
+
+	usage, err := state.JobUsage(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scheduler.validateRole(spec.Template); err != nil {
+		return nil, err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if err := scheduler.checkQuota(spec.ID, usage); err != nil {
+		return nil, err
+	}
+
+	job, err := state.NewJob(spec, scheduler.Allocator, scheduler.scontext)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler.AppStorage.Add(job.JobId, job.App)
+	scheduler.jobs[job.JobId] = job
+
+	return job, nil
+}
+
+func (scheduler *Scheduler) InspectJob(jobId string) (*state.Job, error) {
+	scheduler.jobsLock.RLock()
+	defer scheduler.jobsLock.RUnlock()
+
+	job, found := scheduler.jobs[jobId]
+	if !found {
+		return nil, errors.New("job not exists")
+	}
+
+	return job, nil
+}
+
+func (scheduler *Scheduler) ListJobs() []*state.Job {
+	scheduler.jobsLock.RLock()
+	defer scheduler.jobsLock.RUnlock()
+
+	jobs := make([]*state.Job, 0)
+	for _, job := range scheduler.jobs {
+		jobs = append(jobs, job)
+	}
+
+	return jobs
+}
+
+func (scheduler *Scheduler) DeleteJob(jobId string) error {
+	scheduler.jobsLock.Lock()
+	defer scheduler.jobsLock.Unlock()
+
+	job, found := scheduler.jobs[jobId]
+	if !found {
+		return errors.New("job not exists")
+	}
+
+	if err := job.Delete(); err != nil {
+		return err
+	}
+
+	delete(scheduler.jobs, jobId)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/metrics"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// OfferFlow sends SUPPRESS to mesos once no slot is pending and REVIVE when
+// slots are queued again, which also clears the filters of declined offers.
+// mesos forgets about suppression when swan subscribes again.
+type OfferFlow struct {
+	scheduler *Scheduler
+
+	lock       sync.Mutex
+	suppressed bool
+}
+
+func NewOfferFlow(scheduler *Scheduler) *OfferFlow {
+	return &OfferFlow{
+		scheduler: scheduler,
+	}
+}
+
+func (flow *OfferFlow) Suppress() {
+	flow.lock.Lock()
+	defer flow.lock.Unlock()
+
+	if flow.suppressed {
+		return
+	}
+
+	logrus.Infof("no slot pending, suppress offers")
+	flow.send(sched.Call_SUPPRESS)
+	flow.setSuppressed(true)
+}
+
+func (flow *OfferFlow) Revive() {
+	flow.lock.Lock()
+	defer flow.lock.Unlock()
+
+	if !flow.suppressed {
+		return
+	}
+
+	logrus.Infof("slots pending, revive offers")
+	flow.send(sched.Call_REVIVE)
+	flow.setSuppressed(false)
+}
+
+// framework subscribed again, offers flow until suppressed again
+func (flow *OfferFlow) Reset() {
+	flow.lock.Lock()
+	defer flow.lock.Unlock()
+
+	flow.setSuppressed(false)
+}
+
+func (flow *OfferFlow) setSuppressed(suppressed bool) {
+	flow.suppressed = suppressed
+	metrics.OffersSuppressed(suppressed)
+}
+
+func (flow *OfferFlow) send(callType sched.Call_Type) {
+	call := &sched.Call{
+		FrameworkId: flow.scheduler.MesosConnector.Framework.GetId(),
+		Type:        callType.Enum(),
+	}
+
+	flow.scheduler.MesosConnector.MesosCallChan <- call
+}
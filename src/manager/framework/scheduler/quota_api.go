@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"golang.org/x/net/context"
+)
+
+func (scheduler *Scheduler) CreateQuota(quota *types.Quota) error {
+	if err := state.ValidateQuota(quota); err != nil {
+		return err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if _, found := scheduler.quotas[quota.RunAs]; found {
+		return errors.New("quota of the runAs already exists")
+	}
+
+	if err := scheduler.store.CreateQuota(context.TODO(), state.QuotaToRaft(quota), nil); err != nil {
+		return err
+	}
+
+	scheduler.quotas[quota.RunAs] = quota
+
+	return nil
+}
+
+// apps over the updated quota keep running, they are only refused to grow
+func (scheduler *Scheduler) UpdateQuota(quota *types.Quota) error {
+	if err := state.ValidateQuota(quota); err != nil {
+		return err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if _, found := scheduler.quotas[quota.RunAs]; !found {
+		return errors.New("quota not exists")
+	}
+
+	if err := scheduler.store.UpdateQuota(context.TODO(), state.QuotaToRaft(quota), nil); err != nil {
+		return err
+	}
+
+	scheduler.quotas[quota.RunAs] = quota
+
+	return nil
+}
+
+func (scheduler *Scheduler) InspectQuota(runAs string) (*types.Quota, error) {
+	scheduler.quotasLock.RLock()
+	defer scheduler.quotasLock.RUnlock()
+
+	quota, found := scheduler.quotas[runAs]
+	if !found {
+		return nil, errors.New("quota not exists")
+	}
+
+	return quota, nil
+}
+
+func (scheduler *Scheduler) ListQuotas() []*types.Quota {
+	scheduler.quotasLock.RLock()
+	defer scheduler.quotasLock.RUnlock()
+
+	quotas := make([]*types.Quota, 0)
+	for _, quota := range scheduler.quotas {
+		quotas = append(quotas, quota)
+	}
+
+	return quotas
+}
+
+func (scheduler *Scheduler) DeleteQuota(runAs string) error {
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if _, found := scheduler.quotas[runAs]; !found {
+		return errors.New("quota not exists")
+	}
+
+	if err := scheduler.store.DeleteQuota(context.TODO(), runAs, nil); err != nil {
+		return err
+	}
+
+	delete(scheduler.quotas, runAs)
+
+	return nil
+}
+
+// resources taken by the apps running as the runAs
+func (scheduler *Scheduler) QuotaUsage(runAs string) *types.Quota {
+	return scheduler.usageExcept(runAs, "")
+}
+
+func (scheduler *Scheduler) usageExcept(runAs, appId string) *types.Quota {
+	usage := &types.Quota{RunAs: runAs}
+	for _, app := range scheduler.AppStorage.Filter(AppFilterOptions{}) {
+		if app.AppId == appId || app.CurrentVersion.RunAs != runAs {
+			continue
+		}
+
+		state.AddUsage(usage, app.Usage())
+	}
+
+	return usage
+}
+
+// check the app taking the usage given fits the quota of its runAs along
+// with the other apps, the caller holds the quotas lock till the app admitted
+func (scheduler *Scheduler) checkQuota(appId string, usage *types.Quota) error {
+	quota, found := scheduler.quotas[usage.RunAs]
+	if !found {
+		return nil
+	}
+
+	total := scheduler.usageExcept(usage.RunAs, appId)
+	state.AddUsage(total, usage)
+
+	return state.CheckQuota(quota, total)
+}
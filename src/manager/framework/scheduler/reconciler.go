@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	RECONCILE_INTERVAL        = 10 * time.Minute
+	RECONCILE_INITIAL_BACKOFF = 15 * time.Second
+	RECONCILE_BACKOFF_FACTOR  = 2
+	RECONCILE_MAX_BACKOFF     = 5 * time.Minute
+)
+
+// Reconciler follows the reconciliation algorithm recommended by mesos:
+// explicitly reconcile all the known tasks, retry with backoff for those
+// mesos haven't sent a status update for, then implicitly reconcile to
+// learn about the tasks swan doesn't know. status updates sent by mesos
+// go through UpdateHandler as any other update.
+type Reconciler struct {
+	scheduler *Scheduler
+
+	lock    sync.Mutex
+	pending map[string]*sched.Call_Reconcile_Task // by task id
+	backoff time.Duration
+	timer   *time.Timer
+}
+
+func NewReconciler(scheduler *Scheduler) *Reconciler {
+	return &Reconciler{
+		scheduler: scheduler,
+		pending:   make(map[string]*sched.Call_Reconcile_Task),
+	}
+}
+
+// start a new round of reconciliation, abort the running one if any
+func (r *Reconciler) Reconcile() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.stopTimer()
+
+	r.pending = make(map[string]*sched.Call_Reconcile_Task)
+	for _, app := range r.scheduler.AppStorage.Data() {
+		for _, slot := range app.GetSlots() {
+			if slot.CurrentTask == nil || !slot.Placed() {
+				continue
+			}
+
+			r.pending[slot.CurrentTask.TaskInfoId] = &sched.Call_Reconcile_Task{
+				TaskId:  &mesos.TaskID{Value: proto.String(slot.CurrentTask.TaskInfoId)},
+				AgentId: &mesos.AgentID{Value: proto.String(slot.CurrentTask.AgentId)},
+			}
+		}
+	}
+
+	logrus.Infof("start reconciliation of %d tasks", len(r.pending))
+
+	r.backoff = RECONCILE_INITIAL_BACKOFF
+	r.reconcilePending()
+}
+
+// task got a status update, no need to reconcile it any more
+func (r *Reconciler) Observe(taskId string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, found := r.pending[taskId]; !found {
+		return
+	}
+
+	delete(r.pending, taskId)
+	if len(r.pending) == 0 {
+		r.stopTimer()
+		r.reconcileImplicitly()
+	}
+}
+
+func (r *Reconciler) Stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.stopTimer()
+	r.pending = make(map[string]*sched.Call_Reconcile_Task)
+}
+
+func (r *Reconciler) retry() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.timer = nil
+	if len(r.pending) == 0 {
+		return
+	}
+
+	r.backoff = r.backoff * RECONCILE_BACKOFF_FACTOR
+	if r.backoff > RECONCILE_MAX_BACKOFF {
+		r.backoff = RECONCILE_MAX_BACKOFF
+	}
+
+	logrus.Infof("%d tasks not reconciled yet, retry", len(r.pending))
+	r.reconcilePending()
+}
+
+// lock must be held by caller
+func (r *Reconciler) reconcilePending() {
+	if len(r.pending) == 0 {
+		r.reconcileImplicitly()
+		return
+	}
+
+	tasks := make([]*sched.Call_Reconcile_Task, 0)
+	for _, task := range r.pending {
+		tasks = append(tasks, task)
+	}
+
+	r.send(tasks)
+	r.timer = time.AfterFunc(r.backoff, r.retry)
+}
+
+// lock must be held by caller
+func (r *Reconciler) reconcileImplicitly() {
+	logrus.Infof("known tasks reconciled, reconcile implicitly")
+	r.send(nil)
+}
+
+// lock must be held by caller
+func (r *Reconciler) stopTimer() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+}
+
+func (r *Reconciler) send(tasks []*sched.Call_Reconcile_Task) {
+	call := &sched.Call{
+		FrameworkId: r.scheduler.MesosConnector.Framework.GetId(),
+		Type:        sched.Call_RECONCILE.Enum(),
+		Reconcile: &sched.Call_Reconcile{
+			Tasks: tasks,
+		},
+	}
+
+	r.scheduler.MesosConnector.MesosCallChan <- call
+}
@@ -0,0 +1,370 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	rafttypes "github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/Dataman-Cloud/swan/src/utils"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+)
+
+const (
+	RESERVATION_ACTION_RESERVE   = "reserve"
+	RESERVATION_ACTION_UNRESERVE = "unreserve"
+	RESERVATION_ACTION_DESTROY   = "destroy"
+)
+
+// ReservationOperation waits for an offer of the agent allocated to the role
+// with enough resources, which is then accepted with the operation. destroy
+// operation waits till the app the volumes belonged to is gone and for an
+// offer of all its volumes, which are destroyed and their disk unreserved.
+type ReservationOperation struct {
+	Id      string
+	AppId   string
+	Action  string
+	Spec    *types.Reservation
+	Volumes []*state.SlotVolume
+	Created time.Time
+}
+
+// Reserver keeps the RESERVE, UNRESERVE and DESTROY operations requested until they
+// are applied, offers keep flowing while any of them pending. only DESTROY
+// operations are persisted, pending RESERVE and UNRESERVE are lost on leader
+// change.
+type Reserver struct {
+	scheduler *Scheduler
+
+	lock    sync.Mutex
+	pending []*ReservationOperation
+}
+
+func NewReserver(scheduler *Scheduler) *Reserver {
+	return &Reserver{
+		scheduler: scheduler,
+		pending:   make([]*ReservationOperation, 0),
+	}
+}
+
+func (r *Reserver) Reserve(spec *types.Reservation) (*ReservationOperation, error) {
+	return r.enqueue(RESERVATION_ACTION_RESERVE, spec)
+}
+
+func (r *Reserver) Unreserve(spec *types.Reservation) (*ReservationOperation, error) {
+	return r.enqueue(RESERVATION_ACTION_UNRESERVE, spec)
+}
+
+// destroy persistent volumes the app created for the role once the app is
+// gone, one operation per agent
+func (r *Reserver) DestroyVolumes(appId, role string, volumes []*state.SlotVolume) error {
+	byAgent := make(map[string]*ReservationOperation)
+	operations := make([]*ReservationOperation, 0)
+	for _, volume := range volumes {
+		operation, ok := byAgent[volume.AgentId]
+		if !ok {
+			operation = &ReservationOperation{
+				Id:      uuid.NewV4().String(),
+				AppId:   appId,
+				Action:  RESERVATION_ACTION_DESTROY,
+				Spec:    &types.Reservation{AgentId: volume.AgentId, Role: role},
+				Volumes: make([]*state.SlotVolume, 0),
+				Created: time.Now(),
+			}
+			byAgent[volume.AgentId] = operation
+			operations = append(operations, operation)
+		}
+
+		operation.Volumes = append(operation.Volumes, volume)
+		operation.Spec.Disk += volume.Size
+	}
+
+	for _, operation := range operations {
+		if err := r.scheduler.store.CreateVolumesDestroy(context.TODO(), volumesDestroyToRaft(operation), nil); err != nil {
+			return err
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.pending = append(r.pending, operations...)
+	if len(operations) > 0 {
+		r.scheduler.offerFlow.Revive()
+		logrus.Infof("destroy %d persistent volumes of app %s for role %s pending", len(volumes), appId, role)
+	}
+
+	return nil
+}
+
+// reload the DESTROY operations persisted
+func (r *Reserver) Recover() error {
+	raftVolumesDestroys, err := r.scheduler.store.ListVolumesDestroys()
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, raftVolumesDestroy := range raftVolumesDestroys {
+		r.pending = append(r.pending, volumesDestroyFromRaft(raftVolumesDestroy))
+	}
+
+	return nil
+}
+
+func (r *Reserver) enqueue(action string, spec *types.Reservation) (*ReservationOperation, error) {
+	if len(spec.AgentId) == 0 {
+		return nil, errors.New("agent id of reservation required")
+	}
+
+	roles := r.scheduler.config.Scheduler.MesosRoles
+	if !utils.SliceContains(roles, spec.Role) {
+		return nil, errors.New(fmt.Sprintf("role %s is not one of the mesos roles %v", spec.Role, roles))
+	}
+
+	if spec.Cpus < 0 || spec.Mem < 0 || spec.Disk < 0 || spec.Cpus+spec.Mem+spec.Disk == 0 {
+		return nil, errors.New("cpus, mem or disk of reservation required and should not be negative")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	operation := &ReservationOperation{
+		Action:  action,
+		Spec:    spec,
+		Created: time.Now(),
+	}
+	r.pending = append(r.pending, operation)
+	r.scheduler.offerFlow.Revive()
+
+	logrus.Infof("%s %.2f cpus %.2f mem %.2f disk on agent %s for role %s pending",
+		action, spec.Cpus, spec.Mem, spec.Disk, spec.AgentId, spec.Role)
+
+	return operation, nil
+}
+
+func (r *Reserver) Pending() []*ReservationOperation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]*ReservationOperation{}, r.pending...)
+}
+
+// Operate takes the first operation pending the offer satisfies, nil if none
+func (r *Reserver) Operate(ow *state.OfferWrapper) []*mesos.Offer_Operation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for index, operation := range r.pending {
+		if operation.Spec.AgentId != ow.Offer.GetAgentId().GetValue() || operation.Spec.Role != ow.Role() {
+			continue
+		}
+
+		var resources []*mesos.Resource
+		if operation.Action == RESERVATION_ACTION_DESTROY {
+			// tasks of the app may still use the volumes
+			if r.scheduler.AppStorage.Get(operation.AppId) != nil {
+				continue
+			}
+
+			resources = offeredVolumes(ow.Offer, operation.Volumes)
+		} else {
+			resources = r.operatedResources(ow.Offer, operation)
+		}
+		if resources == nil {
+			continue
+		}
+
+		r.pending = append(r.pending[:index], r.pending[index+1:]...)
+		logrus.Infof("%s resources on agent %s for role %s with offer %s", operation.Action,
+			operation.Spec.AgentId, operation.Spec.Role, ow.Offer.GetId().GetValue())
+
+		switch operation.Action {
+		case RESERVATION_ACTION_DESTROY:
+			if err := r.scheduler.store.DeleteVolumesDestroy(context.TODO(), operation.Id, nil); err != nil {
+				logrus.Errorf("delete volumes destroy %s failed: %s", operation.Id, err)
+			}
+
+			return []*mesos.Offer_Operation{
+				&mesos.Offer_Operation{
+					Type:    mesos.Offer_Operation_DESTROY.Enum(),
+					Destroy: &mesos.Offer_Operation_Destroy{Volumes: resources},
+				},
+				&mesos.Offer_Operation{
+					Type:      mesos.Offer_Operation_UNRESERVE.Enum(),
+					Unreserve: &mesos.Offer_Operation_Unreserve{Resources: volumesDisk(resources)},
+				},
+			}
+
+		case RESERVATION_ACTION_UNRESERVE:
+			return []*mesos.Offer_Operation{
+				&mesos.Offer_Operation{
+					Type:      mesos.Offer_Operation_UNRESERVE.Enum(),
+					Unreserve: &mesos.Offer_Operation_Unreserve{Resources: resources},
+				},
+			}
+		}
+
+		return []*mesos.Offer_Operation{
+			&mesos.Offer_Operation{
+				Type:    mesos.Offer_Operation_RESERVE.Enum(),
+				Reserve: &mesos.Offer_Operation_Reserve{Resources: resources},
+			},
+		}
+	}
+
+	return nil
+}
+
+// suppress offers once neither operations nor slots pending
+func (r *Reserver) SuppressOffersIfIdle(allocator *state.OfferAllocator) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.pending) == 0 {
+		allocator.SuppressOffersIfDrained()
+	}
+}
+
+// unreserved resources of the offer to reserve, or resources dynamically
+// reserved for the role to unreserve, nil if the offer hasn't enough
+func (r *Reserver) operatedResources(offer *mesos.Offer, operation *ReservationOperation) []*mesos.Resource {
+	unreserve := operation.Action == RESERVATION_ACTION_UNRESERVE
+	spec := operation.Spec
+
+	resources := make([]*mesos.Resource, 0)
+	for _, scalar := range []struct {
+		name   string
+		amount float64
+	}{{"cpus", spec.Cpus}, {"mem", spec.Mem}, {"disk", spec.Disk}} {
+		amount := scalar.amount
+		for _, res := range offer.GetResources() {
+			if amount <= 0 {
+				break
+			}
+
+			if res.GetName() != scalar.name || res.GetType() != mesos.Value_SCALAR || res.Disk != nil {
+				continue
+			}
+
+			if unreserve && (res.GetRole() != spec.Role || res.Reservation == nil) ||
+				!unreserve && res.GetRole() != mesos.Default_Resource_Role {
+				continue
+			}
+
+			value := math.Min(amount, res.GetScalar().GetValue())
+			amount -= value
+
+			piece := &mesos.Resource{
+				Name:           res.Name,
+				Type:           res.Type,
+				Scalar:         &mesos.Value_Scalar{Value: proto.Float64(value)},
+				Role:           res.Role,
+				Reservation:    res.Reservation,
+				AllocationInfo: res.AllocationInfo,
+			}
+
+			if !unreserve {
+				piece.Role = proto.String(spec.Role)
+				piece.Reservation = &mesos.Resource_ReservationInfo{}
+				if principal := r.scheduler.MesosConnector.Framework.GetPrincipal(); len(principal) > 0 {
+					piece.Reservation.Principal = proto.String(principal)
+				}
+			}
+
+			resources = append(resources, piece)
+		}
+
+		if amount > 0 {
+			return nil
+		}
+	}
+
+	return resources
+}
+
+// resources of the volumes offered, nil unless the offer has all of them.
+// pending volumes not offered were never created.
+func offeredVolumes(offer *mesos.Offer, volumes []*state.SlotVolume) []*mesos.Resource {
+	resources := make([]*mesos.Resource, 0)
+	for _, volume := range volumes {
+		res := state.OfferedVolume(offer, volume.Id)
+		if res == nil {
+			if volume.Pending {
+				continue
+			}
+			return nil
+		}
+		resources = append(resources, res)
+	}
+
+	if len(resources) == 0 {
+		return nil
+	}
+
+	return resources
+}
+
+// reserved disk the volumes destroyed leave behind
+func volumesDisk(volumes []*mesos.Resource) []*mesos.Resource {
+	resources := make([]*mesos.Resource, 0)
+	for _, res := range volumes {
+		resources = append(resources, &mesos.Resource{
+			Name:           res.Name,
+			Type:           res.Type,
+			Scalar:         res.Scalar,
+			Role:           res.Role,
+			Reservation:    res.Reservation,
+			AllocationInfo: res.AllocationInfo,
+		})
+	}
+
+	return resources
+}
+
+func volumesDestroyToRaft(operation *ReservationOperation) *rafttypes.VolumesDestroy {
+	raftVolumesDestroy := &rafttypes.VolumesDestroy{
+		Id:        operation.Id,
+		AppId:     operation.AppId,
+		Role:      operation.Spec.Role,
+		AgentId:   operation.Spec.AgentId,
+		Volumes:   make([]*rafttypes.SlotVolume, 0),
+		CreatedAt: operation.Created.UnixNano(),
+	}
+
+	for _, volume := range operation.Volumes {
+		raftVolumesDestroy.Volumes = append(raftVolumesDestroy.Volumes, state.SlotVolumeToRaft(volume))
+	}
+
+	return raftVolumesDestroy
+}
+
+func volumesDestroyFromRaft(raftVolumesDestroy *rafttypes.VolumesDestroy) *ReservationOperation {
+	operation := &ReservationOperation{
+		Id:      raftVolumesDestroy.Id,
+		AppId:   raftVolumesDestroy.AppId,
+		Action:  RESERVATION_ACTION_DESTROY,
+		Spec:    &types.Reservation{AgentId: raftVolumesDestroy.AgentId, Role: raftVolumesDestroy.Role},
+		Volumes: make([]*state.SlotVolume, 0),
+		Created: time.Unix(0, raftVolumesDestroy.CreatedAt),
+	}
+
+	for _, raftVolume := range raftVolumesDestroy.Volumes {
+		volume := state.SlotVolumeFromRaft(raftVolume)
+		operation.Volumes = append(operation.Volumes, volume)
+		operation.Spec.Disk += volume.Size
+	}
+
+	return operation
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/state"
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+func (scheduler *Scheduler) CreateScalingSchedule(spec *types.ScalingSchedule) (*state.ScalingSchedule, error) {
+	scheduler.scalingSchedulesLock.Lock()
+	defer scheduler.scalingSchedulesLock.Unlock()
+
+	if _, found := scheduler.scalingSchedules[spec.ID]; found {
+		return nil, errors.New("scaling schedule with the same id already exists")
+	}
+
+	if err := scheduler.validateScaledApp(spec.AppId); err != nil {
+		return nil, err
+	}
+
+	scalingSchedule, err := state.NewScalingSchedule(spec, scheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler.scalingSchedules[scalingSchedule.ScheduleId] = scalingSchedule
+
+	return scalingSchedule, nil
+}
+
+func (scheduler *Scheduler) InspectScalingSchedule(scheduleId string) (*state.ScalingSchedule, error) {
+	scheduler.scalingSchedulesLock.RLock()
+	defer scheduler.scalingSchedulesLock.RUnlock()
+
+	scalingSchedule, found := scheduler.scalingSchedules[scheduleId]
+	if !found {
+		return nil, errors.New("scaling schedule not exists")
+	}
+
+	return scalingSchedule, nil
+}
+
+// scaling schedules of the app, of all apps if no app given
+func (scheduler *Scheduler) ListScalingSchedules(appId string) []*state.ScalingSchedule {
+	scheduler.scalingSchedulesLock.RLock()
+	defer scheduler.scalingSchedulesLock.RUnlock()
+
+	scalingSchedules := make([]*state.ScalingSchedule, 0)
+	for _, scalingSchedule := range scheduler.scalingSchedules {
+		if len(appId) == 0 || scalingSchedule.Spec.AppId == appId {
+			scalingSchedules = append(scalingSchedules, scalingSchedule)
+		}
+	}
+
+	return scalingSchedules
+}
+
+func (scheduler *Scheduler) DeleteScalingSchedule(scheduleId string) error {
+	scheduler.scalingSchedulesLock.Lock()
+	defer scheduler.scalingSchedulesLock.Unlock()
+
+	scalingSchedule, found := scheduler.scalingSchedules[scheduleId]
+	if !found {
+		return errors.New("scaling schedule not exists")
+	}
+
+	if err := scalingSchedule.Delete(); err != nil {
+		return err
+	}
+
+	delete(scheduler.scalingSchedules, scheduleId)
+
+	return nil
+}
+
+// runs of the scaling schedules of the app to come in the period, of all
+// apps if no app given, earliest first and no more than limit
+func (scheduler *Scheduler) PlanScaling(appId string, period time.Duration, limit int) []*state.PlannedScaling {
+	until := time.Now().Add(period)
+
+	planned := make([]*state.PlannedScaling, 0)
+	for _, scalingSchedule := range scheduler.ListScalingSchedules(appId) {
+		planned = append(planned, scalingSchedule.Plan(until, limit)...)
+	}
+
+	state.SortPlannedScalings(planned)
+	if len(planned) > limit {
+		planned = planned[:limit]
+	}
+
+	return planned
+}
+
+// bounds of the autoscaler of the app replaced by the ones given, nothing
+// to do if the app is not autoscaled
+func (scheduler *Scheduler) BoundAutoscaler(appId string, minInstances, maxInstances int32) error {
+	autoscaler, err := scheduler.InspectAutoscaler(appId)
+	if err != nil {
+		return nil
+	}
+
+	policy := *autoscaler.Spec
+	if minInstances > 0 {
+		policy.MinInstances = minInstances
+	}
+
+	if maxInstances > 0 {
+		policy.MaxInstances = maxInstances
+	}
+
+	if policy.MinInstances == autoscaler.Spec.MinInstances && policy.MaxInstances == autoscaler.Spec.MaxInstances {
+		return nil
+	}
+
+	_, err = scheduler.UpdateAutoscaler(&policy)
+	return err
+}
+
+// scaling schedules are applied by the leader only
+func (scheduler *Scheduler) stopScalingSchedules() {
+	scheduler.scalingSchedulesLock.RLock()
+	defer scheduler.scalingSchedulesLock.RUnlock()
+
+	for _, scalingSchedule := range scheduler.scalingSchedules {
+		scalingSchedule.Stop()
+	}
+}
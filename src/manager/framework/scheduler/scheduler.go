@@ -1,6 +1,9 @@
 package scheduler
 
 import (
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/Dataman-Cloud/swan/src/config"
@@ -12,6 +15,8 @@ import (
 	"github.com/Dataman-Cloud/swan/src/manager/swancontext"
 	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
 	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/Dataman-Cloud/swan/src/utils"
 
 	"github.com/Sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -22,13 +27,34 @@ type Scheduler struct {
 	heartbeater      *time.Ticker
 	mesosFailureChan chan error
 
+	reconciler      *Reconciler
+	reconcileTicker *time.Ticker
+
 	handlerManager *HandlerManager
 
 	stopC chan struct{}
 
 	AppStorage *memoryStore
 
+	jobs     map[string]*state.Job
+	jobsLock sync.RWMutex
+
+	cronJobs     map[string]*state.CronJob
+	cronJobsLock sync.RWMutex
+
+	quotas     map[string]*types.Quota
+	quotasLock sync.RWMutex
+
+	autoscalers      map[string]*state.Autoscaler
+	autoscalersLock  sync.RWMutex
+	autoscaleMetrics *autoscaleMetrics
+
+	scalingSchedules     map[string]*state.ScalingSchedule
+	scalingSchedulesLock sync.RWMutex
+
 	Allocator      *state.OfferAllocator
+	offerFlow      *OfferFlow
+	Reserver       *Reserver
 	MesosConnector *mesos_connector.MesosConnector
 	store          store.Store
 	config         config.SwanConfig
@@ -40,16 +66,25 @@ func NewScheduler(config config.SwanConfig, scontext *swancontext.SwanContext, s
 		heartbeater:    time.NewTicker(10 * time.Second),
 		scontext:       scontext,
 
+		reconcileTicker: time.NewTicker(RECONCILE_INTERVAL),
+
 		AppStorage: NewMemoryStore(),
+		jobs:       make(map[string]*state.Job),
+		cronJobs:   make(map[string]*state.CronJob),
+		quotas:     make(map[string]*types.Quota),
 		store:      store,
 		config:     config,
+
+		autoscalers:      make(map[string]*state.Autoscaler),
+		autoscaleMetrics: newAutoscaleMetrics(config.Scheduler),
+		scalingSchedules: make(map[string]*state.ScalingSchedule),
 	}
 
 	RegiserFun := func(m *HandlerManager) {
 		m.Register(sched.Event_SUBSCRIBED, LoggerHandler, SubscribedHandler)
 		m.Register(sched.Event_HEARTBEAT, LoggerHandler, DummyHandler)
 		m.Register(sched.Event_OFFERS, LoggerHandler, OfferHandler, DummyHandler)
-		m.Register(sched.Event_RESCIND, LoggerHandler, DummyHandler)
+		m.Register(sched.Event_RESCIND, LoggerHandler, RecindHandler, DummyHandler)
 		m.Register(sched.Event_UPDATE, LoggerHandler, UpdateHandler, DummyHandler)
 		m.Register(sched.Event_FAILURE, LoggerHandler, DummyHandler)
 		m.Register(sched.Event_MESSAGE, LoggerHandler, DummyHandler)
@@ -58,6 +93,10 @@ func NewScheduler(config config.SwanConfig, scontext *swancontext.SwanContext, s
 
 	scheduler.handlerManager = NewHanlderManager(scheduler, RegiserFun)
 	scheduler.Allocator = state.NewOfferAllocator()
+	scheduler.offerFlow = NewOfferFlow(scheduler)
+	scheduler.Allocator.SetOfferFlow(scheduler.offerFlow)
+	scheduler.Reserver = NewReserver(scheduler)
+	scheduler.reconciler = NewReconciler(scheduler)
 
 	state.SetStore(store)
 
@@ -82,6 +121,48 @@ func (scheduler *Scheduler) Start(ctx context.Context) error {
 		for _, app := range apps {
 			scheduler.AppStorage.Add(app.AppId, app)
 		}
+
+		jobs, err := state.LoadJobData(apps)
+		if err != nil {
+			return err
+		}
+
+		scheduler.jobs = jobs
+
+		cronJobs, err := state.LoadCronJobData(scheduler)
+		if err != nil {
+			return err
+		}
+
+		scheduler.cronJobs = cronJobs
+
+		raftQuotas, err := scheduler.store.ListQuotas()
+		if err != nil {
+			return err
+		}
+
+		for _, raftQuota := range raftQuotas {
+			quota := state.QuotaFromRaft(raftQuota)
+			scheduler.quotas[quota.RunAs] = quota
+		}
+
+		autoscalers, err := state.LoadAutoscalerData(scheduler, scheduler.autoscaleMetrics)
+		if err != nil {
+			return err
+		}
+
+		scheduler.autoscalers = autoscalers
+
+		scalingSchedules, err := state.LoadScalingScheduleData(scheduler)
+		if err != nil {
+			return err
+		}
+
+		scheduler.scalingSchedules = scalingSchedules
+
+		if err := scheduler.Reserver.Recover(); err != nil {
+			return err
+		}
 	}
 
 	// temp solution
@@ -125,9 +206,29 @@ func (scheduler *Scheduler) Run(ctx context.Context) error {
 			logrus.WithFields(logrus.Fields{"failure": "yes"}).Debugf("%s", e)
 
 		case <-scheduler.heartbeater.C: // heartbeat timeout for now
+			// proceed unattended rolling updates waiting for UpdateDelay
+			for _, app := range scheduler.AppStorage.Data() {
+				app.AdvanceUpdate()
+			}
+
+		case <-scheduler.reconcileTicker.C:
+			scheduler.reconciler.Reconcile()
+
+		case report := <-state.HealthReports():
+			report.Apply()
+
+		case report := <-state.ReadinessReports():
+			report.Apply()
+
+		case deadline := <-state.JobDeadlines():
+			deadline.Apply()
 
 		case <-scheduler.stopC:
 			logrus.Infof("stopping main scheduler")
+			scheduler.reconciler.Stop()
+			scheduler.stopCronJobs()
+			scheduler.stopAutoscalers()
+			scheduler.stopScalingSchedules()
 			return nil
 		}
 	}
@@ -151,6 +252,55 @@ func (scheduler *Scheduler) InvalidateApps() {
 	}
 }
 
+// placement strategy of the slot, app's own choice overrides the cluster default
+func (scheduler *Scheduler) PlacementStrategy(slot *state.Slot) state.PlacementStrategy {
+	name := scheduler.config.Scheduler.PlacementStrategy
+	if len(slot.Version.PlacementStrategy) > 0 {
+		name = slot.Version.PlacementStrategy
+	}
+
+	strategy, err := state.NewPlacementStrategy(name)
+	if err != nil {
+		logrus.Errorf("%s, fallback to %s", err, state.DEFAULT_PLACEMENT_STRATEGY)
+		strategy, _ = state.NewPlacementStrategy(state.DEFAULT_PLACEMENT_STRATEGY)
+	}
+
+	return strategy
+}
+
+// filter of offers declined, longer while nothing pending as REVIVE clears it
+// once slots are queued
+func (scheduler *Scheduler) OfferRefuseSeconds(idle bool) float64 {
+	if idle {
+		if scheduler.config.Scheduler.IdleOfferRefuseSeconds > 0 {
+			return scheduler.config.Scheduler.IdleOfferRefuseSeconds
+		}
+		return DEFAULT_IDLE_OFFER_REFUSE_SECONDS
+	}
+
+	if scheduler.config.Scheduler.OfferRefuseSeconds > 0 {
+		return scheduler.config.Scheduler.OfferRefuseSeconds
+	}
+	return DEFAULT_OFFER_REFUSE_SECONDS
+}
+
+// RunAs is the mesos role apps run by if swan registered with roles
+func (scheduler *Scheduler) validateRole(version *types.Version) error {
+	roles := scheduler.config.Scheduler.MesosRoles
+	if version == nil || utils.SliceContains(roles, version.RunAs) {
+		return nil
+	}
+
+	if len(roles) == 0 {
+		if len(state.PersistentVolumes(version)) > 0 {
+			return errors.New("persistent volumes require swan registered with mesos roles")
+		}
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("runAs %s is not one of the mesos roles %v", version.RunAs, roles))
+}
+
 func (scheduler *Scheduler) EmitEvent(swanEvent *swanevent.Event) {
 	scheduler.scontext.EventBus.EventChan <- swanEvent
 }
@@ -13,6 +13,21 @@ func (scheduler *Scheduler) CreateApp(version *types.Version) (*state.App, error
 		return nil, errors.New("app already exists")
 	}
 
+	if version.Mode == string(state.APP_MODE_JOB) {
+		return nil, errors.New("batch jobs should be created as jobs")
+	}
+
+	if err := scheduler.validateRole(version); err != nil {
+		return nil, err
+	}
+
+	scheduler.quotasLock.Lock() // admitted one at a time
+	defer scheduler.quotasLock.Unlock()
+
+	if err := scheduler.checkQuota(version.AppId, state.VersionUsage(version)); err != nil {
+		return nil, err
+	}
+
 	app, err := state.NewApp(version, scheduler.Allocator, scheduler.scontext)
 	if err != nil {
 		return nil, err
@@ -31,18 +46,41 @@ func (scheduler *Scheduler) InspectApp(appId string) (*state.App, error) {
 	return app, nil
 }
 
-func (scheduler *Scheduler) DeleteApp(appId string) error {
+// persistent volumes of the app are kept unless asked to destroy them
+func (scheduler *Scheduler) DeleteApp(appId string, destroyVolumes bool) error {
 	app := scheduler.AppStorage.Get(appId)
 	if app == nil {
 		return errors.New("app not exists")
 	}
 
+	if _, err := scheduler.InspectAutoscaler(appId); err == nil {
+		if err := scheduler.DeleteAutoscaler(appId); err != nil {
+			return err
+		}
+	}
+
+	for _, scalingSchedule := range scheduler.ListScalingSchedules(appId) {
+		if err := scheduler.DeleteScalingSchedule(scalingSchedule.ScheduleId); err != nil {
+			return err
+		}
+	}
+
+	if destroyVolumes {
+		if err := scheduler.Reserver.DestroyVolumes(appId, app.CurrentVersion.RunAs, app.PersistentVolumes()); err != nil {
+			return err
+		}
+	}
+
 	return app.Delete()
 }
 
 func (scheduler *Scheduler) ListApps(appFilterOptions AppFilterOptions) []*state.App {
 	apps := make([]*state.App, 0)
 	for _, v := range scheduler.AppStorage.Filter(appFilterOptions) {
+		if v.IsJob() { // listed as jobs
+			continue
+		}
+
 		apps = append(apps, v)
 	}
 
@@ -55,6 +93,16 @@ func (scheduler *Scheduler) ScaleUp(appId string, newInstances int, newIps []str
 		return errors.New("app not exists")
 	}
 
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	scaled := *app.CurrentVersion
+	scaled.Instances += int32(newInstances)
+	scaled.Ip = append(append([]string{}, scaled.Ip...), newIps...)
+	if err := scheduler.checkQuota(appId, state.VersionUsage(&scaled)); err != nil {
+		return err
+	}
+
 	return app.ScaleUp(newInstances, newIps)
 }
 
@@ -73,9 +121,30 @@ func (scheduler *Scheduler) UpdateApp(appId string, version *types.Version) erro
 		return errors.New("app not exists")
 	}
 
+	if err := scheduler.validateRole(version); err != nil {
+		return err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if err := scheduler.checkQuota(appId, updateUsage(app, version)); err != nil {
+		return err
+	}
+
 	return app.Update(version, scheduler.store)
 }
 
+// both versions run while updating
+func updateUsage(app *state.App, version *types.Version) *types.Quota {
+	usage := state.VersionUsage(version)
+	if version.RunAs == app.CurrentVersion.RunAs {
+		state.MaxUsage(usage, app.Usage())
+	}
+
+	return usage
+}
+
 func (scheduler *Scheduler) CancelUpdate(appId string) error {
 	app := scheduler.AppStorage.Get(appId)
 	if app == nil {
@@ -93,3 +162,84 @@ func (scheduler *Scheduler) ProceedUpdate(appId string, instances int) error {
 
 	return app.ProceedingRollingUpdate(instances)
 }
+
+func (scheduler *Scheduler) PauseUpdate(appId string) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	return app.PauseUpdate()
+}
+
+func (scheduler *Scheduler) ResumeUpdate(appId string) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	return app.ResumeUpdate()
+}
+
+func (scheduler *Scheduler) Rollback(appId, versionId string) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	version, err := app.RollbackVersion(versionId)
+	if err != nil {
+		return err
+	}
+
+	if err := scheduler.validateRole(version); err != nil {
+		return err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if err := scheduler.checkQuota(appId, updateUsage(app, version)); err != nil {
+		return err
+	}
+
+	return app.Update(version, scheduler.store)
+}
+
+func (scheduler *Scheduler) Canary(appId string, version *types.Version, instances int, weight float64) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	if err := scheduler.validateRole(version); err != nil {
+		return err
+	}
+
+	scheduler.quotasLock.Lock()
+	defer scheduler.quotasLock.Unlock()
+
+	if err := scheduler.checkQuota(appId, updateUsage(app, version)); err != nil {
+		return err
+	}
+
+	return app.Canary(version, instances, weight)
+}
+
+func (scheduler *Scheduler) PromoteCanary(appId string) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	return app.PromoteCanary()
+}
+
+func (scheduler *Scheduler) AbortCanary(appId string) error {
+	app := scheduler.AppStorage.Get(appId)
+	if app == nil {
+		return errors.New("app not exists")
+	}
+
+	return app.AbortCanary()
+}
@@ -24,6 +24,7 @@ type AppMode string
 var (
 	APP_MODE_FIXED      AppMode = "fixed"
 	APP_MODE_REPLICATES AppMode = "replicates"
+	APP_MODE_JOB        AppMode = "job"
 )
 
 const (
@@ -32,10 +33,16 @@ const (
 	APP_STATE_MARK_FOR_DELETION      = "deleting"
 	APP_STATE_MARK_FOR_UPDATING      = "updating"
 	APP_STATE_MARK_FOR_CANCEL_UPDATE = "cancel_update"
+	APP_STATE_CANARY                 = "canary"
 	APP_STATE_MARK_FOR_SCALE_UP      = "scale_up"
 	APP_STATE_MARK_FOR_SCALE_DOWN    = "scale_down"
 )
 
+const (
+	UPDATE_POLICY_ACTION_ROLLBACK = "rollback" // roll back to current version when tasks of new version keep failing
+	UPDATE_POLICY_ACTION_CONTINUE = "continue" // keep relaunching failed tasks of new version
+)
+
 var persistentStore store.Store
 
 func SetStore(newStore store.Store) {
@@ -50,6 +57,10 @@ type App struct {
 	slotsLock sync.Mutex
 	slots     map[int]*Slot `json:"slots"`
 
+	// persistent volumes created by slot index, kept when the slot is removed
+	volumesLock sync.Mutex
+	volumes     map[int][]*SlotVolume
+
 	Scontext *swancontext.SwanContext
 
 	// app run with CurrentVersion config
@@ -65,6 +76,16 @@ type App struct {
 
 	State     string
 	ClusterId string
+	// why app got current state, e.g. reason of an automatic rollback
+	StateMessage string
+	// unattended rolling update paused
+	UpdatePaused bool
+	// percentage of traffic sent to the canary instances
+	CanaryWeight float64
+	// when all slots of the last update batch got ready
+	batchReadyAt time.Time
+	// the job app runs tasks for, if in job mode
+	job *Job
 
 	inTransaction bool
 	touched       bool
@@ -82,6 +103,7 @@ func NewApp(version *types.Version,
 	app := &App{
 		Versions:          []*types.Version{version},
 		slots:             make(map[int]*Slot),
+		volumes:           make(map[int][]*SlotVolume),
 		CurrentVersion:    version,
 		OfferAllocatorRef: allocator,
 		AppId:             version.AppId,
@@ -96,6 +118,8 @@ func NewApp(version *types.Version,
 
 	if version.Mode == "fixed" {
 		app.Mode = APP_MODE_FIXED
+	} else if version.Mode == string(APP_MODE_JOB) {
+		app.Mode = APP_MODE_JOB
 	} else { // if no mode specified, default should be replicates
 		app.Mode = APP_MODE_REPLICATES
 	}
@@ -120,6 +144,10 @@ func NewApp(version *types.Version,
 
 // also need user pass ip here
 func (app *App) ScaleUp(newInstances int, newIps []string) error {
+	if app.IsJob() {
+		return errors.New("tasks of job can not be scaled")
+	}
+
 	if !app.StateIs(APP_STATE_NORMAL) {
 		return errors.New("app not in normal state")
 	}
@@ -151,6 +179,10 @@ func (app *App) ScaleUp(newInstances int, newIps []string) error {
 }
 
 func (app *App) ScaleDown(removeInstances int) error {
+	if app.IsJob() {
+		return errors.New("tasks of job can not be scaled")
+	}
+
 	if !app.StateIs(APP_STATE_NORMAL) {
 		return errors.New("app not in normal state")
 	}
@@ -203,6 +235,24 @@ func (app *App) Delete() error {
 //    propose version as current version, set propose version to nil.
 // 6. set app's state to APP_STATE_NORMAL.
 func (app *App) Update(version *types.Version, store store.Store) error {
+	if err := app.checkVersionProposable(version); err != nil {
+		return err
+	}
+
+	app.BeginTx()
+	defer app.Commit()
+
+	app.proposeVersion(version, APP_STATE_MARK_FOR_UPDATING)
+	app.updateSlots(app.firstUpdateBatch()) // only first slot if update manually
+
+	return nil
+}
+
+func (app *App) checkVersionProposable(version *types.Version) error {
+	if app.IsJob() {
+		return errors.New("tasks of job can not be updated")
+	}
+
 	if !app.StateIs(APP_STATE_NORMAL) || app.ProposedVersion != nil {
 		return errors.New("app not in normal state")
 	}
@@ -215,26 +265,32 @@ func (app *App) Update(version *types.Version, store store.Store) error {
 		return err
 	}
 
-	app.BeginTx()
-	defer app.Commit()
-
 	if app.CurrentVersion == nil {
 		return errors.New("update failed: current version was losted")
 	}
 
-	app.SetState(APP_STATE_MARK_FOR_UPDATING)
+	return nil
+}
+
+// caller should be in between transaction
+func (app *App) proposeVersion(version *types.Version, state string) {
+	app.SetState(state)
+	app.StateMessage = ""
+	app.UpdatePaused = false
+	app.batchReadyAt = time.Time{}
 
 	version.ID = fmt.Sprintf("%d", time.Now().Unix())
 	version.PerviousVersionID = app.CurrentVersion.ID
 	app.ProposedVersion = version
+}
 
-	for i := 0; i < 1; i++ { // current we make first slot update
+// update the first instances slots to proposed version
+func (app *App) updateSlots(instances int) {
+	for i := 0; i < instances; i++ {
 		if slot, found := app.GetSlot(i); found {
 			slot.UpdateTask(app.ProposedVersion, true)
 		}
 	}
-
-	return nil
 }
 
 func (app *App) ProceedingRollingUpdate(instances int) error {
@@ -277,15 +333,73 @@ func (app *App) CancelUpdate() error {
 	app.BeginTx()
 	defer app.Commit()
 
+	app.cancelUpdate()
+
+	return nil
+}
+
+func (app *App) cancelUpdate() {
+	canary := app.StateIs(APP_STATE_CANARY)
+
 	app.SetState(APP_STATE_MARK_FOR_CANCEL_UPDATE)
+	app.UpdatePaused = false
+	app.CanaryWeight = 0
+	if canary {
+		app.emitCanaryEvent()
+	}
 
 	for i := app.RollingUpdateInstances() - 1; i >= 0; i-- {
 		if slot, found := app.GetSlot(i); found {
+			if slot.Terminated() { // will be relaunched by slot itself
+				slot.Version = app.CurrentVersion
+				continue
+			}
+
 			slot.UpdateTask(app.CurrentVersion, true)
 		}
 	}
+}
 
-	return nil
+// task of proposed version failed during rolling update, cancel the update
+// once threshold of UpdatePolicy hit: a slot failed more than MaxRetries
+// times or the update failed more than MaxFailovers times in total.
+func (app *App) onUpdateFailure(slot *Slot, reason string) {
+	logrus.Warnf("slot %s failed %d times updating to version %s: %s", slot.Id, slot.UpdateFailures, app.ProposedVersion.ID, reason)
+
+	policy := app.ProposedVersion.UpdatePolicy
+	if policy == nil || policy.Action != UPDATE_POLICY_ACTION_ROLLBACK {
+		return
+	}
+
+	failures := app.UpdateFailures()
+	if slot.UpdateFailures <= int(policy.MaxRetries) && failures <= int(policy.MaxFailovers) {
+		return
+	}
+
+	app.BeginTx()
+	defer app.Commit()
+
+	app.StateMessage = fmt.Sprintf("update to version %s rolled back, slot %s failed %d times, %d failures in total, last failure: %s",
+		app.ProposedVersion.ID, slot.Id, slot.UpdateFailures, failures, reason)
+	logrus.Warnf("app %s %s", app.AppId, app.StateMessage)
+
+	app.EmitEvent(swanevent.NewEvent(swanevent.EventTypeAppRollback, &swanevent.AppRollbackInfo{
+		AppId:       app.AppId,
+		FromVersion: app.ProposedVersion.ID,
+		ToVersion:   app.CurrentVersion.ID,
+		Reason:      app.StateMessage,
+	}))
+
+	app.cancelUpdate()
+}
+
+func (app *App) UpdateFailures() int {
+	updateFailures := 0
+	for _, slot := range app.slots {
+		updateFailures += slot.UpdateFailures
+	}
+
+	return updateFailures
 }
 
 func (app *App) IsReplicates() bool {
@@ -296,6 +410,10 @@ func (app *App) IsFixed() bool {
 	return app.Mode == APP_MODE_FIXED
 }
 
+func (app *App) IsJob() bool {
+	return app.Mode == APP_MODE_JOB
+}
+
 func (app *App) SetState(state string) {
 	app.State = state
 	app.Touch(false)
@@ -317,6 +435,18 @@ func (app *App) RunningInstances() int {
 	return runningInstances
 }
 
+// running instances passed their readiness checks
+func (app *App) ReadyInstances() int {
+	readyInstances := 0
+	for _, slot := range app.slots {
+		if slot.Ready() {
+			readyInstances += 1
+		}
+	}
+
+	return readyInstances
+}
+
 func (app *App) RollingUpdateInstances() int {
 	rollingUpdateInstances := 0
 	for _, slot := range app.slots {
@@ -386,7 +516,7 @@ func (app *App) Reevaluate() {
 	case APP_STATE_MARK_FOR_UPDATING:
 		// when updating done
 		if (app.RollingUpdateInstances() == int(app.CurrentVersion.Instances)) &&
-			(app.RunningInstances() == int(app.CurrentVersion.Instances)) { // not perfect as when instances number increase, all instances running might be hard to acheive
+			(app.ReadyInstances() == int(app.CurrentVersion.Instances)) { // not perfect as when instances number increase, all instances running might be hard to acheive
 			app.SetState(APP_STATE_NORMAL)
 
 			app.CurrentVersion = app.ProposedVersion
@@ -398,9 +528,15 @@ func (app *App) Reevaluate() {
 
 			for _, slot := range app.slots {
 				slot.SetMarkForRollingUpdate(false)
+				slot.UpdateFailures = 0
 			}
+		} else {
+			app.AdvanceUpdate()
 		}
 
+	case APP_STATE_CANARY:
+		// wait for canary promoted or aborted
+
 	case APP_STATE_MARK_FOR_CANCEL_UPDATE:
 		// when update cancelled
 		if app.slots[0].Version == app.CurrentVersion && // until the first slot has updated to CurrentVersion
@@ -410,6 +546,7 @@ func (app *App) Reevaluate() {
 
 			for _, slot := range app.slots {
 				slot.SetMarkForRollingUpdate(false)
+				slot.UpdateFailures = 0
 			}
 		}
 
@@ -448,20 +585,81 @@ func (app *App) checkProposedVersionValid(version *types.Version) error {
 }
 
 func validateAndFormatVersion(version *types.Version) error {
-	if version.Container == nil {
-		return errors.New("swan only support mesos docker containerization, no container found")
+	if len(version.Mode) == 0 {
+		version.Mode = string(APP_MODE_REPLICATES)
 	}
 
-	if version.Container.Docker == nil {
-		return errors.New("swan only support mesos docker containerization, no container found")
+	if (version.Mode != string(APP_MODE_REPLICATES)) && (version.Mode != string(APP_MODE_FIXED)) &&
+		(version.Mode != string(APP_MODE_JOB)) {
+		return errors.New(fmt.Sprintf("enrecognized app mode %s", version.Mode))
 	}
 
-	if len(version.Mode) == 0 {
-		version.Mode = string(APP_MODE_REPLICATES)
+	if err := validateAndFormatContainer(version); err != nil {
+		return err
 	}
 
-	if (version.Mode != string(APP_MODE_REPLICATES)) && (version.Mode != string(APP_MODE_FIXED)) {
-		return errors.New(fmt.Sprintf("enrecognized app mode %s", version.Mode))
+	if _, err := ParseConstraints(version.Constraints); err != nil {
+		return err
+	}
+
+	if _, err := NewPlacementStrategy(version.PlacementStrategy); err != nil {
+		return err
+	}
+
+	if version.UpdatePolicy != nil {
+		if version.UpdatePolicy.MaxRetries < 0 || version.UpdatePolicy.MaxFailovers < 0 {
+			return errors.New("max retries and max failovers of update policy should not be negative")
+		}
+
+		if !utils.SliceContains([]string{"", UPDATE_POLICY_ACTION_ROLLBACK, UPDATE_POLICY_ACTION_CONTINUE}, version.UpdatePolicy.Action) {
+			return errors.New(fmt.Sprintf("unrecognized update policy action %s", version.UpdatePolicy.Action))
+		}
+
+		if len(version.UpdatePolicy.Mode) == 0 {
+			version.UpdatePolicy.Mode = UPDATE_POLICY_MODE_MANUAL
+		}
+
+		if version.UpdatePolicy.Mode != UPDATE_POLICY_MODE_MANUAL && version.UpdatePolicy.Mode != UPDATE_POLICY_MODE_AUTO {
+			return errors.New(fmt.Sprintf("unrecognized update policy mode %s", version.UpdatePolicy.Mode))
+		}
+
+		if _, err := UpdateBatchSize(version.UpdatePolicy, int(version.Instances)); err != nil {
+			return err
+		}
+
+		if version.UpdatePolicy.UpdateDelay < 0 {
+			return errors.New("update delay of update policy should not be negative")
+		}
+	}
+
+	if version.BackoffSeconds < 0 || version.MaxLaunchDelaySeconds < 0 || version.MaxRestarts < 0 {
+		return errors.New("backoff seconds, max launch delay seconds and max restarts should not be negative")
+	}
+
+	if err := validateHealthChecks(version); err != nil {
+		return err
+	}
+
+	if err := validatePersistentVolumes(version); err != nil {
+		return err
+	}
+
+	if check := version.ReadinessCheck; check != nil {
+		if protocol := strings.ToLower(check.Protocol); protocol != "http" && protocol != "tcp" {
+			return errors.New(fmt.Sprintf("unsupported readiness check protocol %s", check.Protocol))
+		}
+
+		if len(check.PortName) == 0 && check.Port <= 0 {
+			return errors.New("port name, or port for fixed mode app, of readiness check required")
+		}
+	}
+
+	if version.MaxConsecutiveUnhealthy < 0 || version.MaxUnhealthySeconds < 0 {
+		return errors.New("max consecutive unhealthy and max unhealthy seconds should not be negative")
+	}
+
+	if version.BackoffFactor != 0 && version.BackoffFactor < 1 {
+		return errors.New("backoff factor should not be less than 1")
 	}
 
 	// validation for fixed mode application
@@ -474,17 +672,13 @@ func validateAndFormatVersion(version *types.Version) error {
 			return errors.New("fixed mode application doesn't support portmapping")
 		}
 
-		if len(version.HealthChecks) > 0 {
-			return errors.New("fixed mode application doesn't health check")
-		}
-
 		if strings.ToLower(version.Container.Docker.Network) != SWAN_RESERVED_NETWORK {
 			return errors.New("fixed mode app suppose the only network driver should be macvlan and name is swan")
 		}
 	}
 
-	// validation for replicates mode app
-	if version.Mode == string(APP_MODE_REPLICATES) {
+	// validation for replicates mode app run by docker
+	if version.Mode == string(APP_MODE_REPLICATES) && IsDockerContainer(version) {
 		// the only network driver should be **bridge**
 		if strings.ToLower(version.Container.Docker.Network) != "bridge" {
 			return errors.New("replicates mode app suppose the only network driver should be bridge")
@@ -506,31 +700,56 @@ func validateAndFormatVersion(version *types.Version) error {
 		if !utils.SliceUnique(portNames) {
 			return errors.New("each port mapping should have a uniquely identified name")
 		}
+	}
 
-		// portName for health check should mandatory
-		for _, hc := range version.HealthChecks {
-			if strings.TrimSpace(hc.PortName) == "" {
-				return errors.New("port name should not empty and match name in docker's PortMappings")
-			}
+	return nil
+}
 
-			// portName should present in dockers' portMappings definition
-			if !utils.SliceContains(portNames, hc.PortName) {
-				return errors.New(fmt.Sprintf("no port name %s found in docker's PortMappings", hc.PortName))
-			}
+// command-only app, docker container, or docker image run by the mesos
+// containerizer, each validated on its own
+func validateAndFormatContainer(version *types.Version) error {
+	container := version.Container
+	if container == nil {
+		if len(strings.TrimSpace(version.Command)) == 0 && len(version.Args) == 0 {
+			return errors.New("command or args required for app without container")
+		}
 
-			if !utils.SliceContains([]string{"tcp", "http", "TCP", "HTTP"}, hc.Protocol) {
-				return errors.New(fmt.Sprintf("doesn't recoginized protocol %s for health check", hc.Protocol))
-			}
+		if version.Mode == string(APP_MODE_FIXED) {
+			return errors.New("fixed mode app requires a docker container")
+		}
 
-			if hc.Protocol == "http" || hc.Protocol == "HTTP" {
-				if len(hc.Path) == 0 {
-					return errors.New("no path provided for health check with HTTP protocol")
-				}
-			}
+		return nil
+	}
+
+	if container.Docker == nil || len(strings.TrimSpace(container.Docker.Image)) == 0 {
+		return errors.New("image of container required")
+	}
+
+	if len(container.Type) == 0 {
+		container.Type = CONTAINER_TYPE_DOCKER
+	}
+
+	switch strings.ToUpper(container.Type) {
+	case CONTAINER_TYPE_DOCKER:
+		return nil
+
+	case CONTAINER_TYPE_MESOS:
+		if version.Mode == string(APP_MODE_FIXED) {
+			return errors.New("fixed mode app requires a docker container")
+		}
+
+		if len(container.Docker.PortMappings) > 0 || len(container.Docker.Parameters) > 0 || container.Docker.Privileged {
+			return errors.New("port mappings, parameters and privileged apply to docker container only")
 		}
+
+		return nil
 	}
 
-	return nil
+	return errors.New(fmt.Sprintf("unrecognized container type %s", container.Type))
+}
+
+func IsDockerContainer(version *types.Version) bool {
+	return version.Container != nil && strings.ToUpper(version.Container.Type) != CONTAINER_TYPE_MESOS
 }
 
 // 1, remove app from persisted storage
@@ -0,0 +1,320 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const (
+	AUTOSCALE_METRIC_CPU        = "cpu"
+	AUTOSCALE_METRIC_MEM        = "mem"
+	AUTOSCALE_METRIC_REQUESTS   = "requests"
+	AUTOSCALE_METRIC_PROMETHEUS = "prometheus"
+
+	DEFAULT_AUTOSCALE_INTERVAL_SECONDS      = 30
+	DEFAULT_AUTOSCALE_UP_COOLDOWN_SECONDS   = 60
+	DEFAULT_AUTOSCALE_DOWN_COOLDOWN_SECONDS = 300
+	AUTOSCALE_TOLERANCE                     = 0.1 // of the target, metric within it leaves instances as they are
+	AUTOSCALE_DECISIONS_KEPT                = 20
+)
+
+// AppScaler scales the apps watched by autoscalers
+type AppScaler interface {
+	InspectApp(appId string) (*App, error)
+	ScaleUp(appId string, newInstances int, newIps []string) error
+	ScaleDown(appId string, removeInstances int) error
+	EmitEvent(swanEvent *swanevent.Event)
+}
+
+// MetricSource measures the metric of autoscale policies for the app, as
+// the value per instance
+type MetricSource interface {
+	Measure(app *App, policy *types.AutoscalePolicy) (float64, error)
+}
+
+// Autoscaler evaluates the policy of the app every interval, scaling the app
+// to the instances keeping the metric per instance around the target.
+type Autoscaler struct {
+	AppId      string
+	Spec       *types.AutoscalePolicy
+	Created    time.Time
+	LastScaled time.Time
+	Decisions  []*AutoscaleDecision // the recent ones, oldest first
+
+	scaler  AppScaler
+	metrics MetricSource
+
+	lock    sync.Mutex
+	timer   *time.Timer
+	stopped bool
+}
+
+// AutoscaleDecision is an evaluation out of cooldown asking for other
+// instances than the app has, not applied if scaling failed.
+type AutoscaleDecision struct {
+	Time          time.Time
+	Value         float64
+	FromInstances int32
+	ToInstances   int32
+	Applied       bool
+	Reason        string
+}
+
+func NewAutoscaler(spec *types.AutoscalePolicy, scaler AppScaler, metrics MetricSource) (*Autoscaler, error) {
+	autoscaler := &Autoscaler{
+		AppId:     spec.AppId,
+		Spec:      spec,
+		Created:   time.Now(),
+		Decisions: make([]*AutoscaleDecision, 0),
+		scaler:    scaler,
+		metrics:   metrics,
+	}
+
+	if err := ValidateAutoscalePolicy(spec); err != nil {
+		return nil, err
+	}
+
+	if err := persistentStore.CreateAutoscaler(context.TODO(), AutoscalerToRaft(autoscaler), nil); err != nil {
+		return nil, err
+	}
+
+	autoscaler.Start()
+
+	return autoscaler, nil
+}
+
+// validate the policy and fill in the defaults
+func ValidateAutoscalePolicy(spec *types.AutoscalePolicy) error {
+	if len(spec.AppId) == 0 {
+		return errors.New("app id of autoscale policy required")
+	}
+
+	if spec.MinInstances < 1 || spec.MaxInstances < spec.MinInstances {
+		return errors.New("min instances should be positive and no more than max instances")
+	}
+
+	switch spec.Metric {
+	case AUTOSCALE_METRIC_CPU, AUTOSCALE_METRIC_MEM, AUTOSCALE_METRIC_REQUESTS:
+	case AUTOSCALE_METRIC_PROMETHEUS:
+		if len(spec.Query) == 0 {
+			return errors.New("query of prometheus metric required")
+		}
+	default:
+		return errors.New(fmt.Sprintf("invalid autoscale metric %s", spec.Metric))
+	}
+
+	if spec.Target <= 0 {
+		return errors.New("target of autoscale policy should be positive")
+	}
+
+	if spec.IntervalSeconds < 0 || spec.ScaleUpCooldownSeconds < 0 || spec.ScaleDownCooldownSeconds < 0 {
+		return errors.New("interval and cooldowns of autoscale policy should not be negative")
+	}
+
+	if spec.IntervalSeconds == 0 {
+		spec.IntervalSeconds = DEFAULT_AUTOSCALE_INTERVAL_SECONDS
+	}
+
+	if spec.ScaleUpCooldownSeconds == 0 {
+		spec.ScaleUpCooldownSeconds = DEFAULT_AUTOSCALE_UP_COOLDOWN_SECONDS
+	}
+
+	if spec.ScaleDownCooldownSeconds == 0 {
+		spec.ScaleDownCooldownSeconds = DEFAULT_AUTOSCALE_DOWN_COOLDOWN_SECONDS
+	}
+
+	return nil
+}
+
+// Start evaluates the policy every interval
+func (autoscaler *Autoscaler) Start() {
+	autoscaler.lock.Lock()
+	defer autoscaler.lock.Unlock()
+
+	autoscaler.stopped = false
+	autoscaler.scheduleNext()
+}
+
+// Stop stops evaluating, instances are left as they are
+func (autoscaler *Autoscaler) Stop() {
+	autoscaler.lock.Lock()
+	defer autoscaler.lock.Unlock()
+
+	autoscaler.stopped = true
+	if autoscaler.timer != nil {
+		autoscaler.timer.Stop()
+	}
+}
+
+// Update replaces the policy, keeping the time of last scaling for cooldowns
+func (autoscaler *Autoscaler) Update(spec *types.AutoscalePolicy) error {
+	if err := ValidateAutoscalePolicy(spec); err != nil {
+		return err
+	}
+
+	autoscaler.lock.Lock()
+	defer autoscaler.lock.Unlock()
+
+	autoscaler.Spec = spec
+
+	return persistentStore.UpdateAutoscaler(context.TODO(), AutoscalerToRaft(autoscaler), nil)
+}
+
+// Delete stops evaluating and removes the autoscaler
+func (autoscaler *Autoscaler) Delete() error {
+	autoscaler.Stop()
+
+	return persistentStore.DeleteAutoscaler(context.TODO(), autoscaler.AppId, nil)
+}
+
+// RecentDecisions returns a copy of the decisions kept
+func (autoscaler *Autoscaler) RecentDecisions() []*AutoscaleDecision {
+	autoscaler.lock.Lock()
+	defer autoscaler.lock.Unlock()
+
+	return append([]*AutoscaleDecision{}, autoscaler.Decisions...)
+}
+
+// caller should hold the lock
+func (autoscaler *Autoscaler) scheduleNext() {
+	interval := time.Duration(autoscaler.Spec.IntervalSeconds) * time.Second
+	autoscaler.timer = time.AfterFunc(interval, func() {
+		autoscaler.lock.Lock()
+		defer autoscaler.lock.Unlock()
+
+		if autoscaler.stopped {
+			return
+		}
+
+		autoscaler.evaluate(time.Now())
+		autoscaler.scheduleNext()
+	})
+}
+
+// caller should hold the lock
+func (autoscaler *Autoscaler) evaluate(now time.Time) {
+	spec := autoscaler.Spec
+
+	app, err := autoscaler.scaler.InspectApp(autoscaler.AppId)
+	if err != nil {
+		logrus.Warnf("autoscaler of app %s: %s", autoscaler.AppId, err)
+		return
+	}
+
+	// in the middle of scaling or updating
+	if !app.StateIs(APP_STATE_NORMAL) {
+		return
+	}
+
+	value, err := autoscaler.metrics.Measure(app, spec)
+	if err != nil {
+		logrus.Warnf("autoscaler of app %s: measure %s got error: %s", autoscaler.AppId, spec.Metric, err)
+		return
+	}
+
+	current := app.CurrentVersion.Instances
+	desired := DesiredInstances(spec, current, value)
+	if desired == current {
+		return
+	}
+
+	if left := autoscaler.cooldownLeft(desired > current, now); left > 0 {
+		logrus.Debugf("autoscaler of app %s: %d instances to %d held for cooldown of another %s", autoscaler.AppId, current, desired, left)
+		return
+	}
+
+	decision := &AutoscaleDecision{
+		Time:          now,
+		Value:         value,
+		FromInstances: current,
+		ToInstances:   desired,
+	}
+
+	if desired > current {
+		err = autoscaler.scaler.ScaleUp(autoscaler.AppId, int(desired-current), nil)
+	} else {
+		err = autoscaler.scaler.ScaleDown(autoscaler.AppId, int(current-desired))
+	}
+
+	if err != nil {
+		decision.Reason = err.Error()
+	} else {
+		decision.Applied = true
+		autoscaler.LastScaled = now
+		autoscaler.update()
+	}
+
+	logrus.Infof("autoscaler of app %s: %s %g for target %g, %d instances to %d, applied: %t %s",
+		autoscaler.AppId, spec.Metric, value, spec.Target, current, desired, decision.Applied, decision.Reason)
+
+	autoscaler.record(decision)
+}
+
+// DesiredInstances returns the instances keeping the value per instance
+// around the target, within the bounds of the policy
+func DesiredInstances(spec *types.AutoscalePolicy, current int32, value float64) int32 {
+	desired := current
+
+	ratio := value / spec.Target
+	if math.Abs(ratio-1) > AUTOSCALE_TOLERANCE {
+		desired = int32(math.Ceil(float64(current) * ratio))
+	}
+
+	if desired < spec.MinInstances {
+		desired = spec.MinInstances
+	}
+
+	if desired > spec.MaxInstances {
+		desired = spec.MaxInstances
+	}
+
+	return desired
+}
+
+// cooldowns are counted from the last scaling either way, caller should hold the lock
+func (autoscaler *Autoscaler) cooldownLeft(up bool, now time.Time) time.Duration {
+	if autoscaler.LastScaled.IsZero() {
+		return 0
+	}
+
+	cooldown := time.Duration(autoscaler.Spec.ScaleDownCooldownSeconds) * time.Second
+	if up {
+		cooldown = time.Duration(autoscaler.Spec.ScaleUpCooldownSeconds) * time.Second
+	}
+
+	return autoscaler.LastScaled.Add(cooldown).Sub(now)
+}
+
+// keep the decision and emit it as an event, caller should hold the lock
+func (autoscaler *Autoscaler) record(decision *AutoscaleDecision) {
+	autoscaler.Decisions = append(autoscaler.Decisions, decision)
+	if len(autoscaler.Decisions) > AUTOSCALE_DECISIONS_KEPT {
+		autoscaler.Decisions = autoscaler.Decisions[len(autoscaler.Decisions)-AUTOSCALE_DECISIONS_KEPT:]
+	}
+
+	autoscaler.scaler.EmitEvent(swanevent.NewEvent(swanevent.EventTypeAppAutoscale, &swanevent.AppAutoscaleInfo{
+		AppId:         autoscaler.AppId,
+		Metric:        autoscaler.Spec.Metric,
+		Value:         decision.Value,
+		Target:        autoscaler.Spec.Target,
+		FromInstances: decision.FromInstances,
+		ToInstances:   decision.ToInstances,
+		Applied:       decision.Applied,
+		Reason:        decision.Reason,
+	}))
+}
+
+func (autoscaler *Autoscaler) update() {
+	if err := persistentStore.UpdateAutoscaler(context.TODO(), AutoscalerToRaft(autoscaler), nil); err != nil {
+		logrus.Errorf("update autoscaler of app %s got error: %s", autoscaler.AppId, err)
+	}
+}
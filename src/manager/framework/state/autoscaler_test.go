@@ -0,0 +1,75 @@
+package state
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testScaler struct {
+	app    *App
+	err    error
+	scaled int // instances added, negative if removed
+	events []*swanevent.Event
+}
+
+func (s *testScaler) InspectApp(appId string) (*App, error) { return s.app, nil }
+func (s *testScaler) ScaleUp(appId string, newInstances int, newIps []string) error {
+	s.scaled += newInstances
+	return s.err
+}
+func (s *testScaler) ScaleDown(appId string, removeInstances int) error {
+	s.scaled -= removeInstances
+	return s.err
+}
+func (s *testScaler) EmitEvent(e *swanevent.Event) { s.events = append(s.events, e) }
+
+type testMetrics float64
+
+func (m testMetrics) Measure(app *App, policy *types.AutoscalePolicy) (float64, error) {
+	return float64(m), nil
+}
+
+func TestDesiredInstances(t *testing.T) {
+	spec := &types.AutoscalePolicy{AppId: "test", MinInstances: 2, MaxInstances: 10, Metric: AUTOSCALE_METRIC_CPU, Target: 50}
+	assert.Nil(t, ValidateAutoscalePolicy(spec))
+	assert.Equal(t, int64(DEFAULT_AUTOSCALE_INTERVAL_SECONDS), spec.IntervalSeconds)
+
+	assert.Equal(t, int32(4), DesiredInstances(spec, 4, 54))   // within tolerance
+	assert.Equal(t, int32(6), DesiredInstances(spec, 4, 75))   // 4 * 1.5
+	assert.Equal(t, int32(10), DesiredInstances(spec, 4, 500)) // max
+	assert.Equal(t, int32(2), DesiredInstances(spec, 4, 5))    // min
+
+	spec.Metric = AUTOSCALE_METRIC_PROMETHEUS
+	assert.NotNil(t, ValidateAutoscalePolicy(spec))
+}
+
+func TestAutoscalerEvaluate(t *testing.T) {
+	app, _ := newTestApp(nil)
+	app.State = APP_STATE_NORMAL
+	app.CurrentVersion.Instances = 4
+
+	scaler := &testScaler{app: app, err: errors.New("quota exceeded")}
+	spec := &types.AutoscalePolicy{AppId: "test", MinInstances: 1, MaxInstances: 10, Metric: AUTOSCALE_METRIC_MEM, Target: 50}
+	assert.Nil(t, ValidateAutoscalePolicy(spec))
+	autoscaler := &Autoscaler{AppId: "test", Spec: spec, scaler: scaler, metrics: testMetrics(100)}
+
+	now := time.Now()
+	autoscaler.evaluate(now)
+	assert.Len(t, autoscaler.Decisions, 1)
+	assert.False(t, autoscaler.Decisions[0].Applied)
+	assert.Equal(t, int32(8), autoscaler.Decisions[0].ToInstances)
+	assert.Len(t, scaler.events, 1)
+	assert.Equal(t, swanevent.EventTypeAppAutoscale, scaler.events[0].Type)
+
+	// held while in cooldown since last scaling
+	autoscaler.LastScaled = now
+	autoscaler.evaluate(now.Add(time.Second))
+	assert.Len(t, autoscaler.Decisions, 1)
+	assert.True(t, autoscaler.cooldownLeft(false, now.Add(time.Minute)) > 0)
+	assert.True(t, autoscaler.cooldownLeft(true, now.Add(time.Minute)) <= 0)
+}
@@ -0,0 +1,85 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+// Canary runs the version on the first instances of the app next to the
+// current version, weight percent of the traffic goes to the canary
+// instances through the proxy, until the canary got promoted or aborted.
+func (app *App) Canary(version *types.Version, instances int, weight float64) error {
+	if err := app.checkVersionProposable(version); err != nil {
+		return err
+	}
+
+	if instances < 1 || instances >= int(app.CurrentVersion.Instances) {
+		return errors.New(fmt.Sprintf("canary instances should be within [1, %d)", app.CurrentVersion.Instances))
+	}
+
+	if weight < 0 || weight > 100 {
+		return errors.New("canary weight should be within [0, 100]")
+	}
+
+	app.BeginTx()
+	defer app.Commit()
+
+	app.proposeVersion(version, APP_STATE_CANARY)
+	app.CanaryWeight = weight
+	app.updateSlots(instances)
+
+	app.emitCanaryEvent()
+
+	return nil
+}
+
+// PromoteCanary rolls the canary version out to the rest instances, batch by
+// batch if the update is unattended, otherwise all at once.
+func (app *App) PromoteCanary() error {
+	if !app.StateIs(APP_STATE_CANARY) || app.ProposedVersion == nil {
+		return errors.New("app not in canary state")
+	}
+
+	app.BeginTx()
+	app.SetState(APP_STATE_MARK_FOR_UPDATING)
+	app.CanaryWeight = 0
+	app.Commit()
+
+	app.emitCanaryEvent()
+
+	if IsAutoUpdate(app.ProposedVersion) {
+		app.AdvanceUpdate()
+		return nil
+	}
+
+	return app.ProceedingRollingUpdate(int(app.CurrentVersion.Instances) - app.RollingUpdateInstances())
+}
+
+// AbortCanary rolls the canary instances back to the current version
+func (app *App) AbortCanary() error {
+	if !app.StateIs(APP_STATE_CANARY) || app.ProposedVersion == nil {
+		return errors.New("app not in canary state")
+	}
+
+	app.BeginTx()
+	defer app.Commit()
+
+	app.cancelUpdate()
+
+	return nil
+}
+
+// tell the proxy how to weight the tasks of the app, no canary version
+// means tasks are weighted evenly.
+func (app *App) emitCanaryEvent() {
+	info := &swanevent.AppCanaryInfo{AppId: app.AppId}
+	if app.StateIs(APP_STATE_CANARY) && app.ProposedVersion != nil {
+		info.VersionId = app.ProposedVersion.ID
+		info.Weight = app.CanaryWeight
+	}
+
+	app.EmitEvent(swanevent.NewEvent(swanevent.EventTypeAppCanary, info))
+}
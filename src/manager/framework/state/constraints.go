@@ -0,0 +1,251 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+)
+
+// constraints follow the marathon syntax field:OPERATOR[:value], e.g. hostname:UNIQUE,
+// rack:CLUSTER:rack-1, zone:GROUP_BY:3, hostname:MAX_PER:2 or kernel:LIKE:^4\.
+// field is either hostname or the name of a mesos agent attribute. LIKE and
+// UNLIKE look for the regular expression anywhere in the value as swan always
+// did, rack:LIKE:rack matches rack-1, anchor it to match the whole value.
+const (
+	CONSTRAINT_OPERATOR_UNIQUE   = "UNIQUE"
+	CONSTRAINT_OPERATOR_CLUSTER  = "CLUSTER"
+	CONSTRAINT_OPERATOR_GROUP_BY = "GROUP_BY"
+	CONSTRAINT_OPERATOR_MAX_PER  = "MAX_PER"
+	CONSTRAINT_OPERATOR_LIKE     = "LIKE"
+	CONSTRAINT_OPERATOR_UNLIKE   = "UNLIKE"
+
+	CONSTRAINT_FIELD_HOSTNAME = "hostname"
+)
+
+type Constraint struct {
+	Field    string
+	Operator string
+	Value    string
+
+	regexp *regexp.Regexp
+	limit  int
+}
+
+func ParseConstraint(constraint string) (*Constraint, error) {
+	cons := strings.SplitN(constraint, ":", 3)
+	if len(cons) < 2 || len(strings.TrimSpace(cons[0])) == 0 {
+		return nil, errors.New(fmt.Sprintf("malformed constraint %s, should be field:OPERATOR[:value]", constraint))
+	}
+
+	c := &Constraint{
+		Field:    strings.TrimSpace(cons[0]),
+		Operator: strings.ToUpper(strings.TrimSpace(cons[1])),
+	}
+	if len(cons) == 3 {
+		c.Value = cons[2]
+	}
+
+	if strings.ToLower(c.Field) == CONSTRAINT_FIELD_HOSTNAME {
+		c.Field = CONSTRAINT_FIELD_HOSTNAME
+	}
+
+	switch c.Operator {
+	case CONSTRAINT_OPERATOR_UNIQUE:
+		if len(c.Value) > 0 {
+			return nil, errors.New(fmt.Sprintf("constraint operator UNIQUE takes no value: %s", constraint))
+		}
+
+	case CONSTRAINT_OPERATOR_CLUSTER:
+
+	case CONSTRAINT_OPERATOR_GROUP_BY:
+		c.limit = 1
+		if len(c.Value) > 0 {
+			limit, err := strconv.Atoi(c.Value)
+			if err != nil || limit < 1 {
+				return nil, errors.New(fmt.Sprintf("constraint operator GROUP_BY requires a positive number: %s", constraint))
+			}
+			c.limit = limit
+		}
+
+	case CONSTRAINT_OPERATOR_MAX_PER:
+		limit, err := strconv.Atoi(c.Value)
+		if err != nil || limit < 1 {
+			return nil, errors.New(fmt.Sprintf("constraint operator MAX_PER requires a positive number: %s", constraint))
+		}
+		c.limit = limit
+
+	case CONSTRAINT_OPERATOR_LIKE, CONSTRAINT_OPERATOR_UNLIKE:
+		if len(c.Value) == 0 {
+			return nil, errors.New(fmt.Sprintf("constraint operator %s requires a value: %s", c.Operator, constraint))
+		}
+
+		// values not valid as regular expression were matched as plain text before
+		re, err := regexp.Compile(c.Value)
+		if err != nil {
+			re = regexp.MustCompile(regexp.QuoteMeta(c.Value))
+		}
+		c.regexp = re
+
+	default:
+		return nil, errors.New(fmt.Sprintf("constraint operator %s not supported", c.Operator))
+	}
+
+	return c, nil
+}
+
+func ParseConstraints(constraints []string) ([]*Constraint, error) {
+	parsed := make([]*Constraint, 0)
+	for _, constraint := range constraints {
+		c, err := ParseConstraint(constraint)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, c)
+	}
+
+	return parsed, nil
+}
+
+func (c *Constraint) String() string {
+	if len(c.Value) == 0 {
+		return fmt.Sprintf("%s:%s", c.Field, c.Operator)
+	}
+
+	return fmt.Sprintf("%s:%s:%s", c.Field, c.Operator, c.Value)
+}
+
+// Match test if the offer satisfy the constraint, placed is the field value of
+// each other slot of the same app which already got an agent.
+func (c *Constraint) Match(offer *mesos.Offer, placed []string) bool {
+	value, found := OfferFieldValue(offer, c.Field)
+
+	switch c.Operator {
+	case CONSTRAINT_OPERATOR_UNIQUE:
+		if !found {
+			return false
+		}
+
+		for _, v := range placed {
+			if v == value {
+				return false
+			}
+		}
+
+		return true
+
+	case CONSTRAINT_OPERATOR_CLUSTER:
+		if !found {
+			return false
+		}
+
+		if len(c.Value) > 0 {
+			return value == c.Value
+		}
+
+		// without value all instances should colocate with the first placed one
+		for _, v := range placed {
+			if v != value {
+				return false
+			}
+		}
+
+		return true
+
+	case CONSTRAINT_OPERATOR_GROUP_BY:
+		if !found {
+			return false
+		}
+
+		counts := make(map[string]int)
+		for _, v := range placed {
+			counts[v] += 1
+		}
+
+		// while not all the expected groups got an instance yet, the minimum is zero
+		minCount := 0
+		if len(counts) >= c.limit {
+			minCount = -1
+			for _, count := range counts {
+				if minCount < 0 || count < minCount {
+					minCount = count
+				}
+			}
+		}
+
+		return counts[value] <= minCount
+
+	case CONSTRAINT_OPERATOR_MAX_PER:
+		if !found {
+			return false
+		}
+
+		count := 0
+		for _, v := range placed {
+			if v == value {
+				count += 1
+			}
+		}
+
+		return count < c.limit
+
+	case CONSTRAINT_OPERATOR_LIKE:
+		return found && c.regexp.MatchString(value)
+
+	case CONSTRAINT_OPERATOR_UNLIKE:
+		return !found || !c.regexp.MatchString(value)
+	}
+
+	return false
+}
+
+// OfferFieldValue returns the hostname of the offer or the value of the
+// named attribute formatted as mesos does.
+func OfferFieldValue(offer *mesos.Offer, field string) (string, bool) {
+	if field == CONSTRAINT_FIELD_HOSTNAME {
+		return offer.GetHostname(), true
+	}
+
+	for _, attr := range offer.GetAttributes() {
+		if attr.GetName() == field {
+			return AttributeValue(attr), true
+		}
+	}
+
+	return "", false
+}
+
+func AttributeValue(attr *mesos.Attribute) string {
+	switch attr.GetType() {
+	case mesos.Value_SCALAR:
+		return strconv.FormatFloat(attr.GetScalar().GetValue(), 'f', -1, 64)
+	case mesos.Value_TEXT:
+		return attr.GetText().GetValue()
+	case mesos.Value_RANGES:
+		ranges := make([]string, 0)
+		for _, r := range attr.GetRanges().GetRange() {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", r.GetBegin(), r.GetEnd()))
+		}
+		return "[" + strings.Join(ranges, ", ") + "]"
+	case mesos.Value_SET:
+		items := append([]string{}, attr.GetSet().GetItem()...)
+		sort.Strings(items)
+		return "{" + strings.Join(items, ", ") + "}"
+	}
+
+	return ""
+}
+
+func OfferAttributes(offer *mesos.Offer) map[string]string {
+	attributes := make(map[string]string)
+	for _, attr := range offer.GetAttributes() {
+		attributes[attr.GetName()] = AttributeValue(attr)
+	}
+
+	return attributes
+}
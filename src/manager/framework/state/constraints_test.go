@@ -0,0 +1,277 @@
+package state
+
+import (
+	"testing"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/manager/swancontext"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOffer(id, hostname string, attributes ...*mesos.Attribute) *mesos.Offer {
+	return &mesos.Offer{
+		Id:          &mesos.OfferID{Value: proto.String(id)},
+		FrameworkId: &mesos.FrameworkID{Value: proto.String("swan")},
+		AgentId:     &mesos.AgentID{Value: proto.String("agent-" + hostname)},
+		Hostname:    proto.String(hostname),
+		Resources: []*mesos.Resource{
+			createScalarResource("cpus", 4),
+			createScalarResource("mem", 4096),
+			createScalarResource("disk", 10240),
+		},
+		Attributes: attributes,
+	}
+}
+
+func textAttribute(name, value string) *mesos.Attribute {
+	return &mesos.Attribute{
+		Name: proto.String(name),
+		Type: mesos.Value_TEXT.Enum(),
+		Text: &mesos.Value_Text{Value: proto.String(value)},
+	}
+}
+
+func scalarAttribute(name string, value float64) *mesos.Attribute {
+	return &mesos.Attribute{
+		Name:   proto.String(name),
+		Type:   mesos.Value_SCALAR.Enum(),
+		Scalar: &mesos.Value_Scalar{Value: proto.Float64(value)},
+	}
+}
+
+// newTestApp returns an app with slots placed on the given offers, the last
+// slot of the app is still waiting for an offer
+func newTestApp(constraints []string, placed ...*mesos.Offer) (*App, *Slot) {
+	version := &types.Version{
+		AppId:       "test",
+		Cpus:        0.1,
+		Mem:         16,
+		Disk:        1,
+		Constraints: constraints,
+	}
+
+	app := &App{
+		AppId:          version.AppId,
+		CurrentVersion: version,
+		Mode:           APP_MODE_REPLICATES,
+		Scontext:       &swancontext.SwanContext{EventBus: swanevent.New()},
+		slots:          make(map[int]*Slot),
+	}
+
+	for index, offer := range placed {
+		slot := &Slot{Index: index, App: app, Version: version, State: SLOT_STATE_TASK_RUNNING}
+		slot.CurrentTask = &Task{Slot: slot, Version: version}
+		slot.AgentId = offer.GetAgentId().GetValue()
+		slot.CurrentTask.AgentId = slot.AgentId
+		slot.AgentHostName = offer.GetHostname()
+		slot.AgentAttributes = OfferAttributes(offer)
+		slot.restartPolicy = NewRestartPolicy(slot, 0, testAndRestart)
+		app.slots[index] = slot
+	}
+
+	pending := &Slot{Index: len(placed), App: app, Version: version, State: SLOT_STATE_PENDING_OFFER}
+	pending.CurrentTask = &Task{Slot: pending, Version: version}
+	pending.restartPolicy = NewRestartPolicy(pending, 0, testAndRestart)
+	app.slots[pending.Index] = pending
+
+	return app, pending
+}
+
+func TestParseConstraint(t *testing.T) {
+	c, err := ParseConstraint("Hostname:unique")
+	assert.Nil(t, err)
+	assert.Equal(t, CONSTRAINT_FIELD_HOSTNAME, c.Field)
+	assert.Equal(t, CONSTRAINT_OPERATOR_UNIQUE, c.Operator)
+
+	c, err = ParseConstraint("rack:LIKE:rack-[0-9]:a")
+	assert.Nil(t, err)
+	assert.Equal(t, "rack-[0-9]:a", c.Value)
+
+	for _, malformed := range []string{
+		"hostname",
+		":UNIQUE",
+		"hostname:UNIQUE:1",
+		"rack:LIKE",
+		"rack:MAX_PER",
+		"rack:MAX_PER:0",
+		"rack:GROUP_BY:x",
+		"rack:UNKNOWN:1",
+	} {
+		_, err := ParseConstraint(malformed)
+		assert.NotNil(t, err, malformed)
+	}
+}
+
+func TestAttributeValue(t *testing.T) {
+	assert.Equal(t, "3", AttributeValue(scalarAttribute("level", 3)))
+	assert.Equal(t, "2.5", AttributeValue(scalarAttribute("level", 2.5)))
+	assert.Equal(t, "rack-1", AttributeValue(textAttribute("rack", "rack-1")))
+
+	set := &mesos.Attribute{
+		Name: proto.String("disks"),
+		Type: mesos.Value_SET.Enum(),
+		Set:  &mesos.Value_Set{Item: []string{"ssd", "hdd"}},
+	}
+	assert.Equal(t, "{hdd, ssd}", AttributeValue(set))
+
+	ranges := &mesos.Attribute{
+		Name: proto.String("ports"),
+		Type: mesos.Value_RANGES.Enum(),
+		Ranges: &mesos.Value_Ranges{Range: []*mesos.Value_Range{
+			{Begin: proto.Uint64(1), End: proto.Uint64(5)},
+			{Begin: proto.Uint64(8), End: proto.Uint64(9)},
+		}},
+	}
+	assert.Equal(t, "[1-5, 8-9]", AttributeValue(ranges))
+}
+
+func TestUniqueConstraint(t *testing.T) {
+	host1 := newTestOffer("o1", "host1", textAttribute("rack", "r1"))
+	host2 := newTestOffer("o2", "host2", textAttribute("rack", "r1"))
+	host3 := newTestOffer("o3", "host3", textAttribute("rack", "r2"))
+
+	_, slot := newTestApp([]string{"hostname:UNIQUE"}, host1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host2)))
+
+	_, slot = newTestApp([]string{"rack:UNIQUE"}, host1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host2)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host3)))
+
+	// offer without the attribute never satisfy UNIQUE
+	_, slot = newTestApp([]string{"zone:UNIQUE"})
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+}
+
+func TestUniqueConstraintIgnoresTerminatedSlots(t *testing.T) {
+	host1 := newTestOffer("o1", "host1")
+
+	app, slot := newTestApp([]string{"hostname:UNIQUE"}, host1)
+	placed, _ := app.GetSlot(0)
+	placed.State = SLOT_STATE_TASK_FAILED
+
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+}
+
+func TestClusterConstraint(t *testing.T) {
+	r1 := newTestOffer("o1", "host1", textAttribute("rack", "r1"))
+	r2 := newTestOffer("o2", "host2", textAttribute("rack", "r2"))
+	noRack := newTestOffer("o3", "host3")
+
+	_, slot := newTestApp([]string{"rack:CLUSTER:r2"})
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(r1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(r2)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(noRack)))
+
+	// without a value, colocate with the already placed slots
+	_, slot = newTestApp([]string{"rack:CLUSTER"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(r1)))
+
+	_, slot = newTestApp([]string{"rack:CLUSTER"}, r1)
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(r1)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(r2)))
+}
+
+func TestGroupByConstraint(t *testing.T) {
+	a1 := newTestOffer("o1", "host1", textAttribute("zone", "a"))
+	a2 := newTestOffer("o2", "host2", textAttribute("zone", "a"))
+	b1 := newTestOffer("o3", "host3", textAttribute("zone", "b"))
+	c1 := newTestOffer("o4", "host4", textAttribute("zone", "c"))
+
+	// expect 3 zones, only zone a got an instance so far
+	_, slot := newTestApp([]string{"zone:GROUP_BY:3"}, a1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(a2)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(b1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(c1)))
+
+	// every zone got one, any zone is fine
+	_, slot = newTestApp([]string{"zone:GROUP_BY:3"}, a1, b1, c1)
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(a2)))
+
+	// a: 2, b: 1, c: 1, a is over-represented
+	_, slot = newTestApp([]string{"zone:GROUP_BY:3"}, a1, a2, b1, c1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(a1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(b1)))
+
+	// without expected group count, spread over the known groups
+	_, slot = newTestApp([]string{"zone:GROUP_BY"}, a1, a2, b1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(a1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(b1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(c1)))
+}
+
+func TestMaxPerConstraint(t *testing.T) {
+	host1 := newTestOffer("o1", "host1", scalarAttribute("level", 1))
+	host2 := newTestOffer("o2", "host2", scalarAttribute("level", 2))
+
+	_, slot := newTestApp([]string{"hostname:MAX_PER:2"}, host1)
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+
+	_, slot = newTestApp([]string{"hostname:MAX_PER:2"}, host1, host1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host2)))
+
+	_, slot = newTestApp([]string{"level:MAX_PER:1"}, host1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host2)))
+}
+
+func TestLikeAndUnlikeConstraint(t *testing.T) {
+	ssd := newTestOffer("o1", "host1", textAttribute("disk", "ssd"), scalarAttribute("level", 12))
+	hdd := newTestOffer("o2", "host2", textAttribute("disk", "hdd"))
+
+	_, slot := newTestApp([]string{"disk:LIKE:ssd"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(hdd)))
+
+	// LIKE matches part of the value unless anchored
+	_, slot = newTestApp([]string{"disk:LIKE:s"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+	_, slot = newTestApp([]string{"disk:LIKE:^s$"})
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+
+	// not a regular expression, matched as plain text
+	odd := newTestOffer("o3", "host3", textAttribute("disk", "ssd[1"))
+	_, slot = newTestApp([]string{"disk:LIKE:d[1"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(odd)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+
+	_, slot = newTestApp([]string{"level:LIKE:1[0-9]"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(hdd)))
+
+	_, slot = newTestApp([]string{"disk:UNLIKE:hdd"})
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(hdd)))
+
+	// UNLIKE is satisfied when the agent doesn't have the attribute
+	_, slot = newTestApp([]string{"level:UNLIKE:12"})
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(ssd)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(hdd)))
+}
+
+func TestAllConstraintsShouldMatch(t *testing.T) {
+	host1 := newTestOffer("o1", "host1", textAttribute("disk", "ssd"))
+	host2 := newTestOffer("o2", "host2", textAttribute("disk", "ssd"))
+	host3 := newTestOffer("o3", "host3", textAttribute("disk", "hdd"))
+
+	_, slot := newTestApp([]string{"hostname:UNIQUE", "disk:LIKE:ssd"}, host1)
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host1)))
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(host2)))
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(host3)))
+}
+
+func TestOfferMatchResources(t *testing.T) {
+	host1 := newTestOffer("o1", "host1")
+
+	_, slot := newTestApp([]string{})
+	ow := NewOfferWrapper(host1)
+	assert.True(t, slot.TestOfferMatch(ow))
+
+	ow.CpusUsed = 3.95
+	assert.False(t, slot.TestOfferMatch(ow))
+}
@@ -9,10 +9,13 @@ import (
 
 func AppToRaft(app *App) *rafttypes.Application {
 	raftApp := &rafttypes.Application{
-		ID:        app.AppId,
-		CreatedAt: app.Created.UnixNano(),
-		UpdatedAt: app.Updated.UnixNano(),
-		State:     app.State,
+		ID:           app.AppId,
+		CreatedAt:    app.Created.UnixNano(),
+		UpdatedAt:    app.Updated.UnixNano(),
+		State:        app.State,
+		StateMessage: app.StateMessage,
+		UpdatePaused: app.UpdatePaused,
+		CanaryWeight: app.CanaryWeight,
 	}
 
 	if app.CurrentVersion != nil {
@@ -28,20 +31,31 @@ func AppToRaft(app *App) *rafttypes.Application {
 
 func VersionToRaft(version *types.Version) *rafttypes.Version {
 	raftVersion := &rafttypes.Version{
-		ID:          version.ID,
-		Command:     version.Command,
-		Cpus:        version.Cpus,
-		Mem:         version.Mem,
-		Disk:        version.Disk,
-		Instances:   version.Instances,
-		RunAs:       version.RunAs,
-		Labels:      version.Labels,
-		Env:         version.Env,
-		Constraints: version.Constraints,
-		Uris:        version.Uris,
-		Ip:          version.Ip,
-		Mode:        version.Mode,
-		AppId:       version.AppId,
+		ID:                version.ID,
+		Command:           version.Command,
+		Args:              version.Args,
+		Cpus:              version.Cpus,
+		Mem:               version.Mem,
+		Disk:              version.Disk,
+		Instances:         version.Instances,
+		RunAs:             version.RunAs,
+		Labels:            version.Labels,
+		Env:               version.Env,
+		Constraints:       version.Constraints,
+		Uris:              version.Uris,
+		Ip:                version.Ip,
+		Mode:              version.Mode,
+		AppId:             version.AppId,
+		PlacementStrategy: version.PlacementStrategy,
+		Priority:          version.Priority,
+
+		BackoffSeconds:        version.BackoffSeconds,
+		BackoffFactor:         version.BackoffFactor,
+		MaxLaunchDelaySeconds: version.MaxLaunchDelaySeconds,
+		MaxRestarts:           version.MaxRestarts,
+
+		MaxConsecutiveUnhealthy: version.MaxConsecutiveUnhealthy,
+		MaxUnhealthySeconds:     version.MaxUnhealthySeconds,
 	}
 
 	if version.Container != nil {
@@ -64,25 +78,40 @@ func VersionToRaft(version *types.Version) *rafttypes.Version {
 		raftVersion.HealthChecks = healthChecks
 	}
 
+	if version.ReadinessCheck != nil {
+		raftVersion.ReadinessCheck = ReadinessCheckToRaft(version.ReadinessCheck)
+	}
+
 	return raftVersion
 }
 
 func VersionFromRaft(raftVersion *rafttypes.Version) *types.Version {
 	version := &types.Version{
-		ID:          raftVersion.ID,
-		AppId:       raftVersion.AppId,
-		Command:     raftVersion.Command,
-		Cpus:        raftVersion.Cpus,
-		Mem:         raftVersion.Mem,
-		Disk:        raftVersion.Disk,
-		Instances:   raftVersion.Instances,
-		RunAs:       raftVersion.RunAs,
-		Labels:      raftVersion.Labels,
-		Env:         raftVersion.Env,
-		Constraints: raftVersion.Constraints,
-		Uris:        raftVersion.Uris,
-		Ip:          raftVersion.Ip,
-		Mode:        raftVersion.Mode,
+		ID:                raftVersion.ID,
+		AppId:             raftVersion.AppId,
+		Command:           raftVersion.Command,
+		Args:              raftVersion.Args,
+		Cpus:              raftVersion.Cpus,
+		Mem:               raftVersion.Mem,
+		Disk:              raftVersion.Disk,
+		Instances:         raftVersion.Instances,
+		RunAs:             raftVersion.RunAs,
+		Labels:            raftVersion.Labels,
+		Env:               raftVersion.Env,
+		Constraints:       raftVersion.Constraints,
+		Uris:              raftVersion.Uris,
+		Ip:                raftVersion.Ip,
+		Mode:              raftVersion.Mode,
+		PlacementStrategy: raftVersion.PlacementStrategy,
+		Priority:          raftVersion.Priority,
+
+		BackoffSeconds:        raftVersion.BackoffSeconds,
+		BackoffFactor:         raftVersion.BackoffFactor,
+		MaxLaunchDelaySeconds: raftVersion.MaxLaunchDelaySeconds,
+		MaxRestarts:           raftVersion.MaxRestarts,
+
+		MaxConsecutiveUnhealthy: raftVersion.MaxConsecutiveUnhealthy,
+		MaxUnhealthySeconds:     raftVersion.MaxUnhealthySeconds,
 	}
 
 	if raftVersion.Container != nil {
@@ -106,12 +135,17 @@ func VersionFromRaft(raftVersion *rafttypes.Version) *types.Version {
 		version.HealthChecks = healthChecks
 	}
 
+	if raftVersion.ReadinessCheck != nil {
+		version.ReadinessCheck = ReadinessCheckFromRaft(raftVersion.ReadinessCheck)
+	}
+
 	return version
 }
 
 func ContainerToRaft(container *types.Container) *rafttypes.Container {
 	raftContainer := &rafttypes.Container{
-		Type: container.Type,
+		Type:       container.Type,
+		Entrypoint: container.Entrypoint,
 	}
 
 	if container.Docker != nil {
@@ -133,7 +167,8 @@ func ContainerToRaft(container *types.Container) *rafttypes.Container {
 
 func ContainerFromContainer(raftContainer *rafttypes.Container) *types.Container {
 	container := &types.Container{
-		Type: raftContainer.Type,
+		Type:       raftContainer.Type,
+		Entrypoint: raftContainer.Entrypoint,
 	}
 
 	if raftContainer.Docker != nil {
@@ -243,30 +278,90 @@ func PortMappingFromRaft(raftPortMapping *rafttypes.PortMapping) *types.PortMapp
 }
 
 func VolumeToRaft(volume *types.Volume) *rafttypes.Volume {
-	return &rafttypes.Volume{
+	raftVolume := &rafttypes.Volume{
 		ContainerPath: volume.ContainerPath,
 		HostPath:      volume.HostPath,
 		Mode:          volume.Mode,
 	}
+
+	if volume.Persistent != nil {
+		raftVolume.Persistent = &rafttypes.PersistentVolume{
+			Size_: volume.Persistent.Size,
+		}
+	}
+
+	return raftVolume
 }
 
 func VolumeFromFaft(raftVolume *rafttypes.Volume) *types.Volume {
-	return &types.Volume{
+	volume := &types.Volume{
 		ContainerPath: raftVolume.ContainerPath,
 		HostPath:      raftVolume.HostPath,
 		Mode:          raftVolume.Mode,
 	}
+
+	if raftVolume.Persistent != nil {
+		volume.Persistent = &types.PersistentVolume{
+			Size: raftVolume.Persistent.Size_,
+		}
+	}
+
+	return volume
+}
+
+func SlotVolumeToRaft(volume *SlotVolume) *rafttypes.SlotVolume {
+	return &rafttypes.SlotVolume{
+		Id:            volume.Id,
+		ContainerPath: volume.ContainerPath,
+		Size_:         volume.Size,
+		AgentId:       volume.AgentId,
+		Pending:       volume.Pending,
+	}
+}
+
+func SlotVolumeFromRaft(raftVolume *rafttypes.SlotVolume) *SlotVolume {
+	return &SlotVolume{
+		Id:            raftVolume.Id,
+		ContainerPath: raftVolume.ContainerPath,
+		Size:          raftVolume.Size_,
+		AgentId:       raftVolume.AgentId,
+		Pending:       raftVolume.Pending,
+	}
+}
+
+func AppVolumesToRaft(appId string, index int, volumes []*SlotVolume) *rafttypes.AppVolumes {
+	raftAppVolumes := &rafttypes.AppVolumes{
+		AppId: appId,
+		Index: int32(index),
+	}
+
+	for _, volume := range volumes {
+		raftAppVolumes.Volumes = append(raftAppVolumes.Volumes, SlotVolumeToRaft(volume))
+	}
+
+	return raftAppVolumes
+}
+
+func AppVolumesFromRaft(raftAppVolumes *rafttypes.AppVolumes) []*SlotVolume {
+	volumes := make([]*SlotVolume, 0)
+	for _, raftVolume := range raftAppVolumes.Volumes {
+		volumes = append(volumes, SlotVolumeFromRaft(raftVolume))
+	}
+
+	return volumes
 }
 
 func KillPolicyToRaft(killPolicy *types.KillPolicy) *rafttypes.KillPolicy {
 	return &rafttypes.KillPolicy{
-		Duration: killPolicy.Duration,
+		Duration:      killPolicy.Duration,
+		DrainDuration: killPolicy.DrainDuration,
 	}
 }
 
 func KillPolicyFromRaft(raftKillPolicy *rafttypes.KillPolicy) *types.KillPolicy {
 	return &types.KillPolicy{
-		Duration: raftKillPolicy.Duration,
+		Duration:      raftKillPolicy.Duration,
+		DrainDuration: raftKillPolicy.DrainDuration,
 	}
 }
 
@@ -276,6 +371,8 @@ func UpdatePolicyToRaft(updatePolicy *types.UpdatePolicy) *rafttypes.UpdatePolic
 		MaxRetries:   updatePolicy.MaxRetries,
 		MaxFailovers: updatePolicy.MaxFailovers,
 		Action:       updatePolicy.Action,
+		Mode:         updatePolicy.Mode,
+		BatchSize:    updatePolicy.BatchSize,
 	}
 }
 
@@ -285,6 +382,8 @@ func UpdatePolicyFromRaft(raftUpdatePolicy *rafttypes.UpdatePolicy) *types.Updat
 		MaxRetries:   raftUpdatePolicy.MaxRetries,
 		MaxFailovers: raftUpdatePolicy.MaxFailovers,
 		Action:       raftUpdatePolicy.Action,
+		Mode:         raftUpdatePolicy.Mode,
+		BatchSize:    raftUpdatePolicy.BatchSize,
 	}
 }
 
@@ -294,6 +393,7 @@ func HealthCheckToRaft(healthCheck *types.HealthCheck) *rafttypes.HealthCheck {
 		Address:             healthCheck.Address,
 		Protocol:            healthCheck.Protocol,
 		PortName:            healthCheck.PortName,
+		Port:                healthCheck.Port,
 		Path:                healthCheck.Path,
 		ConsecutiveFailures: healthCheck.ConsecutiveFailures,
 		GracePeriodSeconds:  healthCheck.GracePeriodSeconds,
@@ -314,6 +414,7 @@ func HealthCheckFromRaft(raftHealthCheck *rafttypes.HealthCheck) *types.HealthCh
 		Address:             raftHealthCheck.Address,
 		Protocol:            raftHealthCheck.Protocol,
 		PortName:            raftHealthCheck.PortName,
+		Port:                raftHealthCheck.Port,
 		Path:                raftHealthCheck.Path,
 		ConsecutiveFailures: raftHealthCheck.ConsecutiveFailures,
 		GracePeriodSeconds:  raftHealthCheck.GracePeriodSeconds,
@@ -328,6 +429,28 @@ func HealthCheckFromRaft(raftHealthCheck *rafttypes.HealthCheck) *types.HealthCh
 	return healthCheck
 }
 
+func ReadinessCheckToRaft(readinessCheck *types.ReadinessCheck) *rafttypes.ReadinessCheck {
+	return &rafttypes.ReadinessCheck{
+		Protocol:        readinessCheck.Protocol,
+		PortName:        readinessCheck.PortName,
+		Port:            readinessCheck.Port,
+		Path:            readinessCheck.Path,
+		IntervalSeconds: readinessCheck.IntervalSeconds,
+		TimeoutSeconds:  readinessCheck.TimeoutSeconds,
+	}
+}
+
+func ReadinessCheckFromRaft(raftReadinessCheck *rafttypes.ReadinessCheck) *types.ReadinessCheck {
+	return &types.ReadinessCheck{
+		Protocol:        raftReadinessCheck.Protocol,
+		PortName:        raftReadinessCheck.PortName,
+		Port:            raftReadinessCheck.Port,
+		Path:            raftReadinessCheck.Path,
+		IntervalSeconds: raftReadinessCheck.IntervalSeconds,
+		TimeoutSeconds:  raftReadinessCheck.TimeoutSeconds,
+	}
+}
+
 func CommandToRaft(command *types.Command) *rafttypes.Command {
 	return &rafttypes.Command{command.Value}
 }
@@ -348,13 +471,23 @@ func SlotToRaft(slot *Slot) *rafttypes.Slot {
 		MarkForDeletion:      slot.MarkForDeletion(),
 		MarkForRollingUpdate: slot.MarkForRollingUpdate(),
 		Healthy:              slot.Healthy(),
+		Ready:                slot.ready,
+		UpdateFailures:       int32(slot.UpdateFailures),
 	}
 
 	if slot.CurrentTask != nil {
 		raftSlot.CurrentTask = TaskToRaft(slot.CurrentTask)
 	}
 
-	// TODO store slot.restartPolicy
+	if slot.restartPolicy != nil {
+		raftSlot.RestartPolicy = &rafttypes.RestartPolicy{
+			Restarts: int32(slot.restartPolicy.Restarts),
+		}
+	}
+
+	for _, volume := range slot.Volumes {
+		raftSlot.Volumes = append(raftSlot.Volumes, SlotVolumeToRaft(volume))
+	}
 
 	return raftSlot
 }
@@ -369,9 +502,22 @@ func SlotFromRaft(raftSlot *rafttypes.Slot) *Slot {
 		AgentId:              raftSlot.CurrentTask.AgentId,
 		Ip:                   raftSlot.CurrentTask.Ip,
 		AgentHostName:        raftSlot.CurrentTask.AgentHostName,
+		AgentAttributes:      raftSlot.CurrentTask.AgentAttributes,
 		markForDeletion:      raftSlot.MarkForDeletion,
 		markForRollingUpdate: raftSlot.MarkForRollingUpdate,
 		healthy:              raftSlot.Healthy,
+		ready:                raftSlot.Ready,
+		UpdateFailures:       int(raftSlot.UpdateFailures),
+	}
+
+	restarts := 0
+	if raftSlot.RestartPolicy != nil {
+		restarts = int(raftSlot.RestartPolicy.Restarts)
+	}
+	slot.restartPolicy = NewRestartPolicy(slot, restarts, testAndRestart)
+
+	for _, raftVolume := range raftSlot.Volumes {
+		slot.Volumes = append(slot.Volumes, SlotVolumeFromRaft(raftVolume))
 	}
 
 	raftVersion, err := persistentStore.GetVersion(raftSlot.AppId, raftSlot.VersionId)
@@ -384,38 +530,40 @@ func SlotFromRaft(raftSlot *rafttypes.Slot) *Slot {
 
 func TaskToRaft(task *Task) *rafttypes.Task {
 	return &rafttypes.Task{
-		Id:            task.Id,
-		TaskInfoId:    task.TaskInfoId,
-		AppId:         task.Slot.App.AppId,
-		VersionId:     task.Version.ID,
-		SlotId:        task.Slot.Id,
-		State:         task.State,
-		Stdout:        task.Stdout,
-		Stderr:        task.Stderr,
-		HostPorts:     task.HostPorts,
-		OfferId:       task.OfferId,
-		AgentId:       task.AgentId,
-		Ip:            task.Ip,
-		AgentHostName: task.AgentHostName,
-		Reason:        task.Reason,
-		CreatedAt:     task.Created.UnixNano(),
+		Id:              task.Id,
+		TaskInfoId:      task.TaskInfoId,
+		AppId:           task.Slot.App.AppId,
+		VersionId:       task.Version.ID,
+		SlotId:          task.Slot.Id,
+		State:           task.State,
+		Stdout:          task.Stdout,
+		Stderr:          task.Stderr,
+		HostPorts:       task.HostPorts,
+		OfferId:         task.OfferId,
+		AgentId:         task.AgentId,
+		Ip:              task.Ip,
+		AgentHostName:   task.AgentHostName,
+		AgentAttributes: task.AgentAttributes,
+		Reason:          task.Reason,
+		CreatedAt:       task.Created.UnixNano(),
 	}
 }
 
 func TaskFromRaft(raftTask *rafttypes.Task) *Task {
 	task := &Task{
-		Id:            raftTask.Id,
-		TaskInfoId:    raftTask.TaskInfoId,
-		State:         raftTask.State,
-		Stdout:        raftTask.Stdout,
-		Stderr:        raftTask.Stderr,
-		HostPorts:     raftTask.HostPorts,
-		OfferId:       raftTask.OfferId,
-		AgentId:       raftTask.AgentId,
-		Ip:            raftTask.Ip,
-		AgentHostName: raftTask.AgentHostName,
-		Reason:        raftTask.Reason,
-		Created:       time.Unix(0, raftTask.CreatedAt),
+		Id:              raftTask.Id,
+		TaskInfoId:      raftTask.TaskInfoId,
+		State:           raftTask.State,
+		Stdout:          raftTask.Stdout,
+		Stderr:          raftTask.Stderr,
+		HostPorts:       raftTask.HostPorts,
+		OfferId:         raftTask.OfferId,
+		AgentId:         raftTask.AgentId,
+		Ip:              raftTask.Ip,
+		AgentHostName:   raftTask.AgentHostName,
+		AgentAttributes: raftTask.AgentAttributes,
+		Reason:          raftTask.Reason,
+		Created:         time.Unix(0, raftTask.CreatedAt),
 	}
 
 	raftVersion, err := persistentStore.GetVersion(raftTask.AppId, raftTask.VersionId)
@@ -425,3 +573,216 @@ func TaskFromRaft(raftTask *rafttypes.Task) *Task {
 
 	return task
 }
+
+func JobToRaft(job *Job) *rafttypes.Job {
+	raftJob := &rafttypes.Job{
+		ID:                    job.JobId,
+		Completions:           int32(job.Completions),
+		Parallelism:           int32(job.Parallelism),
+		BackoffLimit:          int32(job.BackoffLimit),
+		ActiveDeadlineSeconds: job.ActiveDeadlineSeconds,
+		State:                 job.State,
+		Message:               job.Message,
+		Succeeded:             int32(job.Succeeded),
+		Failed:                int32(job.Failed),
+		StartedAt:             job.Started.UnixNano(),
+	}
+
+	if !job.Completed.IsZero() {
+		raftJob.CompletedAt = job.Completed.UnixNano()
+	}
+
+	return raftJob
+}
+
+func JobFromRaft(raftJob *rafttypes.Job) *Job {
+	job := &Job{
+		JobId:                 raftJob.ID,
+		Completions:           int(raftJob.Completions),
+		Parallelism:           int(raftJob.Parallelism),
+		BackoffLimit:          int(raftJob.BackoffLimit),
+		ActiveDeadlineSeconds: raftJob.ActiveDeadlineSeconds,
+		State:                 raftJob.State,
+		Message:               raftJob.Message,
+		Succeeded:             int(raftJob.Succeeded),
+		Failed:                int(raftJob.Failed),
+		Started:               time.Unix(0, raftJob.StartedAt),
+	}
+
+	if raftJob.CompletedAt != 0 {
+		job.Completed = time.Unix(0, raftJob.CompletedAt)
+	}
+
+	return job
+}
+
+func CronJobToRaft(cronJob *CronJob) *rafttypes.CronJob {
+	spec := cronJob.Spec
+	raftCronJob := &rafttypes.CronJob{
+		ID:                      cronJob.CronJobId,
+		Schedule:                spec.Schedule,
+		TimeZone:                spec.TimeZone,
+		ConcurrencyPolicy:       spec.ConcurrencyPolicy,
+		HistoryLimit:            spec.HistoryLimit,
+		StartingDeadlineSeconds: spec.StartingDeadlineSeconds,
+		Completions:             spec.JobTemplate.Completions,
+		Parallelism:             spec.JobTemplate.Parallelism,
+		BackoffLimit:            spec.JobTemplate.BackoffLimit,
+		ActiveDeadlineSeconds:   spec.JobTemplate.ActiveDeadlineSeconds,
+		Template:                VersionToRaft(spec.JobTemplate.Template),
+		CreatedAt:               cronJob.Created.UnixNano(),
+		Runs:                    cronJob.Runs,
+	}
+
+	if !cronJob.LastScheduled.IsZero() {
+		raftCronJob.LastScheduledAt = cronJob.LastScheduled.UnixNano()
+	}
+
+	return raftCronJob
+}
+
+func CronJobFromRaft(raftCronJob *rafttypes.CronJob) *CronJob {
+	cronJob := &CronJob{
+		CronJobId: raftCronJob.ID,
+		Spec: &types.CronJob{
+			ID:                      raftCronJob.ID,
+			Schedule:                raftCronJob.Schedule,
+			TimeZone:                raftCronJob.TimeZone,
+			ConcurrencyPolicy:       raftCronJob.ConcurrencyPolicy,
+			HistoryLimit:            raftCronJob.HistoryLimit,
+			StartingDeadlineSeconds: raftCronJob.StartingDeadlineSeconds,
+			JobTemplate: &types.Job{
+				Completions:           raftCronJob.Completions,
+				Parallelism:           raftCronJob.Parallelism,
+				BackoffLimit:          raftCronJob.BackoffLimit,
+				ActiveDeadlineSeconds: raftCronJob.ActiveDeadlineSeconds,
+				Template:              VersionFromRaft(raftCronJob.Template),
+			},
+		},
+		Created: time.Unix(0, raftCronJob.CreatedAt),
+		Runs:    raftCronJob.Runs,
+	}
+
+	if cronJob.Runs == nil {
+		cronJob.Runs = make([]string, 0)
+	}
+
+	if raftCronJob.LastScheduledAt != 0 {
+		cronJob.LastScheduled = time.Unix(0, raftCronJob.LastScheduledAt)
+	}
+
+	return cronJob
+}
+
+func QuotaToRaft(quota *types.Quota) *rafttypes.Quota {
+	return &rafttypes.Quota{
+		RunAs:     quota.RunAs,
+		Cpus:      quota.Cpus,
+		Mem:       quota.Mem,
+		Disk:      quota.Disk,
+		Instances: quota.Instances,
+		Ips:       quota.Ips,
+	}
+}
+
+func QuotaFromRaft(raftQuota *rafttypes.Quota) *types.Quota {
+	return &types.Quota{
+		RunAs:     raftQuota.RunAs,
+		Cpus:      raftQuota.Cpus,
+		Mem:       raftQuota.Mem,
+		Disk:      raftQuota.Disk,
+		Instances: raftQuota.Instances,
+		Ips:       raftQuota.Ips,
+	}
+}
+
+func AutoscalerToRaft(autoscaler *Autoscaler) *rafttypes.Autoscaler {
+	spec := autoscaler.Spec
+	raftAutoscaler := &rafttypes.Autoscaler{
+		AppId:                    autoscaler.AppId,
+		MinInstances:             spec.MinInstances,
+		MaxInstances:             spec.MaxInstances,
+		Metric:                   spec.Metric,
+		Target:                   spec.Target,
+		Query:                    spec.Query,
+		IntervalSeconds:          spec.IntervalSeconds,
+		ScaleUpCooldownSeconds:   spec.ScaleUpCooldownSeconds,
+		ScaleDownCooldownSeconds: spec.ScaleDownCooldownSeconds,
+		CreatedAt:                autoscaler.Created.UnixNano(),
+	}
+
+	if !autoscaler.LastScaled.IsZero() {
+		raftAutoscaler.LastScaledAt = autoscaler.LastScaled.UnixNano()
+	}
+
+	return raftAutoscaler
+}
+
+func AutoscalerFromRaft(raftAutoscaler *rafttypes.Autoscaler) *Autoscaler {
+	autoscaler := &Autoscaler{
+		AppId: raftAutoscaler.AppId,
+		Spec: &types.AutoscalePolicy{
+			AppId:                    raftAutoscaler.AppId,
+			MinInstances:             raftAutoscaler.MinInstances,
+			MaxInstances:             raftAutoscaler.MaxInstances,
+			Metric:                   raftAutoscaler.Metric,
+			Target:                   raftAutoscaler.Target,
+			Query:                    raftAutoscaler.Query,
+			IntervalSeconds:          raftAutoscaler.IntervalSeconds,
+			ScaleUpCooldownSeconds:   raftAutoscaler.ScaleUpCooldownSeconds,
+			ScaleDownCooldownSeconds: raftAutoscaler.ScaleDownCooldownSeconds,
+		},
+		Created:   time.Unix(0, raftAutoscaler.CreatedAt),
+		Decisions: make([]*AutoscaleDecision, 0),
+	}
+
+	if raftAutoscaler.LastScaledAt != 0 {
+		autoscaler.LastScaled = time.Unix(0, raftAutoscaler.LastScaledAt)
+	}
+
+	return autoscaler
+}
+
+func ScalingScheduleToRaft(scalingSchedule *ScalingSchedule) *rafttypes.ScalingSchedule {
+	spec := scalingSchedule.Spec
+	raftScalingSchedule := &rafttypes.ScalingSchedule{
+		ID:           scalingSchedule.ScheduleId,
+		AppId:        spec.AppId,
+		Schedule:     spec.Schedule,
+		TimeZone:     spec.TimeZone,
+		Instances:    spec.Instances,
+		MinInstances: spec.MinInstances,
+		MaxInstances: spec.MaxInstances,
+		CreatedAt:    scalingSchedule.Created.UnixNano(),
+		LastError:    scalingSchedule.LastError,
+	}
+
+	if !scalingSchedule.LastScheduled.IsZero() {
+		raftScalingSchedule.LastScheduledAt = scalingSchedule.LastScheduled.UnixNano()
+	}
+
+	return raftScalingSchedule
+}
+
+func ScalingScheduleFromRaft(raftScalingSchedule *rafttypes.ScalingSchedule) *ScalingSchedule {
+	scalingSchedule := &ScalingSchedule{
+		ScheduleId: raftScalingSchedule.ID,
+		Spec: &types.ScalingSchedule{
+			ID:           raftScalingSchedule.ID,
+			AppId:        raftScalingSchedule.AppId,
+			Schedule:     raftScalingSchedule.Schedule,
+			TimeZone:     raftScalingSchedule.TimeZone,
+			Instances:    raftScalingSchedule.Instances,
+			MinInstances: raftScalingSchedule.MinInstances,
+			MaxInstances: raftScalingSchedule.MaxInstances,
+		},
+		Created:   time.Unix(0, raftScalingSchedule.CreatedAt),
+		LastError: raftScalingSchedule.LastError,
+	}
+
+	if raftScalingSchedule.LastScheduledAt != 0 {
+		scalingSchedule.LastScheduled = time.Unix(0, raftScalingSchedule.LastScheduledAt)
+	}
+
+	return scalingSchedule
+}
@@ -0,0 +1,275 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/Dataman-Cloud/swan/src/utils/cron"
+
+	"github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const (
+	CONCURRENCY_POLICY_ALLOW   = "allow"
+	CONCURRENCY_POLICY_FORBID  = "forbid"
+	CONCURRENCY_POLICY_REPLACE = "replace"
+
+	DEFAULT_CRON_JOB_HISTORY_LIMIT = 3
+)
+
+// JobRunner launches, inspects and deletes the jobs run by cron jobs
+type JobRunner interface {
+	CreateJob(spec *types.Job) (*Job, error)
+	InspectJob(jobId string) (*Job, error)
+	DeleteJob(jobId string) error
+}
+
+// CronJob runs a job of its template each time the schedule fires, jobs run
+// by it are named after the cron job and the scheduled time.
+type CronJob struct {
+	CronJobId     string
+	Spec          *types.CronJob
+	Created       time.Time
+	LastScheduled time.Time
+	Runs          []string // ids of the jobs kept, oldest first
+
+	timer  *cron.Timer
+	runner JobRunner
+
+	lock sync.Mutex
+}
+
+func NewCronJob(spec *types.CronJob, runner JobRunner) (*CronJob, error) {
+	cronJob := &CronJob{
+		CronJobId: spec.ID,
+		Spec:      spec,
+		Created:   time.Now(),
+		Runs:      make([]string, 0),
+		runner:    runner,
+	}
+
+	if err := cronJob.init(); err != nil {
+		return nil, err
+	}
+
+	if err := persistentStore.CreateCronJob(context.TODO(), CronJobToRaft(cronJob), nil); err != nil {
+		return nil, err
+	}
+
+	cronJob.Start()
+
+	return cronJob, nil
+}
+
+// validate the spec and parse the schedule
+func (cronJob *CronJob) init() error {
+	spec := cronJob.Spec
+	if len(spec.ID) == 0 {
+		return errors.New("cron job id required")
+	}
+
+	if spec.JobTemplate == nil || spec.JobTemplate.Template == nil {
+		return errors.New("job template of cron job required")
+	}
+
+	timer, err := cron.NewTimer(spec.Schedule, spec.TimeZone, cronJob.fire)
+	if err != nil {
+		return err
+	}
+
+	switch spec.ConcurrencyPolicy {
+	case "":
+		spec.ConcurrencyPolicy = CONCURRENCY_POLICY_ALLOW
+	case CONCURRENCY_POLICY_ALLOW, CONCURRENCY_POLICY_FORBID, CONCURRENCY_POLICY_REPLACE:
+	default:
+		return errors.New(fmt.Sprintf("invalid concurrency policy %s", spec.ConcurrencyPolicy))
+	}
+
+	if spec.HistoryLimit == 0 {
+		spec.HistoryLimit = DEFAULT_CRON_JOB_HISTORY_LIMIT
+	}
+
+	if spec.HistoryLimit < 0 || spec.StartingDeadlineSeconds < 0 {
+		return errors.New("history limit and starting deadline of cron job should not be negative")
+	}
+
+	// validate the job template on a copy, each run formats its own
+	jobSpec := *spec.JobTemplate
+	template := *jobSpec.Template
+	jobSpec.ID, jobSpec.Template = spec.ID, &template
+	if err := validateAndFormatJob(&jobSpec); err != nil {
+		return err
+	}
+
+	cronJob.timer = timer
+
+	return nil
+}
+
+// Start launches the run missed while no leader was around, if not later
+// than the starting deadline, then waits for the next one.
+func (cronJob *CronJob) Start() {
+	cronJob.lock.Lock()
+
+	now := time.Now()
+	if missed := cronJob.lastMissed(now); !missed.IsZero() {
+		deadline := time.Duration(cronJob.Spec.StartingDeadlineSeconds) * time.Second
+		if deadline == 0 || now.Sub(missed) <= deadline {
+			logrus.Infof("cron job %s: starting run missed at %s", cronJob.CronJobId, missed)
+			cronJob.run(missed)
+		} else {
+			logrus.Warnf("cron job %s: run missed at %s exceeded starting deadline, skip it", cronJob.CronJobId, missed)
+		}
+	}
+
+	cronJob.lock.Unlock()
+
+	if cronJob.Next().IsZero() {
+		logrus.Warnf("cron job %s: no more runs scheduled", cronJob.CronJobId)
+	}
+	cronJob.timer.Start(now)
+}
+
+// Stop stops scheduling, jobs already running are left alone
+func (cronJob *CronJob) Stop() {
+	cronJob.timer.Stop()
+}
+
+// Next returns the time of the next run, zero time if none
+func (cronJob *CronJob) Next() time.Time {
+	return cronJob.timer.Next(time.Now())
+}
+
+// the most recent scheduled time passed since last run
+func (cronJob *CronJob) lastMissed(now time.Time) time.Time {
+	since := cronJob.LastScheduled
+	if since.IsZero() {
+		since = cronJob.Created
+	}
+
+	return cronJob.timer.LastMissed(since, now)
+}
+
+func (cronJob *CronJob) fire(scheduled time.Time) {
+	cronJob.lock.Lock()
+	defer cronJob.lock.Unlock()
+
+	cronJob.run(scheduled)
+}
+
+// caller should hold the lock
+func (cronJob *CronJob) run(scheduled time.Time) {
+	defer cronJob.update()
+
+	cronJob.LastScheduled = scheduled
+	cronJob.cleanupRuns()
+
+	active := cronJob.activeRuns()
+	switch cronJob.Spec.ConcurrencyPolicy {
+	case CONCURRENCY_POLICY_FORBID:
+		if len(active) > 0 {
+			logrus.Infof("cron job %s: jobs %v still running, skip run of %s", cronJob.CronJobId, active, scheduled)
+			return
+		}
+	case CONCURRENCY_POLICY_REPLACE:
+		for _, jobId := range active {
+			logrus.Infof("cron job %s: replacing job %s", cronJob.CronJobId, jobId)
+			if err := cronJob.runner.DeleteJob(jobId); err != nil {
+				logrus.Errorf("cron job %s: delete job %s got error: %s", cronJob.CronJobId, jobId, err)
+				continue
+			}
+			cronJob.removeRun(jobId)
+		}
+	}
+
+	jobSpec := *cronJob.Spec.JobTemplate
+	template := *jobSpec.Template
+	jobSpec.ID = fmt.Sprintf("%s-%d", cronJob.CronJobId, scheduled.Unix())
+	jobSpec.Template = &template
+
+	job, err := cronJob.runner.CreateJob(&jobSpec)
+	if err != nil {
+		logrus.Errorf("cron job %s: create job %s got error: %s", cronJob.CronJobId, jobSpec.ID, err)
+		return
+	}
+
+	cronJob.Runs = append(cronJob.Runs, job.JobId)
+}
+
+// ActiveRuns returns ids of the jobs still running
+func (cronJob *CronJob) ActiveRuns() []string {
+	cronJob.lock.Lock()
+	defer cronJob.lock.Unlock()
+
+	return cronJob.activeRuns()
+}
+
+func (cronJob *CronJob) activeRuns() []string {
+	active := make([]string, 0)
+	for _, jobId := range cronJob.Runs {
+		if job, err := cronJob.runner.InspectJob(jobId); err == nil && job.StateIs(JOB_STATE_RUNNING) {
+			active = append(active, jobId)
+		}
+	}
+
+	return active
+}
+
+// forget jobs deleted elsewhere and delete finished jobs beyond the history limit
+func (cronJob *CronJob) cleanupRuns() {
+	finished := make([]string, 0)
+	for _, jobId := range append([]string{}, cronJob.Runs...) {
+		job, err := cronJob.runner.InspectJob(jobId)
+		if err != nil {
+			cronJob.removeRun(jobId)
+			continue
+		}
+
+		if !job.StateIs(JOB_STATE_RUNNING) {
+			finished = append(finished, jobId)
+		}
+	}
+
+	for i := 0; i < len(finished)-int(cronJob.Spec.HistoryLimit); i++ {
+		if err := cronJob.runner.DeleteJob(finished[i]); err != nil {
+			logrus.Errorf("cron job %s: delete job %s got error: %s", cronJob.CronJobId, finished[i], err)
+			continue
+		}
+		cronJob.removeRun(finished[i])
+	}
+}
+
+func (cronJob *CronJob) removeRun(jobId string) {
+	for i, id := range cronJob.Runs {
+		if id == jobId {
+			cronJob.Runs = append(cronJob.Runs[:i], cronJob.Runs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Delete stops scheduling and removes the cron job along with its jobs
+func (cronJob *CronJob) Delete() error {
+	cronJob.Stop()
+
+	cronJob.lock.Lock()
+	defer cronJob.lock.Unlock()
+
+	for _, jobId := range cronJob.Runs {
+		if err := cronJob.runner.DeleteJob(jobId); err != nil {
+			logrus.Errorf("cron job %s: delete job %s got error: %s", cronJob.CronJobId, jobId, err)
+		}
+	}
+
+	return persistentStore.DeleteCronJob(context.TODO(), cronJob.CronJobId, nil)
+}
+
+func (cronJob *CronJob) update() {
+	if err := persistentStore.UpdateCronJob(context.TODO(), CronJobToRaft(cronJob), nil); err != nil {
+		logrus.Errorf("update cron job %s got error: %s", cronJob.CronJobId, err)
+	}
+}
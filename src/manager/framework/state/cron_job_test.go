@@ -0,0 +1,93 @@
+package state
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeJobRunner struct {
+	jobs map[string]*Job
+}
+
+func (runner *fakeJobRunner) CreateJob(spec *types.Job) (*Job, error) {
+	job := &Job{JobId: spec.ID, State: JOB_STATE_RUNNING}
+	runner.jobs[job.JobId] = job
+	return job, nil
+}
+
+func (runner *fakeJobRunner) InspectJob(jobId string) (*Job, error) {
+	job, found := runner.jobs[jobId]
+	if !found {
+		return nil, errors.New("job not exists")
+	}
+	return job, nil
+}
+
+func (runner *fakeJobRunner) DeleteJob(jobId string) error {
+	delete(runner.jobs, jobId)
+	return nil
+}
+
+func newTestCronJob(schedule string) *CronJob {
+	return &CronJob{
+		CronJobId: "cron",
+		Spec:      &types.CronJob{ID: "cron", Schedule: schedule, JobTemplate: &types.Job{Template: &types.Version{}}},
+		Runs:      make([]string, 0),
+		runner:    &fakeJobRunner{jobs: make(map[string]*Job)},
+	}
+}
+
+func TestCronJobInit(t *testing.T) {
+	cronJob := newTestCronJob("*/5 * * * *")
+	assert.Nil(t, cronJob.init())
+	assert.Equal(t, CONCURRENCY_POLICY_ALLOW, cronJob.Spec.ConcurrencyPolicy)
+	assert.Equal(t, int32(DEFAULT_CRON_JOB_HISTORY_LIMIT), cronJob.Spec.HistoryLimit)
+	assert.Equal(t, "", cronJob.Spec.JobTemplate.Template.AppId) // formatted on a copy
+
+	cronJob = newTestCronJob("* * * *")
+	assert.NotNil(t, cronJob.init())
+
+	cronJob = newTestCronJob("* * * * *")
+	cronJob.Spec.TimeZone = "Nowhere/Nothing"
+	assert.NotNil(t, cronJob.init())
+
+	cronJob = newTestCronJob("* * * * *")
+	cronJob.Spec.ConcurrencyPolicy = "queue"
+	assert.NotNil(t, cronJob.init())
+}
+
+func TestCronJobLastMissed(t *testing.T) {
+	cronJob := newTestCronJob("0 * * * *")
+	cronJob.Spec.TimeZone = "UTC"
+	assert.Nil(t, cronJob.init())
+
+	cronJob.Created = time.Date(2017, time.March, 1, 9, 30, 0, 0, time.UTC)
+	now := time.Date(2017, time.March, 1, 12, 10, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC), cronJob.lastMissed(now))
+
+	cronJob.LastScheduled = time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC)
+	assert.True(t, cronJob.lastMissed(now).IsZero())
+}
+
+func TestCronJobCleanupRuns(t *testing.T) {
+	cronJob := newTestCronJob("* * * * *")
+	cronJob.Spec.HistoryLimit = 1
+	runner := cronJob.runner.(*fakeJobRunner)
+
+	for _, id := range []string{"cron-1", "cron-2", "cron-3", "cron-4"} {
+		runner.CreateJob(&types.Job{ID: id})
+		cronJob.Runs = append(cronJob.Runs, id)
+	}
+	runner.jobs["cron-1"].State = JOB_STATE_SUCCEEDED
+	runner.jobs["cron-2"].State = JOB_STATE_FAILED
+	delete(runner.jobs, "cron-3")
+
+	cronJob.cleanupRuns()
+	assert.Equal(t, []string{"cron-2", "cron-4"}, cronJob.Runs)
+	assert.Equal(t, []string{"cron-4"}, cronJob.activeRuns())
+}
@@ -1,57 +1,112 @@
 package state
 
 import (
+	"math"
 	"time"
 
 	"github.com/Sirupsen/logrus"
 )
 
+const (
+	DEFAULT_BACKOFF_SECONDS          = 10
+	DEFAULT_BACKOFF_FACTOR           = 2
+	DEFAULT_MAX_LAUNCH_DELAY_SECONDS = 300
+)
+
 type TestAndRestartFunc func(slot *Slot) bool
 
+// RestartPolicy relaunches the task of a slot after it failed, with a delay
+// growing by BackoffFactor each consecutive restart. knobs are read from the
+// slot version each time, so an app update takes effect on next restart.
 type RestartPolicy struct {
-	BackoffSeconds        time.Duration
-	BackoffFactor         float64
-	MaxLaunchDelaySeconds time.Duration
+	// consecutive restarts since the task last ran stable
+	Restarts int
 
-	currentInterval time.Duration
-	checkTimer      *time.Timer
-	restartFunc     TestAndRestartFunc
+	checkTimer  *time.Timer
+	restartFunc TestAndRestartFunc
 
 	slot *Slot
 }
 
-func NewRestartPolicy(slot *Slot, BackoffSeconds time.Duration, BackoffFactor float64,
-	MaxLaunchDelaySeconds time.Duration, restartFunc TestAndRestartFunc) *RestartPolicy {
+func NewRestartPolicy(slot *Slot, restarts int, restartFunc TestAndRestartFunc) *RestartPolicy {
+	return &RestartPolicy{
+		Restarts:    restarts,
+		slot:        slot,
+		restartFunc: restartFunc,
+	}
+}
 
-	p := &RestartPolicy{
-		BackoffSeconds:        BackoffSeconds,
-		BackoffFactor:         BackoffFactor,
-		MaxLaunchDelaySeconds: MaxLaunchDelaySeconds,
-		currentInterval:       BackoffSeconds,
-		slot:                  slot,
-		restartFunc:           restartFunc,
+func (rs *RestartPolicy) BackoffSeconds() time.Duration {
+	if rs.slot.Version.BackoffSeconds > 0 {
+		return floatSeconds(rs.slot.Version.BackoffSeconds)
 	}
 
-	p.checkTimer = time.AfterFunc(p.currentInterval, func() {
-		p.restartAndSetNextTimer()
-	})
+	return DEFAULT_BACKOFF_SECONDS * time.Second
+}
+
+func (rs *RestartPolicy) BackoffFactor() float64 {
+	if rs.slot.Version.BackoffFactor > 0 {
+		return rs.slot.Version.BackoffFactor
+	}
+
+	return DEFAULT_BACKOFF_FACTOR
+}
+
+func (rs *RestartPolicy) MaxLaunchDelaySeconds() time.Duration {
+	if rs.slot.Version.MaxLaunchDelaySeconds > 0 {
+		return floatSeconds(rs.slot.Version.MaxLaunchDelaySeconds)
+	}
+
+	return DEFAULT_MAX_LAUNCH_DELAY_SECONDS * time.Second
+}
+
+// delay before next restart
+func (rs *RestartPolicy) Delay() time.Duration {
+	delay := rs.BackoffSeconds().Seconds() * math.Pow(rs.BackoffFactor(), float64(rs.Restarts))
+	if maxDelay := rs.MaxLaunchDelaySeconds(); delay > maxDelay.Seconds() {
+		return maxDelay
+	}
 
-	return p
+	return floatSeconds(delay)
 }
 
-func (rs *RestartPolicy) restartAndSetNextTimer() {
-	logrus.Debug("call restartFunc now")
+// reached max restarts of the version, if any
+func (rs *RestartPolicy) Exhausted() bool {
+	return rs.slot.Version.MaxRestarts > 0 && rs.Restarts >= int(rs.slot.Version.MaxRestarts)
+}
 
-	stopCheck := rs.restartFunc(rs.slot)
-	rs.currentInterval = time.Duration(rs.currentInterval.Seconds()*rs.BackoffFactor) * time.Second
-	if !stopCheck && rs.currentInterval < rs.MaxLaunchDelaySeconds {
-		rs.checkTimer = time.AfterFunc(rs.currentInterval, rs.restartAndSetNextTimer)
+// schedule restart of the slot, return false if no more restart allowed
+func (rs *RestartPolicy) ScheduleRestart() bool {
+	if rs.Exhausted() {
+		return false
 	}
+
+	rs.Stop()
+
+	delay := rs.Delay()
+	rs.Restarts += 1
+
+	logrus.Infof("restart slot %s in %s, restarts %d", rs.slot.Id, delay, rs.Restarts)
+	rs.checkTimer = time.AfterFunc(delay, func() {
+		rs.restartFunc(rs.slot)
+	})
+
+	return true
+}
+
+// task ran long enough, start over the backoff
+func (rs *RestartPolicy) Reset() {
+	rs.Restarts = 0
 }
 
 func (rs *RestartPolicy) Stop() {
 	if rs.checkTimer != nil {
 		logrus.Infof("stop RestartPolicy timer for slot %s", rs.slot.Id)
 		rs.checkTimer.Stop()
+		rs.checkTimer = nil
 	}
 }
+
+func floatSeconds(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
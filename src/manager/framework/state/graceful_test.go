@@ -0,0 +1,38 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartPolicyDelay(t *testing.T) {
+	slot := &Slot{Version: &types.Version{}}
+	policy := NewRestartPolicy(slot, 0, testAndRestart)
+	assert.Equal(t, DEFAULT_BACKOFF_SECONDS*time.Second, policy.Delay())
+
+	slot.Version = &types.Version{BackoffSeconds: 1, BackoffFactor: 2, MaxLaunchDelaySeconds: 10}
+	for restarts, expected := range []time.Duration{1, 2, 4, 8, 10, 10} {
+		policy.Restarts = restarts
+		assert.Equal(t, expected*time.Second, policy.Delay())
+	}
+}
+
+func TestRestartPolicyExhausted(t *testing.T) {
+	slot := &Slot{Version: &types.Version{}}
+	policy := NewRestartPolicy(slot, 100, testAndRestart)
+	assert.False(t, policy.Exhausted())
+
+	slot.Version.MaxRestarts = 3
+	policy.Restarts = 2
+	assert.False(t, policy.Exhausted())
+
+	policy.Restarts = 3
+	assert.True(t, policy.Exhausted())
+	assert.False(t, policy.ScheduleRestart())
+
+	policy.Reset()
+	assert.False(t, policy.Exhausted())
+}
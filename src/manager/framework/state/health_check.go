@@ -0,0 +1,346 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/Dataman-Cloud/swan/src/utils"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+)
+
+// Health checks of a version are combined, the task is healthy once all of
+// them passed and unhealthy as soon as any of them failed. mesos runs one
+// health check per task, which is the command check if any, or the first
+// check of replicates mode app, the others are probed by swan itself. http
+// and tcp checks of fixed mode app probe the container ip.
+
+func validateHealthChecks(version *types.Version) error {
+	portNames := make([]string, 0)
+	if IsDockerContainer(version) {
+		for _, portMapping := range version.Container.Docker.PortMappings {
+			portNames = append(portNames, portMapping.Name)
+		}
+	}
+
+	commandChecks := 0
+	for _, hc := range version.HealthChecks {
+		switch strings.ToLower(hc.Protocol) {
+		case "command":
+			if hc.Command == nil || len(strings.TrimSpace(hc.Command.Value)) == 0 {
+				return errors.New("no command provided for health check with COMMAND protocol")
+			}
+
+			commandChecks += 1
+			continue
+
+		case "http":
+			if len(hc.Path) == 0 {
+				return errors.New("no path provided for health check with HTTP protocol")
+			}
+
+		case "tcp":
+
+		default:
+			return errors.New(fmt.Sprintf("doesn't recoginized protocol %s for health check", hc.Protocol))
+		}
+
+		if version.Mode == string(APP_MODE_FIXED) {
+			if hc.Port <= 0 {
+				return errors.New("port of container ip should be provided for health check of fixed mode app")
+			}
+			continue
+		}
+
+		// portName for health check should mandatory
+		if strings.TrimSpace(hc.PortName) == "" {
+			return errors.New("port name should not empty and match name in docker's PortMappings")
+		}
+
+		// portName should present in dockers' portMappings definition
+		if !utils.SliceContains(portNames, hc.PortName) {
+			return errors.New(fmt.Sprintf("no port name %s found in docker's PortMappings", hc.PortName))
+		}
+	}
+
+	if commandChecks > 1 {
+		return errors.New("only one health check with COMMAND protocol supported")
+	}
+
+	return nil
+}
+
+// index of the health check run by mesos, -1 if all probed by swan
+func MesosHealthCheckIndex(version *types.Version) int {
+	for index, hc := range version.HealthChecks {
+		if strings.ToLower(hc.Protocol) == "command" {
+			return index
+		}
+	}
+
+	if len(version.HealthChecks) > 0 && version.Mode != string(APP_MODE_FIXED) {
+		return 0
+	}
+
+	return -1
+}
+
+func (task *Task) prepareHealthCheck(taskInfo *mesos.TaskInfo) {
+	index := MesosHealthCheckIndex(task.Slot.Version)
+	if index < 0 {
+		return
+	}
+
+	healthCheck := task.Slot.Version.HealthChecks[index]
+	switch strings.ToLower(healthCheck.Protocol) {
+	case "command":
+		taskInfo.HealthCheck = &mesos.HealthCheck{
+			Type: mesos.HealthCheck_COMMAND.Enum(),
+			Command: &mesos.CommandInfo{
+				Shell: proto.Bool(true),
+				Value: proto.String(healthCheck.Command.Value),
+			},
+		}
+
+	case "http", "tcp":
+		if taskInfo.Container == nil || taskInfo.Container.Docker == nil {
+			return
+		}
+
+		var hostPort *uint32
+		for _, portMapping := range task.Slot.Version.Container.Docker.PortMappings {
+			if portMapping.Name == healthCheck.PortName {
+				containerPort := portMapping.ContainerPort
+				for _, portMapping := range taskInfo.Container.Docker.PortMappings {
+					if uint32(containerPort) == *portMapping.ContainerPort {
+						hostPort = portMapping.HostPort
+					}
+				}
+			}
+		}
+
+		if strings.ToLower(healthCheck.Protocol) == "http" {
+			taskInfo.HealthCheck = &mesos.HealthCheck{
+				Type: mesos.HealthCheck_HTTP.Enum(),
+				Http: &mesos.HealthCheck_HTTPCheckInfo{
+					Scheme:   proto.String("http"),
+					Port:     hostPort,
+					Path:     &healthCheck.Path,
+					Statuses: []uint32{uint32(200), uint32(201), uint32(301), uint32(302)},
+				},
+			}
+		} else {
+			taskInfo.HealthCheck = &mesos.HealthCheck{
+				Type: mesos.HealthCheck_TCP.Enum(),
+				Tcp: &mesos.HealthCheck_TCPCheckInfo{
+					Port: hostPort,
+				},
+			}
+		}
+
+	default:
+		return
+	}
+
+	taskInfo.HealthCheck.IntervalSeconds = proto.Float64(healthCheck.IntervalSeconds)
+	taskInfo.HealthCheck.TimeoutSeconds = proto.Float64(healthCheck.TimeoutSeconds)
+	taskInfo.HealthCheck.ConsecutiveFailures = proto.Uint32(healthCheck.ConsecutiveFailures)
+	taskInfo.HealthCheck.GracePeriodSeconds = proto.Float64(healthCheck.GracePeriodSeconds)
+}
+
+// HealthReport is a health check result probed by swan, or the expiry of
+// MaxUnhealthySeconds or the unreachable grace period of the slot, applied by
+// the scheduler loop so it doesn't race with the status updates of mesos
+type HealthReport struct {
+	slot        *Slot
+	checker     *HealthChecker // nil for the timers
+	healthy     bool
+	unreachable bool // the unreachable timer expired
+}
+
+var healthReports = make(chan *HealthReport, 1024)
+
+// HealthReports returns the reports the scheduler loop should apply
+func HealthReports() <-chan *HealthReport {
+	return healthReports
+}
+
+// Apply records the report, called by the scheduler loop
+func (report *HealthReport) Apply() {
+	slot := report.slot
+	if report.unreachable {
+		slot.replaceUnreachableTask()
+		return
+	}
+
+	if report.checker == nil {
+		// stale timer of an earlier unhealthy period
+		if slot.unhealthySince.IsZero() || time.Since(slot.unhealthySince) < floatSeconds(slot.Version.MaxUnhealthySeconds) {
+			return
+		}
+		slot.replaceUnhealthyTask()
+		return
+	}
+
+	slot.reportHealth(report.checker, report.healthy)
+}
+
+// HealthChecker probes a health check of the task from swan, reporting
+// unhealthy each time ConsecutiveFailures probes in a row failed, and healthy
+// when a probe passed after that.
+type HealthChecker struct {
+	slot  *Slot
+	index int
+	check *types.HealthCheck
+	stopC chan struct{}
+}
+
+func NewHealthChecker(slot *Slot, index int) *HealthChecker {
+	return &HealthChecker{
+		slot:  slot,
+		index: index,
+		check: slot.Version.HealthChecks[index],
+		stopC: make(chan struct{}),
+	}
+}
+
+func (hc *HealthChecker) Start() {
+	interval := floatSeconds(hc.check.IntervalSeconds)
+	if interval <= 0 {
+		interval = DEFAULT_READINESS_INTERVAL_SECONDS * time.Second
+	}
+
+	timeout := floatSeconds(hc.check.TimeoutSeconds)
+	if timeout <= 0 {
+		timeout = DEFAULT_READINESS_TIMEOUT_SECONDS * time.Second
+	}
+
+	maxFailures := int(hc.check.ConsecutiveFailures)
+	if maxFailures < 1 {
+		maxFailures = 1
+	}
+
+	go func() {
+		select {
+		case <-hc.stopC:
+			return
+		case <-time.After(floatSeconds(hc.check.GracePeriodSeconds)):
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		failures, reportedHealthy := 0, false
+		for {
+			select {
+			case <-hc.stopC:
+				return
+			case <-ticker.C:
+			}
+
+			address, err := hc.slot.checkAddress(hc.check.PortName, hc.check.Port)
+			if err == nil {
+				err = probe(hc.check.Protocol, address, hc.check.Path, timeout)
+			}
+
+			if err == nil {
+				if !reportedHealthy {
+					if !hc.report(true) {
+						return
+					}
+					reportedHealthy = true
+				}
+				failures = 0
+				continue
+			}
+
+			failures += 1
+			logrus.Debugf("health check %d of slot %s failed: %s", hc.index, hc.slot.Id, err)
+			if failures >= maxFailures {
+				if !hc.report(false) {
+					return
+				}
+				reportedHealthy = false
+			}
+		}
+	}()
+}
+
+// send the result to the scheduler loop, false if stopped meanwhile
+func (hc *HealthChecker) report(healthy bool) bool {
+	select {
+	case healthReports <- &HealthReport{slot: hc.slot, checker: hc, healthy: healthy}:
+		return true
+	case <-hc.stopC:
+		return false
+	}
+}
+
+func (hc *HealthChecker) Stop() {
+	close(hc.stopC)
+}
+
+// start probing the health checks mesos doesn't run
+func (slot *Slot) startHealthCheckers() {
+	slot.stopHealthCheckers()
+
+	mesosIndex := MesosHealthCheckIndex(slot.Version)
+	for index, hc := range slot.Version.HealthChecks {
+		if index == mesosIndex || strings.ToLower(hc.Protocol) == "command" {
+			continue
+		}
+
+		checker := NewHealthChecker(slot, index)
+		slot.healthCheckers = append(slot.healthCheckers, checker)
+		checker.Start()
+	}
+}
+
+func (slot *Slot) stopHealthCheckers() {
+	for _, checker := range slot.healthCheckers {
+		checker.Stop()
+	}
+	slot.healthCheckers = nil
+}
+
+// record result of one of the health checks, nil checker for the one run by mesos
+func (slot *Slot) reportHealth(checker *HealthChecker, healthy bool) {
+	index := MesosHealthCheckIndex(slot.Version)
+	if checker != nil {
+		found := false
+		for _, hc := range slot.healthCheckers {
+			found = found || hc == checker
+		}
+
+		if !found { // stopped already
+			return
+		}
+		index = checker.index
+	}
+
+	if index < 0 {
+		return
+	}
+
+	if slot.checkResults == nil {
+		slot.checkResults = make(map[int]bool)
+	}
+	slot.checkResults[index] = healthy
+
+	for _, result := range slot.checkResults {
+		if !result {
+			slot.applyHealth(false)
+			return
+		}
+	}
+
+	// all passed, or waiting for results of the others
+	if len(slot.checkResults) == len(slot.Version.HealthChecks) {
+		slot.applyHealth(true)
+	}
+}
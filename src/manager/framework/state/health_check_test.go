@@ -0,0 +1,94 @@
+package state
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHealthChecks(t *testing.T) {
+	version := &types.Version{
+		Mode:      string(APP_MODE_REPLICATES),
+		Container: &types.Container{Docker: &types.Docker{PortMappings: []*types.PortMapping{{Name: "web"}}}},
+		HealthChecks: []*types.HealthCheck{
+			{Protocol: "HTTP", PortName: "web", Path: "/health"},
+			{Protocol: "command", Command: &types.Command{Value: "test -f /tmp/healthy"}},
+		},
+	}
+	assert.Nil(t, validateHealthChecks(version))
+
+	version.HealthChecks = append(version.HealthChecks, &types.HealthCheck{Protocol: "COMMAND", Command: &types.Command{Value: "true"}})
+	assert.NotNil(t, validateHealthChecks(version))
+
+	version.HealthChecks = []*types.HealthCheck{{Protocol: "tcp", PortName: "db"}}
+	assert.NotNil(t, validateHealthChecks(version))
+
+	version.HealthChecks = []*types.HealthCheck{{Protocol: "command"}}
+	assert.NotNil(t, validateHealthChecks(version))
+
+	version.Mode = string(APP_MODE_FIXED)
+	version.HealthChecks = []*types.HealthCheck{{Protocol: "tcp", Port: 8080}}
+	assert.Nil(t, validateHealthChecks(version))
+
+	version.HealthChecks = []*types.HealthCheck{{Protocol: "tcp", PortName: "web"}}
+	assert.NotNil(t, validateHealthChecks(version))
+}
+
+func TestMesosHealthCheckIndex(t *testing.T) {
+	version := &types.Version{Mode: string(APP_MODE_REPLICATES)}
+	assert.Equal(t, -1, MesosHealthCheckIndex(version))
+
+	version.HealthChecks = []*types.HealthCheck{{Protocol: "http"}, {Protocol: "tcp"}}
+	assert.Equal(t, 0, MesosHealthCheckIndex(version))
+
+	version.HealthChecks = append(version.HealthChecks, &types.HealthCheck{Protocol: "COMMAND"})
+	assert.Equal(t, 2, MesosHealthCheckIndex(version))
+
+	version.Mode = string(APP_MODE_FIXED)
+	assert.Equal(t, 2, MesosHealthCheckIndex(version))
+
+	version.HealthChecks = version.HealthChecks[:2]
+	assert.Equal(t, -1, MesosHealthCheckIndex(version))
+}
+
+func TestHealthCheckersReportToLoop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	host, portStr, _ := net.SplitHostPort(server.Listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	app, _ := newTestApp([]string{}, newTestOffer("offer-1", "host-1"))
+	app.Mode = APP_MODE_FIXED
+	app.CurrentVersion.Mode = string(APP_MODE_FIXED)
+	slot := app.slots[0]
+	slot.Ip = host
+	slot.Version.HealthChecks = []*types.HealthCheck{
+		{Protocol: "http", Port: int32(port), Path: "/health", IntervalSeconds: 0.01},
+		{Protocol: "tcp", Port: int32(port), IntervalSeconds: 0.01},
+	}
+	slot.BeginTx() // nothing persisted in tests
+
+	slot.startHealthCheckers()
+	assert.Equal(t, 2, len(slot.healthCheckers))
+
+	// the scheduler loop
+	timeout := time.After(5 * time.Second)
+	for !slot.healthy {
+		select {
+		case report := <-HealthReports():
+			report.Apply()
+		case <-timeout:
+			t.Fatal("no health reports from checkers")
+		}
+	}
+
+	slot.stopHealthCheckers()
+	assert.Equal(t, 2, len(slot.checkResults))
+}
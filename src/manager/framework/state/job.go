@@ -0,0 +1,260 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/manager/swancontext"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const (
+	JOB_STATE_RUNNING   = "running"
+	JOB_STATE_SUCCEEDED = "succeeded"
+	JOB_STATE_FAILED    = "failed"
+)
+
+// Job runs tasks of its template until Completions of them finished
+// successfully, the tasks run in the slots of an app in job mode, one slot
+// for each of the tasks running in parallel.
+type Job struct {
+	JobId                 string
+	Completions           int
+	Parallelism           int
+	BackoffLimit          int
+	ActiveDeadlineSeconds int64
+
+	State     string
+	Message   string
+	Succeeded int
+	Failed    int
+	Started   time.Time
+	Completed time.Time
+
+	App *App
+
+	lock          sync.Mutex
+	deadlineTimer *time.Timer
+}
+
+func NewJob(spec *types.Job, allocator *OfferAllocator, scontext *swancontext.SwanContext) (*Job, error) {
+	if err := validateAndFormatJob(spec); err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		JobId:                 spec.ID,
+		Completions:           int(spec.Completions),
+		Parallelism:           int(spec.Parallelism),
+		BackoffLimit:          int(spec.BackoffLimit),
+		ActiveDeadlineSeconds: spec.ActiveDeadlineSeconds,
+		State:                 JOB_STATE_RUNNING,
+		Started:               time.Now(),
+	}
+
+	if err := persistentStore.CreateJob(context.TODO(), JobToRaft(job), nil); err != nil {
+		return nil, err
+	}
+
+	app, err := NewApp(spec.Template, allocator, scontext)
+	if err != nil {
+		persistentStore.DeleteJob(context.TODO(), job.JobId, nil)
+		return nil, err
+	}
+
+	job.App = app
+	app.job = job
+	job.startDeadlineTimer()
+
+	return job, nil
+}
+
+func validateAndFormatJob(spec *types.Job) error {
+	if len(spec.ID) == 0 {
+		return errors.New("job id required")
+	}
+
+	if spec.Template == nil {
+		return errors.New("job template required")
+	}
+
+	if spec.Completions == 0 {
+		spec.Completions = 1
+	}
+
+	if spec.Parallelism == 0 {
+		spec.Parallelism = 1
+	}
+
+	if spec.Completions < 0 || spec.Parallelism < 0 || spec.BackoffLimit < 0 || spec.ActiveDeadlineSeconds < 0 {
+		return errors.New("completions, parallelism, backoff limit and active deadline of job should not be negative")
+	}
+
+	// one slot for each task running in parallel
+	instances := spec.Parallelism
+	if spec.Completions < instances {
+		instances = spec.Completions
+	}
+
+	spec.Template.AppId = spec.ID
+	spec.Template.Mode = string(APP_MODE_JOB)
+	spec.Template.Instances = instances
+
+	return nil
+}
+
+func (job *Job) StateIs(state string) bool {
+	return job.State == state
+}
+
+// tasks running or going to be relaunched
+func (job *Job) Active() int {
+	active := 0
+	for _, slot := range job.App.GetSlots() {
+		if !slot.StateIs(SLOT_STATE_TASK_FINISHED) && !slot.StateIs(SLOT_STATE_TASK_KILLED) &&
+			!slot.StateIs(SLOT_STATE_TASK_ERROR) && !slot.StateIs(SLOT_STATE_CRASH_LOOPING) {
+			active += 1
+		}
+	}
+
+	return active
+}
+
+// task finished successfully, relaunch the slot if more completions needed
+func (job *Job) onTaskFinished(slot *Slot) {
+	job.lock.Lock()
+	defer job.lock.Unlock()
+
+	if !job.StateIs(JOB_STATE_RUNNING) {
+		return
+	}
+
+	job.Succeeded += 1
+	logrus.Infof("job %s: task of slot %s finished, %d/%d completions", job.JobId, slot.Id, job.Succeeded, job.Completions)
+
+	if job.Succeeded >= job.Completions {
+		job.complete(JOB_STATE_SUCCEEDED, "")
+		return
+	}
+
+	if job.Succeeded+job.Active() < job.Completions {
+		slot.Archive()
+		slot.DispatchNewTask(slot.Version)
+	}
+
+	job.update()
+}
+
+// task failed, retry with backoff until BackoffLimit failures reached
+func (job *Job) onTaskFailed(slot *Slot) {
+	job.lock.Lock()
+	defer job.lock.Unlock()
+
+	if !job.StateIs(JOB_STATE_RUNNING) {
+		return
+	}
+
+	job.Failed += 1
+	logrus.Warnf("job %s: task of slot %s failed with state %s, %d failures", job.JobId, slot.Id, slot.State, job.Failed)
+
+	if slot.StateIs(SLOT_STATE_TASK_ERROR) { // relaunch won't help
+		job.complete(JOB_STATE_FAILED, fmt.Sprintf("task of slot %s got error", slot.Id))
+		return
+	}
+
+	if job.Failed > job.BackoffLimit {
+		job.complete(JOB_STATE_FAILED, fmt.Sprintf("backoff limit %d exceeded", job.BackoffLimit))
+		return
+	}
+
+	slot.scheduleRestart()
+	if slot.StateIs(SLOT_STATE_CRASH_LOOPING) { // max restarts of the template reached
+		job.complete(JOB_STATE_FAILED, fmt.Sprintf("task of slot %s crash looping after %d restarts", slot.Id, slot.Restarts()))
+		return
+	}
+
+	job.update()
+}
+
+func (job *Job) startDeadlineTimer() {
+	if job.ActiveDeadlineSeconds <= 0 || !job.StateIs(JOB_STATE_RUNNING) {
+		return
+	}
+
+	deadline := job.Started.Add(time.Duration(job.ActiveDeadlineSeconds) * time.Second)
+	job.deadlineTimer = time.AfterFunc(deadline.Sub(time.Now()), func() {
+		jobDeadlines <- &JobDeadline{job: job}
+	})
+}
+
+// JobDeadline is the active deadline of a job exceeded, applied by the
+// scheduler loop as it kills the slots of the job
+type JobDeadline struct {
+	job *Job
+}
+
+var jobDeadlines = make(chan *JobDeadline, 1024)
+
+// JobDeadlines returns the deadlines the scheduler loop should apply
+func JobDeadlines() <-chan *JobDeadline {
+	return jobDeadlines
+}
+
+// Apply fails the job unless it completed meanwhile, called by the scheduler loop
+func (deadline *JobDeadline) Apply() {
+	job := deadline.job
+	job.lock.Lock()
+	defer job.lock.Unlock()
+
+	if job.StateIs(JOB_STATE_RUNNING) {
+		job.complete(JOB_STATE_FAILED, fmt.Sprintf("active deadline %ds exceeded", job.ActiveDeadlineSeconds))
+	}
+}
+
+// caller should hold the lock
+func (job *Job) complete(state, message string) {
+	job.State = state
+	job.Message = message
+	job.Completed = time.Now()
+	logrus.Infof("job %s %s %s", job.JobId, state, message)
+
+	if job.deadlineTimer != nil {
+		job.deadlineTimer.Stop()
+	}
+
+	for _, slot := range job.App.GetSlots() {
+		slot.StopRestartPolicy()
+		if !slot.Terminated() {
+			slot.KillTask()
+		}
+	}
+
+	job.update()
+}
+
+// Delete kills the running tasks and removes the job along with its app
+func (job *Job) Delete() error {
+	job.lock.Lock()
+	defer job.lock.Unlock()
+
+	if job.deadlineTimer != nil {
+		job.deadlineTimer.Stop()
+	}
+
+	if err := job.App.Delete(); err != nil {
+		return err
+	}
+
+	return persistentStore.DeleteJob(context.TODO(), job.JobId, nil)
+}
+
+func (job *Job) update() {
+	if err := persistentStore.UpdateJob(context.TODO(), JobToRaft(job), nil); err != nil {
+		logrus.Errorf("update job %s got error: %s", job.JobId, err)
+	}
+}
@@ -0,0 +1,149 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAndFormatJob(t *testing.T) {
+	spec := &types.Job{ID: "job", Completions: 5, Parallelism: 2, Template: &types.Version{}}
+	assert.Nil(t, validateAndFormatJob(spec))
+	assert.Equal(t, "job", spec.Template.AppId)
+	assert.Equal(t, string(APP_MODE_JOB), spec.Template.Mode)
+	assert.Equal(t, int32(2), spec.Template.Instances)
+
+	spec = &types.Job{ID: "job", Parallelism: 3, Template: &types.Version{}}
+	assert.Nil(t, validateAndFormatJob(spec))
+	assert.Equal(t, int32(1), spec.Completions)
+	assert.Equal(t, int32(1), spec.Template.Instances)
+
+	assert.NotNil(t, validateAndFormatJob(&types.Job{Template: &types.Version{}}))
+	assert.NotNil(t, validateAndFormatJob(&types.Job{ID: "job"}))
+	assert.NotNil(t, validateAndFormatJob(&types.Job{ID: "job", BackoffLimit: -1, Template: &types.Version{}}))
+}
+
+func TestJobActive(t *testing.T) {
+	app, slot := newTestApp([]string{}, newTestOffer("o1", "host1"), newTestOffer("o2", "host2"))
+	job := &Job{JobId: "test", Completions: 3, State: JOB_STATE_RUNNING, App: app}
+	assert.Equal(t, 3, job.Active())
+
+	app.slots[0].State = SLOT_STATE_TASK_FINISHED
+	slot.State = SLOT_STATE_TASK_FAILED // waiting for restart
+	assert.Equal(t, 2, job.Active())
+}
+
+// job of two slots, the first running and the other pending
+func newTestJob(completions, backoffLimit int) *Job {
+	app, _ := newTestApp([]string{}, newTestOffer("o1", "host1"))
+	app.OfferAllocatorRef = NewOfferAllocator()
+
+	job := &Job{JobId: "test", Completions: completions, Parallelism: 2, BackoffLimit: backoffLimit, State: JOB_STATE_RUNNING, App: app}
+	app.job = job
+
+	return job
+}
+
+func TestJobTaskFinished(t *testing.T) {
+	_, tearDown := setUpTestStore()
+	defer tearDown()
+
+	job := newTestJob(3, 0)
+	first, second := job.App.slots[0], job.App.slots[1]
+
+	// one more completion needed than running, relaunched
+	first.SetState(SLOT_STATE_TASK_FINISHED)
+	assert.Equal(t, 1, job.Succeeded)
+	assert.Equal(t, SLOT_STATE_PENDING_OFFER, first.State)
+
+	// the other slot completes the rest
+	first.SetState(SLOT_STATE_TASK_FINISHED)
+	assert.Equal(t, 2, job.Succeeded)
+	assert.Equal(t, SLOT_STATE_TASK_FINISHED, first.State)
+	assert.True(t, job.StateIs(JOB_STATE_RUNNING))
+
+	second.SetState(SLOT_STATE_TASK_FINISHED)
+	assert.Equal(t, 3, job.Succeeded)
+	assert.True(t, job.StateIs(JOB_STATE_SUCCEEDED))
+}
+
+func TestJobBackoffLimitExceeded(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	job := newTestJob(2, 1)
+	first, second := job.App.slots[0], job.App.slots[1]
+
+	first.SetState(SLOT_STATE_TASK_FAILED)
+	assert.Equal(t, 1, job.Failed)
+	assert.Equal(t, 1, first.Restarts())
+	assert.True(t, job.StateIs(JOB_STATE_RUNNING))
+
+	// failed once more than allowed, the task pending is killed
+	first.State = SLOT_STATE_TASK_RUNNING
+	first.SetState(SLOT_STATE_TASK_FAILED)
+	assert.Equal(t, 2, job.Failed)
+	assert.True(t, job.StateIs(JOB_STATE_FAILED))
+	assert.Equal(t, "backoff limit 1 exceeded", job.Message)
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+	assert.Equal(t, SLOT_STATE_PENDING_KILL, second.State)
+}
+
+func TestJobTaskError(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	job := newTestJob(2, 3)
+	job.App.slots[0].SetState(SLOT_STATE_TASK_ERROR)
+	assert.Equal(t, 1, job.Failed)
+	assert.True(t, job.StateIs(JOB_STATE_FAILED))
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+}
+
+func TestJobCrashLooping(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	job := newTestJob(2, 5)
+	job.App.CurrentVersion.MaxRestarts = 1
+	first := job.App.slots[0]
+
+	first.SetState(SLOT_STATE_TASK_FAILED)
+	assert.True(t, job.StateIs(JOB_STATE_RUNNING))
+
+	// restarts of the template exhausted before the backoff limit
+	first.State = SLOT_STATE_TASK_RUNNING
+	first.SetState(SLOT_STATE_TASK_FAILED)
+	assert.Equal(t, SLOT_STATE_CRASH_LOOPING, first.State)
+	assert.True(t, job.StateIs(JOB_STATE_FAILED))
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+}
+
+func TestJobDeadlineReportsToLoop(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	job := newTestJob(3, 0)
+	job.ActiveDeadlineSeconds = 1
+	job.Started = time.Now().Add(-time.Minute)
+	job.startDeadlineTimer()
+
+	var deadline *JobDeadline
+	select {
+	case deadline = <-JobDeadlines():
+	case <-time.After(time.Second):
+		t.Fatal("deadline not reported")
+	}
+
+	// nothing killed till the scheduler loop applies it
+	assert.True(t, job.StateIs(JOB_STATE_RUNNING))
+	assert.Equal(t, 0, len(calls))
+
+	deadline.Apply()
+	assert.True(t, job.StateIs(JOB_STATE_FAILED))
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+}
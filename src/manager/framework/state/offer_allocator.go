@@ -3,47 +3,194 @@ package state
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
 )
 
+// OfferFlow stops offers from mesos while no slot is pending and gets them
+// flowing again once slots are queued
+type OfferFlow interface {
+	Suppress()
+	Revive()
+}
+
+// appQueue holds the pending slots of an app in the order they are queued
+type appQueue struct {
+	appId      string
+	priority   int32
+	slots      []*Slot
+	delayUntil time.Time // slots held back till then after launch failures
+}
+
+// OfferAllocator queues pending slots per app. slots are dequeued from the
+// apps of the highest priority in turn, so a large app doesn't starve the
+// others, apps delayed after launch failures are skipped till the delay is
+// over.
 type OfferAllocator struct {
-	PendingOfferSlots []*Slot
-	BySlotName        map[string]*mesos.OfferID // record allocated offers that map slot
+	pendingQueues map[string]*appQueue
+	rotation      []string                  // app ids in round robin order, head served next
+	BySlotName    map[string]*mesos.OfferID // record allocated offers that map slot
 
-	pendingOfferRWLock sync.RWMutex
+	offerFlow OfferFlow
+
+	pendingLock        sync.Mutex
 	allocatedOfferLock sync.Mutex
 }
 
 func NewOfferAllocator() *OfferAllocator {
 	allocator := &OfferAllocator{
-		PendingOfferSlots:  make([]*Slot, 0),
+		pendingQueues:      make(map[string]*appQueue),
+		rotation:           make([]string, 0),
 		BySlotName:         make(map[string]*mesos.OfferID),
-		pendingOfferRWLock: sync.RWMutex{},
+		pendingLock:        sync.Mutex{},
 		allocatedOfferLock: sync.Mutex{},
 	}
 
 	return allocator
 }
 
+// next slot waiting for offers, nil if none or all pending apps delayed
 func (allocator *OfferAllocator) NextPendingOffer() *Slot {
-	if len(allocator.PendingOfferSlots) == 0 {
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	now := time.Now()
+	var next *appQueue
+	for _, appId := range append([]string{}, allocator.rotation...) {
+		queue := allocator.pendingQueues[appId]
+		if now.Before(queue.delayUntil) {
+			continue
+		}
+
+		if len(queue.slots) == 0 { // delay over with nothing pending
+			allocator.removeFromRotation(appId)
+			delete(allocator.pendingQueues, appId)
+			continue
+		}
+
+		if next == nil || queue.priority > next.priority {
+			next = queue
+		}
+	}
+
+	if next == nil {
 		return nil
 	}
 
-	slot := allocator.PendingOfferSlots[0]
-	allocator.pendingOfferRWLock.Lock()
-	pendingOfferSize := len(allocator.PendingOfferSlots)
-	allocator.PendingOfferSlots = allocator.PendingOfferSlots[1:pendingOfferSize]
-	allocator.pendingOfferRWLock.Unlock()
+	slot := next.slots[0]
+	next.slots = next.slots[1:]
+
+	// served app goes to the end of the rotation
+	allocator.removeFromRotation(next.appId)
+	if len(next.slots) > 0 || now.Before(next.delayUntil) {
+		allocator.rotation = append(allocator.rotation, next.appId)
+	} else {
+		delete(allocator.pendingQueues, next.appId)
+	}
 
 	return slot
 }
 
 func (allocator *OfferAllocator) PutSlotBackToPendingQueue(slot *Slot) {
-	allocator.pendingOfferRWLock.Lock()
-	allocator.PendingOfferSlots = append(allocator.PendingOfferSlots, slot)
-	allocator.pendingOfferRWLock.Unlock()
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	queue := allocator.appQueue(slot.App.AppId)
+	queue.priority = slot.Version.Priority
+	queue.slots = append(queue.slots, slot)
+
+	if allocator.offerFlow != nil {
+		allocator.offerFlow.Revive()
+	}
+}
+
+// hold back pending slots of the app for the delay after its tasks failed
+func (allocator *OfferAllocator) DelayLaunch(appId string, delay time.Duration) {
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	allocator.appQueue(appId).delayUntil = time.Now().Add(delay)
+
+	// offers may have been declined for long while the app was delayed
+	time.AfterFunc(delay, func() {
+		allocator.pendingLock.Lock()
+		defer allocator.pendingLock.Unlock()
+
+		if queue, found := allocator.pendingQueues[appId]; found && len(queue.slots) > 0 && allocator.offerFlow != nil {
+			allocator.offerFlow.Revive()
+		}
+	})
+}
+
+// task of the app launched fine, its pending slots are no longer delayed
+func (allocator *OfferAllocator) ResetLaunchDelay(appId string) {
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	queue, found := allocator.pendingQueues[appId]
+	if !found {
+		return
+	}
+
+	queue.delayUntil = time.Time{}
+	if len(queue.slots) == 0 {
+		allocator.removeFromRotation(appId)
+		delete(allocator.pendingQueues, appId)
+	}
+}
+
+// number of slots waiting for offers, delayed ones included
+func (allocator *OfferAllocator) PendingSlots() int {
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	return allocator.pendingSlots()
+}
+
+func (allocator *OfferAllocator) pendingSlots() int {
+	pending := 0
+	for _, queue := range allocator.pendingQueues {
+		pending += len(queue.slots)
+	}
+
+	return pending
+}
+
+// queue of the app, created at the end of the rotation if not found
+func (allocator *OfferAllocator) appQueue(appId string) *appQueue {
+	queue, found := allocator.pendingQueues[appId]
+	if !found {
+		queue = &appQueue{appId: appId, slots: make([]*Slot, 0)}
+		allocator.pendingQueues[appId] = queue
+		allocator.rotation = append(allocator.rotation, appId)
+	}
+
+	return queue
+}
+
+func (allocator *OfferAllocator) removeFromRotation(appId string) {
+	for index, id := range allocator.rotation {
+		if id == appId {
+			allocator.rotation = append(allocator.rotation[:index], allocator.rotation[index+1:]...)
+			return
+		}
+	}
+}
+
+func (allocator *OfferAllocator) SetOfferFlow(offerFlow OfferFlow) {
+	allocator.offerFlow = offerFlow
+}
+
+// suppress offers once all the pending slots got offers, checked under the
+// same lock slots are queued with, so no slot is left waiting suppressed
+func (allocator *OfferAllocator) SuppressOffersIfDrained() {
+	allocator.pendingLock.Lock()
+	defer allocator.pendingLock.Unlock()
+
+	if allocator.pendingSlots() == 0 && allocator.offerFlow != nil {
+		allocator.offerFlow.Suppress()
+	}
 }
 
 func (allocator *OfferAllocator) SetOfferIdForSlotId(offerId *mesos.OfferID, slotName string) {
@@ -52,30 +199,45 @@ func (allocator *OfferAllocator) SetOfferIdForSlotId(offerId *mesos.OfferID, slo
 	allocator.allocatedOfferLock.Unlock()
 }
 
+// remove all the slots mapped to the offer
 func (allocator *OfferAllocator) DeleteSlotIdOfferIdMap(offerId *mesos.OfferID) {
-	key := ""
+	allocator.allocatedOfferLock.Lock()
+	defer allocator.allocatedOfferLock.Unlock()
+
 	for k, v := range allocator.BySlotName {
-		if v.Value == offerId.Value {
-			key = k
+		if v.GetValue() == offerId.GetValue() {
+			delete(allocator.BySlotName, k)
 		}
 	}
+}
 
+// offer of the slot is consumed once mesos reports any status of the task
+func (allocator *OfferAllocator) DeleteOfferIdForSlotId(slotName string) {
 	allocator.allocatedOfferLock.Lock()
-	delete(allocator.BySlotName, key)
+	delete(allocator.BySlotName, slotName)
 	allocator.allocatedOfferLock.Unlock()
 }
 
 func (allocator *OfferAllocator) RetriveSlotIdOfferId(offerId *mesos.OfferID) (string, error) {
-	key := ""
-	for k, v := range allocator.BySlotName {
-		if v.Value == offerId.Value {
-			key = k
-		}
+	slotIds := allocator.RetriveSlotIdsByOfferId(offerId)
+	if len(slotIds) == 0 {
+		return "", errors.New("not found")
 	}
 
-	if len(key) == 0 {
-		return "", errors.New("not found")
+	return slotIds[0], nil
+}
+
+// one offer may be used to launch several slots
+func (allocator *OfferAllocator) RetriveSlotIdsByOfferId(offerId *mesos.OfferID) []string {
+	allocator.allocatedOfferLock.Lock()
+	defer allocator.allocatedOfferLock.Unlock()
+
+	slotIds := make([]string, 0)
+	for k, v := range allocator.BySlotName {
+		if v.GetValue() == offerId.GetValue() {
+			slotIds = append(slotIds, k)
+		}
 	}
 
-	return key, nil
+	return slotIds
 }
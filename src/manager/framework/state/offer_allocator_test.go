@@ -0,0 +1,94 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotIdOfferIdMap(t *testing.T) {
+	allocator := NewOfferAllocator()
+	o1 := &mesos.OfferID{Value: proto.String("o1")}
+	o2 := &mesos.OfferID{Value: proto.String("o2")}
+
+	allocator.SetOfferIdForSlotId(o1, "0-app")
+	allocator.SetOfferIdForSlotId(o1, "1-app")
+	allocator.SetOfferIdForSlotId(o2, "2-app")
+
+	slotIds := allocator.RetriveSlotIdsByOfferId(o1)
+	assert.Len(t, slotIds, 2)
+	assert.Contains(t, slotIds, "0-app")
+	assert.Contains(t, slotIds, "1-app")
+
+	allocator.DeleteOfferIdForSlotId("2-app")
+	_, err := allocator.RetriveSlotIdOfferId(o2)
+	assert.NotNil(t, err)
+
+	allocator.DeleteSlotIdOfferIdMap(&mesos.OfferID{Value: proto.String("o1")})
+	assert.Empty(t, allocator.RetriveSlotIdsByOfferId(o1))
+	assert.Empty(t, allocator.BySlotName)
+}
+
+type fakeOfferFlow struct {
+	suppressed bool
+}
+
+func (flow *fakeOfferFlow) Suppress() { flow.suppressed = true }
+func (flow *fakeOfferFlow) Revive()   { flow.suppressed = false }
+
+func TestOfferFlow(t *testing.T) {
+	allocator := NewOfferAllocator()
+	flow := &fakeOfferFlow{}
+	allocator.SetOfferFlow(flow)
+
+	_, slot := newTestApp(nil)
+	allocator.PutSlotBackToPendingQueue(slot)
+	allocator.SuppressOffersIfDrained()
+	assert.False(t, flow.suppressed)
+
+	assert.NotNil(t, allocator.NextPendingOffer())
+	allocator.SuppressOffersIfDrained()
+	assert.True(t, flow.suppressed)
+
+	allocator.PutSlotBackToPendingQueue(slot)
+	assert.False(t, flow.suppressed)
+}
+
+func pendingTestSlot(appId string, index int, priority int32) *Slot {
+	version := &types.Version{AppId: appId, Priority: priority}
+	return &Slot{Id: fmt.Sprintf("%d-%s", index, appId), Index: index, App: &App{AppId: appId}, Version: version}
+}
+
+func TestPendingQueue(t *testing.T) {
+	allocator := NewOfferAllocator()
+	for index := 0; index < 3; index++ {
+		allocator.PutSlotBackToPendingQueue(pendingTestSlot("big", index, 0))
+	}
+	allocator.PutSlotBackToPendingQueue(pendingTestSlot("small", 0, 0))
+	allocator.PutSlotBackToPendingQueue(pendingTestSlot("urgent", 0, 10))
+	assert.Equal(t, 5, allocator.PendingSlots())
+
+	// highest priority first, then apps in turn
+	dequeued := make([]string, 0)
+	for slot := allocator.NextPendingOffer(); slot != nil; slot = allocator.NextPendingOffer() {
+		dequeued = append(dequeued, slot.Id)
+	}
+	assert.Equal(t, []string{"0-urgent", "0-big", "0-small", "1-big", "2-big"}, dequeued)
+
+	allocator.DelayLaunch("big", time.Hour)
+	allocator.PutSlotBackToPendingQueue(pendingTestSlot("big", 0, 0))
+	allocator.PutSlotBackToPendingQueue(pendingTestSlot("small", 0, 0))
+	assert.Equal(t, "0-small", allocator.NextPendingOffer().Id)
+	assert.Nil(t, allocator.NextPendingOffer())
+	assert.Equal(t, 1, allocator.PendingSlots())
+
+	allocator.ResetLaunchDelay("big")
+	assert.Equal(t, "0-big", allocator.NextPendingOffer().Id)
+	assert.Empty(t, allocator.pendingQueues)
+	assert.Empty(t, allocator.rotation)
+}
@@ -1,7 +1,11 @@
 package state
 
 import (
+	"math"
+
 	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+
+	"github.com/golang/protobuf/proto"
 )
 
 // wrapper offer to record offer reserve history
@@ -11,6 +15,11 @@ type OfferWrapper struct {
 	MemUsed      float64
 	DiskUsed     float64
 	PortUsedSize int
+
+	allocated map[int]float64 // scalar allocated by index of offered resources
+
+	// operations applied before launching tasks with the offer
+	Operations []*mesos.Offer_Operation
 }
 
 func NewOfferWrapper(offer *mesos.Offer) *OfferWrapper {
@@ -20,6 +29,7 @@ func NewOfferWrapper(offer *mesos.Offer) *OfferWrapper {
 		MemUsed:      0,
 		DiskUsed:     0,
 		PortUsedSize: 0,
+		allocated:    make(map[int]float64),
 	}
 	return o
 }
@@ -64,10 +74,127 @@ func (ow *OfferWrapper) MemRemain() float64 {
 func (ow *OfferWrapper) DiskRemain() float64 {
 	var disk float64
 	for _, res := range ow.Offer.GetResources() {
-		if res.GetName() == "disk" {
+		if res.GetName() == "disk" && res.Disk == nil { // persistent volumes excluded
 			disk += *res.GetScalar().Value
 		}
 	}
 
 	return disk - ow.DiskUsed
 }
+
+// role the offer is allocated to, empty unless swan registered with roles
+func (ow *OfferWrapper) Role() string {
+	for _, res := range ow.Offer.GetResources() {
+		if res.GetAllocationInfo() != nil {
+			return res.GetAllocationInfo().GetRole()
+		}
+	}
+
+	return ""
+}
+
+// Allocate carves the resources requested out of the offer, pieces allocated
+// keep role, reservation and allocation info of the offered resources they
+// come from. reserved resources are allocated first.
+func (ow *OfferWrapper) Allocate(requested []*mesos.Resource) []*mesos.Resource {
+	resources := make([]*mesos.Resource, 0)
+	for _, req := range requested {
+		switch req.GetType() {
+		case mesos.Value_SCALAR:
+			resources = append(resources, ow.allocateScalar(req.GetName(), req.GetScalar().GetValue(), true, false)...)
+
+		case mesos.Value_RANGES:
+			for _, r := range req.GetRanges().GetRange() {
+				for port := r.GetBegin(); port <= r.GetEnd(); port++ {
+					resources = append(resources, ow.allocateRange(req, port))
+				}
+			}
+
+		default:
+			resources = append(resources, req)
+		}
+	}
+
+	return resources
+}
+
+// allocate reserved or unreserved resources in the order asked
+func (ow *OfferWrapper) allocateScalar(name string, amount float64, reservedOrder ...bool) []*mesos.Resource {
+	resources := make([]*mesos.Resource, 0)
+	for _, reserved := range reservedOrder {
+		for index, res := range ow.Offer.GetResources() {
+			if !isScalarOf(res, name, reserved) {
+				continue
+			}
+
+			available := res.GetScalar().GetValue() - ow.allocated[index]
+			if amount <= 0 || available <= 0 {
+				continue
+			}
+
+			value := math.Min(amount, available)
+			ow.allocated[index] += value
+			amount -= value
+
+			piece := resourcePiece(res)
+			piece.Scalar = &mesos.Value_Scalar{Value: proto.Float64(value)}
+			resources = append(resources, piece)
+		}
+	}
+
+	return resources
+}
+
+// unreserved scalar resource not allocated yet
+func (ow *OfferWrapper) unreservedRemain(name string) float64 {
+	var remain float64
+	for index, res := range ow.Offer.GetResources() {
+		if isScalarOf(res, name, false) {
+			remain += res.GetScalar().GetValue() - ow.allocated[index]
+		}
+	}
+
+	return remain
+}
+
+// persistent volumes are allocated on their own, not as plain disk
+func isScalarOf(res *mesos.Resource, name string, reserved bool) bool {
+	return res.GetName() == name && res.GetType() == mesos.Value_SCALAR && res.Disk == nil && isReserved(res) == reserved
+}
+
+// the single value of the range, e.g. a port, out of the range offered it falls in
+func (ow *OfferWrapper) allocateRange(req *mesos.Resource, value uint64) *mesos.Resource {
+	piece := createRangeResource(req.GetName(), value, value)
+	for _, res := range ow.Offer.GetResources() {
+		if res.GetName() != req.GetName() || res.GetType() != mesos.Value_RANGES {
+			continue
+		}
+
+		for _, r := range res.GetRanges().GetRange() {
+			if value >= r.GetBegin() && value <= r.GetEnd() {
+				piece = resourcePiece(res)
+				piece.Ranges = &mesos.Value_Ranges{
+					Range: []*mesos.Value_Range{{Begin: proto.Uint64(value), End: proto.Uint64(value)}},
+				}
+				return piece
+			}
+		}
+	}
+
+	return piece
+}
+
+func isReserved(res *mesos.Resource) bool {
+	return res.GetRole() != mesos.Default_Resource_Role
+}
+
+// resource of the same name, type, role, reservation and allocation, value unset
+func resourcePiece(res *mesos.Resource) *mesos.Resource {
+	return &mesos.Resource{
+		Name:           res.Name,
+		Type:           res.Type,
+		Role:           res.Role,
+		Reservation:    res.Reservation,
+		AllocationInfo: res.AllocationInfo,
+	}
+}
@@ -0,0 +1,64 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferWrapperAllocate(t *testing.T) {
+	allocation := &mesos.Resource_AllocationInfo{Role: proto.String("web")}
+	reserved := createScalarResource("cpus", 1)
+	reserved.Role = proto.String("web")
+	reserved.Reservation = &mesos.Resource_ReservationInfo{}
+
+	offer := newTestOffer("o1", "host1")
+	offer.Resources = append(offer.Resources, reserved, createRangeResource("ports", 31000, 31009))
+	for _, res := range offer.Resources {
+		res.AllocationInfo = allocation
+	}
+
+	ow := NewOfferWrapper(offer)
+	assert.Equal(t, "web", ow.Role())
+
+	resources := ow.Allocate([]*mesos.Resource{createScalarResource("cpus", 1.5), createRangeResource("ports", 31005, 31005)})
+	assert.Len(t, resources, 3)
+	assert.Equal(t, "web", resources[0].GetRole())
+	assert.Equal(t, 1.0, resources[0].GetScalar().GetValue())
+	assert.Equal(t, "*", resources[1].GetRole())
+	assert.Equal(t, 0.5, resources[1].GetScalar().GetValue())
+	assert.Equal(t, uint64(31005), resources[2].GetRanges().GetRange()[0].GetBegin())
+	for _, res := range resources {
+		assert.Equal(t, "web", res.GetAllocationInfo().GetRole())
+	}
+
+	// reserved cpus used up by the previous allocation
+	resources = ow.Allocate([]*mesos.Resource{createScalarResource("cpus", 1)})
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "*", resources[0].GetRole())
+
+	// allocation info survives the wire
+	data, err := proto.Marshal(resources[0])
+	assert.Nil(t, err)
+	decoded := &mesos.Resource{}
+	assert.Nil(t, proto.Unmarshal(data, decoded))
+	assert.Equal(t, "web", decoded.GetAllocationInfo().GetRole())
+}
+
+func TestOfferMatchRole(t *testing.T) {
+	_, slot := newTestApp(nil)
+	slot.Version.RunAs = "web"
+
+	offer := newTestOffer("o1", "host1")
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(offer)))
+
+	for _, res := range offer.Resources {
+		res.AllocationInfo = &mesos.Resource_AllocationInfo{Role: proto.String("db")}
+	}
+	assert.False(t, slot.TestOfferMatch(NewOfferWrapper(offer)))
+
+	slot.Version.RunAs = "db"
+	assert.True(t, slot.TestOfferMatch(NewOfferWrapper(offer)))
+}
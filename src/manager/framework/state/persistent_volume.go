@@ -0,0 +1,307 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/src/manager/framework/mesos_connector"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+)
+
+// Persistent volumes of a version are created on disk reserved for the RunAs
+// role the first time the slot launches, along with the task, and reused by
+// the tasks relaunched after that, which are only placed on the agent holding
+// the volumes. volumes survive the slot, recorded by the app for the slot
+// index and reused by the slot of the index scaled up again, they are
+// destroyed only when the app is deleted asking for it. a volume is pending
+// till its task runs or an offer shows it, it is created anew if the launch
+// creating it failed.
+
+// SlotVolume is a persistent volume created for the slot
+type SlotVolume struct {
+	Id            string
+	ContainerPath string
+	Size          float64 // MB
+	AgentId       string
+	Pending       bool // not known to be created yet
+}
+
+func validatePersistentVolumes(version *types.Version) error {
+	for _, volume := range PersistentVolumes(version) {
+		if len(strings.TrimSpace(volume.ContainerPath)) == 0 {
+			return errors.New("container path of persistent volume required")
+		}
+
+		if len(volume.HostPath) > 0 {
+			return errors.New(fmt.Sprintf("persistent volume %s should not have host path", volume.ContainerPath))
+		}
+
+		if volume.Persistent.Size <= 0 {
+			return errors.New(fmt.Sprintf("size of persistent volume %s should be positive", volume.ContainerPath))
+		}
+	}
+
+	return nil
+}
+
+func PersistentVolumes(version *types.Version) []*types.Volume {
+	volumes := make([]*types.Volume, 0)
+	if version.Container == nil {
+		return volumes
+	}
+
+	for _, volume := range version.Container.Volumes {
+		if volume.Persistent != nil {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	return volumes
+}
+
+// volumes created for the app, including those of slots scaled down
+func (app *App) PersistentVolumes() []*SlotVolume {
+	volumes := make([]*SlotVolume, 0)
+	found := make(map[string]bool)
+	add := func(volume *SlotVolume) {
+		if !found[volume.Id] {
+			found[volume.Id] = true
+			volumes = append(volumes, volume)
+		}
+	}
+
+	app.volumesLock.Lock()
+	for _, indexVolumes := range app.volumes {
+		for _, volume := range indexVolumes {
+			add(volume)
+		}
+	}
+	app.volumesLock.Unlock()
+
+	for _, slot := range app.GetSlots() {
+		for _, volume := range slot.Volumes {
+			add(volume)
+		}
+	}
+
+	return volumes
+}
+
+// volumes recorded for the slot index
+func (app *App) indexVolumes(index int) []*SlotVolume {
+	app.volumesLock.Lock()
+	defer app.volumesLock.Unlock()
+
+	return append([]*SlotVolume(nil), app.volumes[index]...)
+}
+
+func (app *App) recordVolumes(index int, volumes []*SlotVolume) {
+	app.volumesLock.Lock()
+	defer app.volumesLock.Unlock()
+
+	if app.volumes == nil {
+		app.volumes = make(map[int][]*SlotVolume)
+	}
+	app.volumes[index] = append([]*SlotVolume(nil), volumes...)
+}
+
+// persist the volumes created since last update as the ones of the index
+func (slot *Slot) updateVolumes() {
+	if !slot.volumesTouched {
+		return
+	}
+
+	appVolumes := AppVolumesToRaft(slot.App.AppId, slot.Index, slot.Volumes)
+	if err := persistentStore.UpdateAppVolumes(context.TODO(), appVolumes, nil); err != nil {
+		logrus.Errorf("update volumes of app %s index %d got error: %s", slot.App.AppId, slot.Index, err)
+		return
+	}
+	slot.volumesTouched = false
+}
+
+// the volume created for the container path, nil if not created yet
+func (slot *Slot) volume(containerPath string) *SlotVolume {
+	for _, volume := range slot.Volumes {
+		if volume.ContainerPath == containerPath {
+			return volume
+		}
+	}
+
+	return nil
+}
+
+// agent holding the volumes created for the slot, empty if none
+func (slot *Slot) volumesAgent() string {
+	for _, volume := range slot.Volumes {
+		if !volume.Pending {
+			return volume.AgentId
+		}
+	}
+
+	return ""
+}
+
+// task of the slot runs with its volumes, those pending were created
+func (slot *Slot) confirmVolumes() {
+	for _, volume := range slot.Volumes {
+		slot.confirmVolume(volume)
+	}
+}
+
+func (slot *Slot) confirmVolume(volume *SlotVolume) {
+	if !volume.Pending {
+		return
+	}
+
+	volume.Pending = false
+	slot.App.recordVolumes(slot.Index, slot.Volumes)
+	slot.volumesTouched = true
+}
+
+func (slot *Slot) removeVolume(id string) {
+	for i, volume := range slot.Volumes {
+		if volume.Id == id {
+			slot.Volumes = append(slot.Volumes[:i], slot.Volumes[i+1:]...)
+			return
+		}
+	}
+}
+
+// offer matches if it is from the agent holding the volumes of the slot,
+// offers each of them, and has enough unreserved disk for those to create,
+// volumes pending and not offered are created anew
+func (slot *Slot) testVolumeMatch(ow *OfferWrapper) bool {
+	if agentId := slot.volumesAgent(); len(agentId) > 0 && agentId != ow.Offer.GetAgentId().GetValue() {
+		return false
+	}
+
+	var toCreate float64
+	for _, volume := range PersistentVolumes(slot.Version) {
+		created := slot.volume(volume.ContainerPath)
+		if created != nil && OfferedVolume(ow.Offer, created.Id) != nil {
+			continue
+		}
+
+		if created == nil || created.Pending {
+			toCreate += volume.Persistent.Size
+			continue
+		}
+
+		return false
+	}
+
+	return ow.DiskRemain() >= slot.Version.Disk+toCreate && ow.unreservedRemain("disk") >= toCreate
+}
+
+// resources of the persistent volumes for the task, volumes not created yet
+// are created on unreserved disk reserved for the role along the way
+func (slot *Slot) preparePersistentVolumes(ow *OfferWrapper) []*mesos.Resource {
+	resources := make([]*mesos.Resource, 0)
+	for _, volume := range PersistentVolumes(slot.Version) {
+		created := slot.volume(volume.ContainerPath)
+		if created != nil {
+			if res := OfferedVolume(ow.Offer, created.Id); res != nil {
+				resources = append(resources, res)
+				slot.confirmVolume(created)
+				continue
+			}
+
+			if !created.Pending {
+				continue
+			}
+
+			logrus.Warnf("volume %s of slot %s not created by the launch before, create a new one", created.Id, slot.Id)
+			slot.removeVolume(created.Id)
+		}
+
+		created = &SlotVolume{
+			Id:            fmt.Sprintf("%s-%s", slot.Id, strings.Replace(uuid.NewV4().String(), "-", "", -1)),
+			ContainerPath: volume.ContainerPath,
+			Size:          volume.Persistent.Size,
+			AgentId:       ow.Offer.GetAgentId().GetValue(),
+			Pending:       true,
+		}
+
+		resources = append(resources, slot.createVolume(ow, created))
+		slot.Volumes = append(slot.Volumes, created)
+		slot.App.recordVolumes(slot.Index, slot.Volumes)
+		slot.volumesTouched = true
+	}
+
+	return resources
+}
+
+// reserve unreserved disk of the offer and create the volume on it
+func (slot *Slot) createVolume(ow *OfferWrapper, volume *SlotVolume) *mesos.Resource {
+	reservation := &mesos.Resource_ReservationInfo{}
+	if connector := mesos_connector.Instance(); connector != nil && len(connector.Framework.GetPrincipal()) > 0 {
+		reservation.Principal = proto.String(connector.Framework.GetPrincipal())
+	}
+
+	reserved := ow.allocateScalar("disk", volume.Size, false)
+	for _, piece := range reserved {
+		piece.Role = proto.String(slot.Version.RunAs)
+		piece.Reservation = reservation
+	}
+	ow.DiskUsed += volume.Size
+
+	res := &mesos.Resource{
+		Name:        proto.String("disk"),
+		Type:        mesos.Value_SCALAR.Enum(),
+		Scalar:      &mesos.Value_Scalar{Value: proto.Float64(volume.Size)},
+		Role:        proto.String(slot.Version.RunAs),
+		Reservation: reservation,
+		Disk: &mesos.Resource_DiskInfo{
+			Persistence: &mesos.Resource_DiskInfo_Persistence{
+				Id:        proto.String(volume.Id),
+				Principal: reservation.Principal,
+			},
+			Volume: &mesos.Volume{
+				ContainerPath: proto.String(sandboxPath(volume.ContainerPath)),
+				Mode:          mesos.Volume_RW.Enum(),
+			},
+		},
+	}
+
+	if len(reserved) > 0 {
+		res.AllocationInfo = reserved[0].AllocationInfo
+	}
+
+	ow.Operations = append(ow.Operations,
+		&mesos.Offer_Operation{
+			Type:    mesos.Offer_Operation_RESERVE.Enum(),
+			Reserve: &mesos.Offer_Operation_Reserve{Resources: reserved},
+		},
+		&mesos.Offer_Operation{
+			Type:   mesos.Offer_Operation_CREATE.Enum(),
+			Create: &mesos.Offer_Operation_Create{Volumes: []*mesos.Resource{res}},
+		},
+	)
+
+	return res
+}
+
+// persistent volumes are mounted by mesos relative to the sandbox, then into
+// the container at the container path
+func sandboxPath(containerPath string) string {
+	return "volume" + strings.Replace(path.Clean("/"+containerPath), "/", "-", -1)
+}
+
+// the resource of the persistent volume offered, nil if not offered
+func OfferedVolume(offer *mesos.Offer, volumeId string) *mesos.Resource {
+	for _, res := range offer.GetResources() {
+		if res.GetDisk().GetPersistence().GetId() == volumeId {
+			return res
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentVolumes(t *testing.T) {
+	app, slot := newTestApp(nil)
+	slot.Version.RunAs = "db"
+	slot.Version.Container = &types.Container{
+		Docker: &types.Docker{Image: "mysql"},
+		Volumes: []*types.Volume{
+			{ContainerPath: "/var/lib/mysql", Persistent: &types.PersistentVolume{Size: 1024}},
+			{ContainerPath: "/etc/mysql", HostPath: "/etc/mysql", Mode: "RO"},
+		},
+	}
+	assert.Nil(t, validatePersistentVolumes(slot.Version))
+
+	offer := newTestOffer("o1", "host1")
+	ow := NewOfferWrapper(offer)
+	assert.True(t, slot.testVolumeMatch(ow))
+
+	resources := slot.preparePersistentVolumes(ow)
+	assert.Len(t, resources, 1)
+	assert.Len(t, slot.Volumes, 1)
+	assert.Equal(t, "agent-host1", slot.Volumes[0].AgentId)
+	assert.Equal(t, "volume-var-lib-mysql", resources[0].GetDisk().GetVolume().GetContainerPath())
+	assert.Equal(t, "db", resources[0].GetRole())
+
+	assert.Len(t, ow.Operations, 2)
+	assert.Equal(t, mesos.Offer_Operation_RESERVE, ow.Operations[0].GetType())
+	assert.Equal(t, 1024.0, ow.Operations[0].GetReserve().GetResources()[0].GetScalar().GetValue())
+	assert.Equal(t, mesos.Offer_Operation_CREATE, ow.Operations[1].GetType())
+
+	// recorded for the index, kept after the slot scaled down
+	assert.True(t, slot.volumesTouched)
+	assert.Equal(t, slot.Volumes, app.indexVolumes(slot.Index))
+	delete(app.slots, slot.Index)
+	assert.Equal(t, slot.Volumes, app.PersistentVolumes())
+	app.slots[slot.Index] = slot
+	assert.Len(t, app.PersistentVolumes(), 1)
+
+	// launch failed before the volume got created, created anew elsewhere
+	assert.True(t, slot.Volumes[0].Pending)
+	pending := slot.Volumes[0].Id
+	ow = NewOfferWrapper(newTestOffer("o2", "host2"))
+	assert.True(t, slot.testVolumeMatch(ow))
+	resources = slot.preparePersistentVolumes(ow)
+	assert.Len(t, slot.Volumes, 1)
+	assert.NotEqual(t, pending, slot.Volumes[0].Id)
+	assert.Equal(t, "agent-host2", slot.Volumes[0].AgentId)
+
+	// created once the task runs
+	slot.confirmVolumes()
+	assert.False(t, slot.Volumes[0].Pending)
+	assert.Equal(t, slot.Volumes, app.indexVolumes(slot.Index))
+
+	// relaunched only with offers of the agent holding the volume
+	assert.False(t, slot.testVolumeMatch(NewOfferWrapper(newTestOffer("o3", "host1"))))
+	assert.False(t, slot.testVolumeMatch(NewOfferWrapper(newTestOffer("o4", "host2"))))
+
+	offer = newTestOffer("o5", "host2")
+	offer.Resources = append(offer.Resources, resources[0])
+	ow = NewOfferWrapper(offer)
+	assert.True(t, slot.testVolumeMatch(ow))
+	assert.Equal(t, resources, slot.preparePersistentVolumes(ow))
+	assert.Len(t, ow.Operations, 0)
+
+	slot.Version.Container.Volumes[0].Persistent.Size = 0
+	assert.NotNil(t, validatePersistentVolumes(slot.Version))
+}
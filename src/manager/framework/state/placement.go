@@ -0,0 +1,120 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// PlacementDiagnosis is the result of testing a pending slot against the
+// offers of the last offer event it was considered for, reasons offers were
+// rejected are kept along with the agents offering them.
+type PlacementDiagnosis struct {
+	Tested     time.Time
+	Considered int
+	Matched    int
+	Rejections []*PlacementRejection
+}
+
+type PlacementRejection struct {
+	Reason string
+	Agents []string // hostnames
+}
+
+// offers matching the slot, the result recorded as its placement diagnosis
+func (slot *Slot) MatchOffers(offerWrappers []*OfferWrapper) []*OfferWrapper {
+	diagnosis := &PlacementDiagnosis{
+		Tested:     time.Now(),
+		Considered: len(offerWrappers),
+		Rejections: make([]*PlacementRejection, 0),
+	}
+
+	agents := make(map[string][]string) // by reason
+	matched := make([]*OfferWrapper, 0)
+	for _, ow := range offerWrappers {
+		reason := slot.offerMismatch(ow)
+		if len(reason) == 0 {
+			matched = append(matched, ow)
+			continue
+		}
+
+		agents[reason] = append(agents[reason], ow.Offer.GetHostname())
+	}
+
+	for reason, hostnames := range agents {
+		sort.Strings(hostnames)
+		diagnosis.Rejections = append(diagnosis.Rejections, &PlacementRejection{Reason: reason, Agents: hostnames})
+	}
+	sort.Sort(rejectionsByReason(diagnosis.Rejections))
+	diagnosis.Matched = len(matched)
+
+	slot.placementLock.Lock()
+	slot.placement = diagnosis
+	slot.placementLock.Unlock()
+
+	return matched
+}
+
+// slot of the same version not tested, as the other failed already
+func (slot *Slot) SharePlacement(other *Slot) {
+	diagnosis := other.Placement()
+
+	slot.placementLock.Lock()
+	slot.placement = diagnosis
+	slot.placementLock.Unlock()
+}
+
+// diagnosis of the last offers the slot was tested against, nil if none yet
+func (slot *Slot) Placement() *PlacementDiagnosis {
+	slot.placementLock.Lock()
+	defer slot.placementLock.Unlock()
+
+	return slot.placement
+}
+
+// why the offer doesn't match the slot, empty if it matches
+func (slot *Slot) offerMismatch(ow *OfferWrapper) string {
+	// offers are allocated to a single role if swan registered with roles
+	if role := ow.Role(); len(role) > 0 && role != slot.Version.RunAs {
+		return fmt.Sprintf("allocated to role %s", role)
+	}
+
+	if ow.CpuRemain() <= slot.Version.Cpus {
+		return "insufficient cpus"
+	}
+
+	if ow.MemRemain() <= slot.Version.Mem {
+		return "insufficient mem"
+	}
+
+	if ow.DiskRemain() <= slot.Version.Disk {
+		return "insufficient disk"
+	}
+
+	if !slot.testVolumeMatch(ow) {
+		return "persistent volumes unavailable"
+	}
+
+	constraints, err := ParseConstraints(slot.Version.Constraints)
+	if err != nil {
+		logrus.Errorf("parse constraints of slot %s failed, Error: %s", slot.Id, err.Error())
+		return "invalid constraints"
+	}
+
+	for _, constraint := range constraints {
+		if !constraint.Match(ow.Offer, slot.placedFieldValues(constraint.Field)) {
+			logrus.Debugf("offer %s doesn't match constraint %s of slot %s", ow.Offer.GetId().GetValue(), constraint, slot.Id)
+			return fmt.Sprintf("constraint %s", constraint)
+		}
+	}
+
+	return ""
+}
+
+type rejectionsByReason []*PlacementRejection
+
+func (r rejectionsByReason) Len() int           { return len(r) }
+func (r rejectionsByReason) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r rejectionsByReason) Less(i, j int) bool { return r[i].Reason < r[j].Reason }
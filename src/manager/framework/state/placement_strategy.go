@@ -0,0 +1,115 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	PLACEMENT_STRATEGY_BINPACK   = "binpack"
+	PLACEMENT_STRATEGY_SPREAD    = "spread"
+	PLACEMENT_STRATEGY_FIRST_FIT = "first-fit"
+
+	DEFAULT_PLACEMENT_STRATEGY = PLACEMENT_STRATEGY_FIRST_FIT
+)
+
+// PlacementStrategy decides which of the offers matching a slot the task
+// should be launched with, offers are only scored after TestOfferMatch passed.
+type PlacementStrategy interface {
+	Name() string
+	// Score rates the offer for the slot, the higher the better
+	Score(slot *Slot, ow *OfferWrapper) float64
+}
+
+var placementStrategies = map[string]PlacementStrategy{
+	PLACEMENT_STRATEGY_BINPACK:   &BinpackStrategy{},
+	PLACEMENT_STRATEGY_SPREAD:    &SpreadStrategy{},
+	PLACEMENT_STRATEGY_FIRST_FIT: &FirstFitStrategy{},
+}
+
+func NewPlacementStrategy(name string) (PlacementStrategy, error) {
+	if len(name) == 0 {
+		name = DEFAULT_PLACEMENT_STRATEGY
+	}
+
+	strategy, found := placementStrategies[name]
+	if !found {
+		return nil, errors.New(fmt.Sprintf("unrecognized placement strategy %s", name))
+	}
+
+	return strategy, nil
+}
+
+// BestOffer returns the candidate with the highest score, the earliest one wins a tie
+func BestOffer(strategy PlacementStrategy, slot *Slot, candidates []*OfferWrapper) *OfferWrapper {
+	var best *OfferWrapper
+	var bestScore float64
+
+	for _, ow := range candidates {
+		score := strategy.Score(slot, ow)
+		if best == nil || score > bestScore {
+			best = ow
+			bestScore = score
+		}
+	}
+
+	return best
+}
+
+// BinpackStrategy fills up agents before moving to the next one, it prefers
+// the offer which would be the most utilized after the task launched.
+type BinpackStrategy struct{}
+
+func (s *BinpackStrategy) Name() string {
+	return PLACEMENT_STRATEGY_BINPACK
+}
+
+func (s *BinpackStrategy) Score(slot *Slot, ow *OfferWrapper) float64 {
+	return usageAfterReservation(slot, ow)
+}
+
+// SpreadStrategy prefers agents running the fewest instances of the app,
+// offers with more free resources win between agents with the same count.
+type SpreadStrategy struct{}
+
+func (s *SpreadStrategy) Name() string {
+	return PLACEMENT_STRATEGY_SPREAD
+}
+
+func (s *SpreadStrategy) Score(slot *Slot, ow *OfferWrapper) float64 {
+	instances := 0
+	for _, other := range slot.App.GetSlots() {
+		if other != slot && other.Placed() && other.AgentId == ow.Offer.GetAgentId().GetValue() {
+			instances += 1
+		}
+	}
+
+	// usage is within [0, 1], instance count always outweighs it
+	return -float64(instances) + (1-usageAfterReservation(slot, ow))/2
+}
+
+// FirstFitStrategy takes the first matching offer, as offers come from mesos.
+type FirstFitStrategy struct{}
+
+func (s *FirstFitStrategy) Name() string {
+	return PLACEMENT_STRATEGY_FIRST_FIT
+}
+
+func (s *FirstFitStrategy) Score(slot *Slot, ow *OfferWrapper) float64 {
+	return 0
+}
+
+// average of cpu and mem usage ratio of the offer if slot would be launched with
+func usageAfterReservation(slot *Slot, ow *OfferWrapper) float64 {
+	ratio := func(remain, used, required float64) float64 {
+		total := remain + used
+		if total <= 0 {
+			return 1
+		}
+
+		return (used + required) / total
+	}
+
+	return (ratio(ow.CpuRemain(), ow.CpusUsed, slot.Version.Cpus) +
+		ratio(ow.MemRemain(), ow.MemUsed, slot.Version.Mem)) / 2
+}
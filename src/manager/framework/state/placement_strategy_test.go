@@ -0,0 +1,64 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPlacementStrategy(t *testing.T) {
+	strategy, err := NewPlacementStrategy("")
+	assert.Nil(t, err)
+	assert.Equal(t, DEFAULT_PLACEMENT_STRATEGY, strategy.Name())
+
+	for _, name := range []string{PLACEMENT_STRATEGY_BINPACK, PLACEMENT_STRATEGY_SPREAD, PLACEMENT_STRATEGY_FIRST_FIT} {
+		strategy, err := NewPlacementStrategy(name)
+		assert.Nil(t, err)
+		assert.Equal(t, name, strategy.Name())
+	}
+
+	_, err = NewPlacementStrategy("random")
+	assert.NotNil(t, err)
+}
+
+func TestBinpackStrategy(t *testing.T) {
+	host1 := NewOfferWrapper(newTestOffer("o1", "host1"))
+	host2 := NewOfferWrapper(newTestOffer("o2", "host2"))
+	host2.CpusUsed = 2
+	host2.MemUsed = 2048
+
+	_, slot := newTestApp([]string{})
+	strategy, _ := NewPlacementStrategy(PLACEMENT_STRATEGY_BINPACK)
+	assert.Equal(t, host2, BestOffer(strategy, slot, []*OfferWrapper{host1, host2}))
+}
+
+func TestSpreadStrategy(t *testing.T) {
+	host1 := newTestOffer("o1", "host1")
+	host2 := newTestOffer("o2", "host2")
+
+	_, slot := newTestApp([]string{}, host1)
+	strategy, _ := NewPlacementStrategy(PLACEMENT_STRATEGY_SPREAD)
+
+	ow1, ow2 := NewOfferWrapper(host1), NewOfferWrapper(host2)
+	// host2 runs no instance of the app, even though host1 has more free resources
+	ow2.CpusUsed = 3
+	ow2.MemUsed = 3072
+	assert.Equal(t, ow2, BestOffer(strategy, slot, []*OfferWrapper{ow1, ow2}))
+
+	// same instance count, prefer the one with more free resources
+	_, slot = newTestApp([]string{})
+	assert.Equal(t, ow1, BestOffer(strategy, slot, []*OfferWrapper{ow2, ow1}))
+}
+
+func TestFirstFitStrategy(t *testing.T) {
+	host1 := NewOfferWrapper(newTestOffer("o1", "host1"))
+	host2 := NewOfferWrapper(newTestOffer("o2", "host2"))
+	host2.CpusUsed = 2
+
+	_, slot := newTestApp([]string{})
+	strategy, _ := NewPlacementStrategy(PLACEMENT_STRATEGY_FIRST_FIT)
+	assert.Equal(t, host1, BestOffer(strategy, slot, []*OfferWrapper{host1, host2}))
+	assert.Equal(t, host2, BestOffer(strategy, slot, []*OfferWrapper{host2, host1}))
+
+	assert.Nil(t, BestOffer(strategy, slot, []*OfferWrapper{}))
+}
@@ -0,0 +1,30 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOffers(t *testing.T) {
+	o1 := newTestOffer("o1", "host1")
+	_, slot := newTestApp([]string{"hostname:UNIQUE"}, o1)
+	assert.Nil(t, slot.Placement())
+
+	small := newTestOffer("o3", "host3")
+	small.Resources[1] = createScalarResource("mem", 8)
+
+	offers := []*OfferWrapper{NewOfferWrapper(newTestOffer("o1", "host1")), NewOfferWrapper(newTestOffer("o2", "host2")), NewOfferWrapper(small)}
+	matched := slot.MatchOffers(offers)
+	assert.Len(t, matched, 1)
+	assert.Equal(t, "host2", matched[0].Offer.GetHostname())
+
+	placement := slot.Placement()
+	assert.Equal(t, 3, placement.Considered)
+	assert.Equal(t, 1, placement.Matched)
+	assert.Len(t, placement.Rejections, 2)
+	assert.Equal(t, "constraint hostname:UNIQUE", placement.Rejections[0].Reason)
+	assert.Equal(t, []string{"host1"}, placement.Rejections[0].Agents)
+	assert.Equal(t, "insufficient mem", placement.Rejections[1].Reason)
+	assert.Equal(t, []string{"host3"}, placement.Rejections[1].Agents)
+}
@@ -0,0 +1,106 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+// ValidateQuota checks the limits of the quota before it is stored
+func ValidateQuota(quota *types.Quota) error {
+	if len(quota.RunAs) == 0 {
+		return errors.New("runAs of quota required")
+	}
+
+	if quota.Cpus < 0 || quota.Mem < 0 || quota.Disk < 0 || quota.Instances < 0 || quota.Ips < 0 {
+		return errors.New("limits of quota should not be negative")
+	}
+
+	return nil
+}
+
+// resources all instances of the version take
+func VersionUsage(version *types.Version) *types.Quota {
+	disk := version.Disk
+	for _, volume := range PersistentVolumes(version) {
+		disk += volume.Persistent.Size
+	}
+
+	instances := float64(version.Instances)
+	usage := &types.Quota{
+		RunAs:     version.RunAs,
+		Cpus:      version.Cpus * instances,
+		Mem:       version.Mem * instances,
+		Disk:      disk * instances,
+		Instances: version.Instances,
+	}
+
+	if version.Mode == string(APP_MODE_FIXED) {
+		usage.Ips = int32(len(version.Ip))
+	}
+
+	return usage
+}
+
+// resources the job takes running its tasks in parallel, the spec is
+// formatted the way the job is created
+func JobUsage(spec *types.Job) (*types.Quota, error) {
+	if err := validateAndFormatJob(spec); err != nil {
+		return nil, err
+	}
+
+	return VersionUsage(spec.Template), nil
+}
+
+// resources the app takes, the larger of both versions while updating
+func (app *App) Usage() *types.Quota {
+	usage := VersionUsage(app.CurrentVersion)
+	if app.ProposedVersion != nil {
+		MaxUsage(usage, VersionUsage(app.ProposedVersion))
+	}
+
+	return usage
+}
+
+func AddUsage(usage, other *types.Quota) {
+	usage.Cpus += other.Cpus
+	usage.Mem += other.Mem
+	usage.Disk += other.Disk
+	usage.Instances += other.Instances
+	usage.Ips += other.Ips
+}
+
+func MaxUsage(usage, other *types.Quota) {
+	usage.Cpus = math.Max(usage.Cpus, other.Cpus)
+	usage.Mem = math.Max(usage.Mem, other.Mem)
+	usage.Disk = math.Max(usage.Disk, other.Disk)
+	if other.Instances > usage.Instances {
+		usage.Instances = other.Instances
+	}
+	if other.Ips > usage.Ips {
+		usage.Ips = other.Ips
+	}
+}
+
+// error naming the first limit of the quota the usage exceeds, nil if none
+func CheckQuota(quota, usage *types.Quota) error {
+	for _, limit := range []struct {
+		name         string
+		limit, usage float64
+	}{
+		{"cpus", quota.Cpus, usage.Cpus},
+		{"mem", quota.Mem, usage.Mem},
+		{"disk", quota.Disk, usage.Disk},
+		{"instances", float64(quota.Instances), float64(usage.Instances)},
+		{"ips", float64(quota.Ips), float64(usage.Ips)},
+	} {
+		if limit.limit > 0 && limit.usage > limit.limit {
+			return errors.New(fmt.Sprintf("quota of runAs %s exceeded: %s %g requested, %g allowed",
+				quota.RunAs, limit.name, limit.usage, limit.limit))
+		}
+	}
+
+	return nil
+}
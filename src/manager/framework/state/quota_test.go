@@ -0,0 +1,31 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckQuota(t *testing.T) {
+	quota := &types.Quota{RunAs: "web", Cpus: 2, Instances: 4}
+	assert.Nil(t, ValidateQuota(quota))
+	assert.NotNil(t, ValidateQuota(&types.Quota{RunAs: "web", Mem: -1}))
+
+	version := &types.Version{RunAs: "web", Cpus: 0.5, Mem: 64, Instances: 3}
+	usage := VersionUsage(version)
+	assert.Equal(t, 1.5, usage.Cpus)
+	assert.Equal(t, 192.0, usage.Mem)
+	assert.Nil(t, CheckQuota(quota, usage))
+
+	// mem not limited, cpus exceeded
+	AddUsage(usage, VersionUsage(&types.Version{RunAs: "web", Cpus: 1, Mem: 1024, Instances: 1}))
+	err := CheckQuota(quota, usage)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cpus 2.5 requested, 2 allowed")
+
+	app := &App{CurrentVersion: version, ProposedVersion: &types.Version{RunAs: "web", Cpus: 1, Mem: 32, Instances: 3}}
+	usage = app.Usage()
+	assert.Equal(t, 3.0, usage.Cpus)
+	assert.Equal(t, 192.0, usage.Mem)
+}
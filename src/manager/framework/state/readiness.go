@@ -0,0 +1,196 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	swanevent "github.com/Dataman-Cloud/swan/src/manager/event"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	DEFAULT_READINESS_INTERVAL_SECONDS = 5
+	DEFAULT_READINESS_TIMEOUT_SECONDS  = 2
+)
+
+// ReadinessChecker probes the task of a slot from swan until it is ready,
+// mesos of the vendored protos doesn't run checks other than health checks.
+type ReadinessChecker struct {
+	slot  *Slot
+	check *types.ReadinessCheck
+	stopC chan struct{}
+}
+
+// ReadinessReport is the readiness check of a slot passed, applied by the
+// scheduler loop as it changes the app and slot as well
+type ReadinessReport struct {
+	slot    *Slot
+	checker *ReadinessChecker
+}
+
+var readinessReports = make(chan *ReadinessReport, 1024)
+
+// ReadinessReports returns the reports the scheduler loop should apply
+func ReadinessReports() <-chan *ReadinessReport {
+	return readinessReports
+}
+
+// Apply marks the slot ready, called by the scheduler loop
+func (report *ReadinessReport) Apply() {
+	report.slot.setReady(report.checker)
+}
+
+func NewReadinessChecker(slot *Slot) *ReadinessChecker {
+	return &ReadinessChecker{
+		slot:  slot,
+		check: slot.Version.ReadinessCheck,
+		stopC: make(chan struct{}),
+	}
+}
+
+func (rc *ReadinessChecker) Start() {
+	interval := floatSeconds(rc.check.IntervalSeconds)
+	if interval <= 0 {
+		interval = DEFAULT_READINESS_INTERVAL_SECONDS * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-rc.stopC:
+				return
+			case <-ticker.C:
+				if err := rc.probe(); err != nil {
+					logrus.Debugf("slot %s not ready: %s", rc.slot.Id, err)
+					continue
+				}
+
+				select {
+				case readinessReports <- &ReadinessReport{slot: rc.slot, checker: rc}:
+				case <-rc.stopC:
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (rc *ReadinessChecker) Stop() {
+	close(rc.stopC)
+}
+
+func (rc *ReadinessChecker) probe() error {
+	address, err := rc.slot.checkAddress(rc.check.PortName, rc.check.Port)
+	if err != nil {
+		return err
+	}
+
+	timeout := floatSeconds(rc.check.TimeoutSeconds)
+	if timeout <= 0 {
+		timeout = DEFAULT_READINESS_TIMEOUT_SECONDS * time.Second
+	}
+
+	return probe(rc.check.Protocol, address, rc.check.Path, timeout)
+}
+
+// probe tcp or http address, http probe passes with 2xx or 3xx responded
+func probe(protocol, address, path string, timeout time.Duration) error {
+	switch strings.ToLower(protocol) {
+	case "tcp":
+		conn, err := net.DialTimeout("tcp", address, timeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+
+	case "http":
+		client := &http.Client{Timeout: timeout}
+		resp, err := client.Get(fmt.Sprintf("http://%s%s", address, path))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return errors.New(fmt.Sprintf("responded with status %d", resp.StatusCode))
+		}
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("unsupported check protocol %s", protocol))
+}
+
+// address to reach the checked port from swan, port on the container ip for
+// fixed mode app, or host port mapped for the port named otherwise
+func (slot *Slot) checkAddress(portName string, port int32) (string, error) {
+	if slot.App.IsFixed() {
+		return fmt.Sprintf("%s:%d", slot.Ip, port), nil
+	}
+
+	if !IsDockerContainer(slot.Version) {
+		return "", errors.New("check requires a docker container")
+	}
+
+	for index, portMapping := range slot.Version.Container.Docker.PortMappings {
+		if portMapping.Name != portName {
+			continue
+		}
+
+		if index < len(slot.CurrentTask.HostPorts) {
+			return fmt.Sprintf("%s:%d", slot.AgentHostName, slot.CurrentTask.HostPorts[index]), nil
+		}
+
+		return fmt.Sprintf("%s:%d", slot.AgentHostName, portMapping.ContainerPort), nil // HOST network
+	}
+
+	return "", errors.New(fmt.Sprintf("port %s not found in port mappings", portName))
+}
+
+func (slot *Slot) Ready() bool {
+	return slot.ready && slot.StateIs(SLOT_STATE_TASK_RUNNING)
+}
+
+// task running, wait for it ready before adding it to service discovery
+func (slot *Slot) startReadinessCheck() {
+	slot.stopReadinessCheck()
+
+	if slot.Version.ReadinessCheck == nil {
+		slot.ready = true
+		slot.EmitTaskEvent(swanevent.EventTypeTaskAdd)
+		return
+	}
+
+	logrus.Infof("slot %s running, waiting for it ready", slot.Id)
+	slot.readinessChecker = NewReadinessChecker(slot)
+	slot.readinessChecker.Start()
+}
+
+func (slot *Slot) stopReadinessCheck() {
+	if slot.readinessChecker != nil {
+		slot.readinessChecker.Stop()
+		slot.readinessChecker = nil
+	}
+}
+
+func (slot *Slot) setReady(rc *ReadinessChecker) {
+	if slot.readinessChecker != rc || !slot.StateIs(SLOT_STATE_TASK_RUNNING) {
+		return
+	}
+
+	logrus.Infof("slot %s ready", slot.Id)
+	slot.readinessChecker = nil
+	slot.ready = true
+	slot.EmitTaskEvent(swanevent.EventTypeTaskAdd)
+
+	slot.App.Reevaluate()
+	slot.Touch(false)
+}
@@ -0,0 +1,46 @@
+package state
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadinessCheckerReportsToLoop(t *testing.T) {
+	_, tearDown := setUpTestStore()
+	defer tearDown()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	host, portStr, _ := net.SplitHostPort(server.Listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	app, _ := newTestApp([]string{}, newTestOffer("offer-1", "host-1"))
+	app.Mode = APP_MODE_FIXED
+	app.CurrentVersion.Mode = string(APP_MODE_FIXED)
+	slot := app.slots[0]
+	slot.Ip = host
+	slot.Version.ReadinessCheck = &types.ReadinessCheck{Protocol: "http", Port: int32(port), Path: "/ready", IntervalSeconds: 0.01}
+
+	slot.startReadinessCheck()
+	assert.False(t, slot.Ready())
+
+	// the scheduler loop
+	select {
+	case report := <-ReadinessReports():
+		assert.False(t, slot.Ready())
+		report.Apply()
+	case <-time.After(5 * time.Second):
+		t.Fatal("no readiness report from checker")
+	}
+
+	assert.True(t, slot.Ready())
+	assert.Nil(t, slot.readinessChecker)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/Dataman-Cloud/swan/src/manager/framework/mesos_connector"
 	"github.com/Dataman-Cloud/swan/src/manager/swancontext"
 	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
 )
 
 // load app data frm persistent data
@@ -23,6 +25,9 @@ func LoadAppData(allocator *OfferAllocator, mesosConnector *mesos_connector.Meso
 			AppId:             raftApp.ID,
 			CurrentVersion:    VersionFromRaft(raftApp.Version),
 			State:             raftApp.State,
+			StateMessage:      raftApp.StateMessage,
+			UpdatePaused:      raftApp.UpdatePaused,
+			CanaryWeight:      raftApp.CanaryWeight,
 			Mode:              AppMode(raftApp.Version.Mode),
 			Created:           time.Unix(0, raftApp.CreatedAt),
 			Updated:           time.Unix(0, raftApp.UpdatedAt),
@@ -31,6 +36,10 @@ func LoadAppData(allocator *OfferAllocator, mesosConnector *mesos_connector.Meso
 			OfferAllocatorRef: allocator,
 		}
 
+		if raftApp.ProposedVersion != nil {
+			app.ProposedVersion = VersionFromRaft(raftApp.ProposedVersion)
+		}
+
 		raftVersions, err := persistentStore.ListVersions(raftApp.ID)
 		if err != nil {
 			return nil, err
@@ -48,8 +57,28 @@ func LoadAppData(allocator *OfferAllocator, mesosConnector *mesos_connector.Meso
 			return nil, err
 		}
 
+		raftVolumes, err := persistentStore.ListAppVolumes(raftApp.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, raftAppVolumes := range raftVolumes {
+			app.recordVolumes(int(raftAppVolumes.Index), AppVolumesFromRaft(raftAppVolumes))
+		}
+
 		for _, slot := range slots {
 			app.SetSlot(int(slot.Index), slot)
+
+			// volumes of slots persisted before they were recorded by index
+			if len(slot.Volumes) > 0 && len(app.indexVolumes(slot.Index)) == 0 {
+				app.recordVolumes(slot.Index, slot.Volumes)
+				slot.volumesTouched = true
+				slot.updateVolumes()
+			}
+		}
+
+		if app.StateIs(APP_STATE_CANARY) {
+			app.emitCanaryEvent()
 		}
 
 		apps[app.AppId] = app
@@ -86,10 +115,149 @@ func LoadAppSlots(app *App) ([]*Slot, error) {
 		}
 		slot.App = app
 		// TODO yaoyun
-		slot.Version = app.CurrentVersion
+		if app.ProposedVersion != nil && slot.Version != nil && slot.Version.ID == app.ProposedVersion.ID {
+			slot.Version = app.ProposedVersion
+		} else {
+			slot.Version = app.CurrentVersion
+		}
+
+		// restart timer doesn't survive failover
+		if slot.Abnormal() && !slot.StateIs(SLOT_STATE_TASK_FINISHED) &&
+			!slot.MarkForDeletion() && !slot.MarkForRollingUpdate() && !app.IsJob() {
+			slot.scheduleRestart()
+		}
+
+		// nor the drain timer, drain over again in case kill not sent yet
+		if slot.StateIs(SLOT_STATE_PENDING_KILL) && slot.drainDuration() > 0 {
+			slot.killCurrentTask(true)
+		}
+
+		// neither do readiness and health checkers
+		if slot.StateIs(SLOT_STATE_TASK_RUNNING) {
+			slot.startHealthCheckers()
+			if !slot.ready {
+				slot.startReadinessCheck()
+			}
+		}
 
 		slots = append(slots, slot)
 	}
 
 	return slots, nil
 }
+
+// load jobs from persistent data and attach them to their apps
+func LoadJobData(apps map[string]*App) (map[string]*Job, error) {
+	raftJobs, err := persistentStore.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make(map[string]*Job)
+	for _, raftJob := range raftJobs {
+		app, found := apps[raftJob.ID]
+		if !found {
+			logrus.Warnf("app of job %s not found, skip it", raftJob.ID)
+			continue
+		}
+
+		job := JobFromRaft(raftJob)
+		job.App = app
+		app.job = job
+		job.startDeadlineTimer()
+
+		// failed tasks of running job wait for restart, which doesn't survive failover
+		for _, slot := range app.GetSlots() {
+			if job.StateIs(JOB_STATE_RUNNING) && slot.Abnormal() && !slot.StateIs(SLOT_STATE_TASK_FINISHED) &&
+				!slot.MarkForDeletion() {
+				slot.scheduleRestart()
+			}
+		}
+
+		jobs[job.JobId] = job
+	}
+
+	return jobs, nil
+}
+
+// load cron jobs from persistent data and start them, which catches up the
+// runs missed during failover
+func LoadCronJobData(runner JobRunner) (map[string]*CronJob, error) {
+	raftCronJobs, err := persistentStore.ListCronJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	cronJobs := make(map[string]*CronJob)
+	for _, raftCronJob := range raftCronJobs {
+		cronJob := CronJobFromRaft(raftCronJob)
+		cronJob.runner = runner
+		if err := cronJob.init(); err != nil {
+			logrus.Errorf("load cron job %s got error: %s", cronJob.CronJobId, err)
+			continue
+		}
+
+		cronJob.Start()
+		cronJobs[cronJob.CronJobId] = cronJob
+	}
+
+	return cronJobs, nil
+}
+
+func LoadAutoscalerData(scaler AppScaler, metrics MetricSource) (map[string]*Autoscaler, error) {
+	raftAutoscalers, err := persistentStore.ListAutoscalers()
+	if err != nil {
+		return nil, err
+	}
+
+	autoscalers := make(map[string]*Autoscaler)
+	for _, raftAutoscaler := range raftAutoscalers {
+		autoscaler := AutoscalerFromRaft(raftAutoscaler)
+		autoscaler.scaler, autoscaler.metrics = scaler, metrics
+		if err := ValidateAutoscalePolicy(autoscaler.Spec); err != nil {
+			logrus.Errorf("load autoscaler of app %s got error: %s", autoscaler.AppId, err)
+			continue
+		}
+
+		autoscaler.Start()
+		autoscalers[autoscaler.AppId] = autoscaler
+	}
+
+	return autoscalers, nil
+}
+
+// schedules are started in the order of the runs they have pending, so the
+// latest run of the schedules of an app is the one the app ends up with
+func LoadScalingScheduleData(scaler ScheduleScaler) (map[string]*ScalingSchedule, error) {
+	raftScalingSchedules, err := persistentStore.ListScalingSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	loaded := make([]*ScalingSchedule, 0)
+	pending := make([]*PlannedScaling, 0)
+	for _, raftScalingSchedule := range raftScalingSchedules {
+		scalingSchedule := ScalingScheduleFromRaft(raftScalingSchedule)
+		scalingSchedule.scaler = scaler
+		if err := scalingSchedule.init(); err != nil {
+			logrus.Errorf("load scaling schedule %s got error: %s", scalingSchedule.ScheduleId, err)
+			continue
+		}
+
+		loaded = append(loaded, scalingSchedule)
+		pending = append(pending, &PlannedScaling{Time: scalingSchedule.pendingRun(now), ScheduleId: scalingSchedule.ScheduleId})
+	}
+
+	scalingSchedules := make(map[string]*ScalingSchedule)
+	for _, scalingSchedule := range loaded {
+		scalingSchedules[scalingSchedule.ScheduleId] = scalingSchedule
+	}
+
+	SortPlannedScalings(pending)
+	for _, run := range pending {
+		scalingSchedules[run.ScheduleId].Start()
+	}
+
+	return scalingSchedules, nil
+}
@@ -0,0 +1,63 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+)
+
+const ROLLBACK_TO_PREVIOUS_VERSION = "previous"
+
+// RollbackVersion returns the version rolling back to the version given
+// deploys through the rolling update, a new version so the PerviousVersionID
+// lineage is kept, with the instances and ips of the current version.
+func (app *App) RollbackVersion(versionId string) (*types.Version, error) {
+	if app.CurrentVersion == nil {
+		return nil, errors.New("rollback failed: current version was losted")
+	}
+
+	target, err := app.FindVersion(versionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.ID == app.CurrentVersion.ID {
+		return nil, errors.New(fmt.Sprintf("app already running version %s", target.ID))
+	}
+
+	// the target is kept in the versions of the app as it was
+	version := VersionFromRaft(VersionToRaft(target))
+	version.Instances = app.CurrentVersion.Instances
+	version.Ip = append([]string{}, app.CurrentVersion.Ip...)
+
+	return version, nil
+}
+
+// FindVersion looks up a version of the app by id, previous stands for the
+// version the current one was updated from.
+func (app *App) FindVersion(versionId string) (*types.Version, error) {
+	if versionId == ROLLBACK_TO_PREVIOUS_VERSION {
+		versionId = app.CurrentVersion.PerviousVersionID
+		if len(versionId) == 0 {
+			return nil, errors.New("app has no previous version")
+		}
+	}
+
+	if app.CurrentVersion.ID == versionId {
+		return app.CurrentVersion, nil
+	}
+
+	for _, version := range app.Versions {
+		if version.ID == versionId {
+			return version, nil
+		}
+	}
+
+	raftVersion, err := persistentStore.GetVersion(app.AppId, versionId)
+	if err != nil || raftVersion == nil {
+		return nil, errors.New(fmt.Sprintf("version %s not found", versionId))
+	}
+
+	return VersionFromRaft(raftVersion), nil
+}
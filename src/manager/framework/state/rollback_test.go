@@ -0,0 +1,52 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindVersion(t *testing.T) {
+	v1 := &types.Version{ID: "1"}
+	v2 := &types.Version{ID: "2", PerviousVersionID: "1"}
+	app := &App{AppId: "test", CurrentVersion: v2, Versions: []*types.Version{v1, v2}}
+
+	version, err := app.FindVersion(ROLLBACK_TO_PREVIOUS_VERSION)
+	assert.Nil(t, err)
+	assert.Equal(t, v1, version)
+
+	version, err = app.FindVersion("2")
+	assert.Nil(t, err)
+	assert.Equal(t, v2, version)
+
+	app.CurrentVersion = v1
+	_, err = app.FindVersion(ROLLBACK_TO_PREVIOUS_VERSION)
+	assert.NotNil(t, err)
+}
+
+func TestRollbackToCurrentVersion(t *testing.T) {
+	v1 := &types.Version{ID: "1"}
+	app := &App{AppId: "test", CurrentVersion: v1, Versions: []*types.Version{v1}}
+
+	_, err := app.RollbackVersion("1")
+	assert.NotNil(t, err)
+}
+
+func TestRollbackVersionCopiesTarget(t *testing.T) {
+	v1 := &types.Version{ID: "1", Instances: 2, Ip: []string{"192.168.1.10", "192.168.1.11"},
+		KillPolicy: &types.KillPolicy{Duration: 5000}}
+	v2 := &types.Version{ID: "2", PerviousVersionID: "1", Instances: 3, Ip: []string{"192.168.1.10", "192.168.1.11", "192.168.1.12"}}
+	app := &App{AppId: "test", CurrentVersion: v2, Versions: []*types.Version{v1, v2}}
+
+	version, err := app.RollbackVersion(ROLLBACK_TO_PREVIOUS_VERSION)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), version.Instances)
+	assert.Equal(t, v2.Ip, version.Ip)
+
+	version.KillPolicy.Duration = 0
+	assert.Equal(t, int32(2), v1.Instances)
+	assert.Equal(t, 2, len(v1.Ip))
+	assert.Equal(t, int64(5000), v1.KillPolicy.Duration)
+}
@@ -0,0 +1,155 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	UPDATE_POLICY_MODE_MANUAL = "manual" // each batch proceeded by proceed-update
+	UPDATE_POLICY_MODE_AUTO   = "auto"   // batches proceeded by swan once previous one is ready
+)
+
+// instances updated per batch, batch size is either a count or a percentage
+// of instances e.g. 25%, at least one instance per batch.
+func UpdateBatchSize(policy *types.UpdatePolicy, instances int) (int, error) {
+	if policy == nil || len(policy.BatchSize) == 0 {
+		return 1, nil
+	}
+
+	batchSize := strings.TrimSpace(policy.BatchSize)
+	if strings.HasSuffix(batchSize, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(batchSize, "%"), 64)
+		if err != nil || percent <= 0 || percent > 100 {
+			return 0, errors.New(fmt.Sprintf("invalid batch size %s, percentage should be within (0%%, 100%%]", policy.BatchSize))
+		}
+
+		return int(math.Max(1, math.Ceil(float64(instances)*percent/100))), nil
+	}
+
+	count, err := strconv.Atoi(batchSize)
+	if err != nil || count < 1 {
+		return 0, errors.New(fmt.Sprintf("invalid batch size %s, should be a positive number or percentage", policy.BatchSize))
+	}
+
+	return count, nil
+}
+
+func IsAutoUpdate(version *types.Version) bool {
+	return version.UpdatePolicy != nil && version.UpdatePolicy.Mode == UPDATE_POLICY_MODE_AUTO
+}
+
+// instances of the first batch when update started
+func (app *App) firstUpdateBatch() int {
+	if !IsAutoUpdate(app.ProposedVersion) {
+		return 1
+	}
+
+	batchSize, err := UpdateBatchSize(app.ProposedVersion.UpdatePolicy, int(app.CurrentVersion.Instances))
+	if err != nil {
+		return 1
+	}
+
+	if batchSize > int(app.CurrentVersion.Instances) {
+		return int(app.CurrentVersion.Instances)
+	}
+
+	return batchSize
+}
+
+// AdvanceUpdate proceeds an unattended rolling update to next batch, once
+// all the updated slots are ready, healthy if version has health checks,
+// and UpdateDelay passed since then.
+func (app *App) AdvanceUpdate() {
+	if !app.StateIs(APP_STATE_MARK_FOR_UPDATING) || app.ProposedVersion == nil ||
+		!IsAutoUpdate(app.ProposedVersion) || app.UpdatePaused {
+		return
+	}
+
+	updated := app.RollingUpdateInstances()
+	if updated >= int(app.CurrentVersion.Instances) {
+		return
+	}
+
+	for _, slot := range app.slots {
+		if slot.MarkForRollingUpdate() && !app.updatedSlotReady(slot) {
+			app.batchReadyAt = time.Time{}
+			return
+		}
+	}
+
+	if app.batchReadyAt.IsZero() {
+		app.batchReadyAt = time.Now()
+	}
+
+	delay := time.Duration(app.ProposedVersion.UpdatePolicy.UpdateDelay) * time.Second
+	if time.Since(app.batchReadyAt) < delay {
+		return
+	}
+
+	batchSize, err := UpdateBatchSize(app.ProposedVersion.UpdatePolicy, int(app.CurrentVersion.Instances))
+	if err != nil {
+		logrus.Errorf("app %s: %s", app.AppId, err)
+		return
+	}
+
+	if batchSize > int(app.CurrentVersion.Instances)-updated {
+		batchSize = int(app.CurrentVersion.Instances) - updated
+	}
+
+	logrus.Infof("app %s proceed update to version %s with %d instances", app.AppId, app.ProposedVersion.ID, batchSize)
+
+	app.batchReadyAt = time.Time{}
+	if err := app.ProceedingRollingUpdate(batchSize); err != nil {
+		logrus.Errorf("app %s proceed update failed: %s", app.AppId, err)
+	}
+}
+
+func (app *App) updatedSlotReady(slot *Slot) bool {
+	if slot.Version.ID != app.ProposedVersion.ID || !slot.Ready() {
+		return false
+	}
+
+	return len(slot.Version.HealthChecks) == 0 || slot.Healthy()
+}
+
+func (app *App) PauseUpdate() error {
+	if !app.StateIs(APP_STATE_MARK_FOR_UPDATING) || app.ProposedVersion == nil {
+		return errors.New("app not in updating state")
+	}
+
+	if app.UpdatePaused {
+		return errors.New("app update already paused")
+	}
+
+	app.UpdatePaused = true
+	app.batchReadyAt = time.Time{}
+	app.Touch(false)
+
+	return nil
+}
+
+func (app *App) ResumeUpdate() error {
+	if !app.StateIs(APP_STATE_MARK_FOR_UPDATING) || app.ProposedVersion == nil {
+		return errors.New("app not in updating state")
+	}
+
+	if !app.UpdatePaused {
+		return errors.New("app update not paused")
+	}
+
+	app.UpdatePaused = false
+	app.Touch(false)
+
+	app.AdvanceUpdate()
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateBatchSize(t *testing.T) {
+	size, err := UpdateBatchSize(nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, size)
+
+	for batchSize, expected := range map[string]int{"3": 3, "25%": 3, "1%": 1, "100%": 10, " 50% ": 5} {
+		size, err := UpdateBatchSize(&types.UpdatePolicy{BatchSize: batchSize}, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, size, batchSize)
+	}
+
+	for _, batchSize := range []string{"0", "-1", "abc", "0%", "101%", "%"} {
+		_, err := UpdateBatchSize(&types.UpdatePolicy{BatchSize: batchSize}, 10)
+		assert.NotNil(t, err, batchSize)
+	}
+}
+
+func TestUpdatedSlotReady(t *testing.T) {
+	app, slot := newTestApp([]string{})
+	app.ProposedVersion = &types.Version{ID: "2"}
+	slot.State = SLOT_STATE_TASK_RUNNING
+	assert.False(t, app.updatedSlotReady(slot))
+
+	slot.Version = app.ProposedVersion
+	assert.False(t, app.updatedSlotReady(slot)) // waiting for readiness check
+
+	slot.ready = true
+	assert.True(t, app.updatedSlotReady(slot))
+
+	app.ProposedVersion.HealthChecks = []*types.HealthCheck{{Protocol: "http"}}
+	assert.False(t, app.updatedSlotReady(slot))
+
+	slot.healthy = true
+	assert.True(t, app.updatedSlotReady(slot))
+}
@@ -0,0 +1,279 @@
+package state
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/Dataman-Cloud/swan/src/utils/cron"
+
+	"github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+const SCALING_RETRY_INTERVAL = 10 * time.Second
+
+// ScheduleScaler scales the apps of scaling schedules
+type ScheduleScaler interface {
+	AppScaler
+	BoundAutoscaler(appId string, minInstances, maxInstances int32) error
+}
+
+// ScalingSchedule scales the app each time the schedule fires, scaling
+// failed, e.g. while the app is updating, is retried till the next run.
+type ScalingSchedule struct {
+	ScheduleId    string
+	Spec          *types.ScalingSchedule
+	Created       time.Time
+	LastScheduled time.Time
+	LastError     string // of the last run, empty if applied
+
+	timer  *cron.Timer
+	scaler ScheduleScaler
+
+	lock       sync.Mutex
+	retryTimer *time.Timer
+	stopped    bool
+}
+
+// PlannedScaling is a run of a scaling schedule to come
+type PlannedScaling struct {
+	Time         time.Time
+	ScheduleId   string
+	AppId        string
+	Instances    int32
+	MinInstances int32
+	MaxInstances int32
+}
+
+func NewScalingSchedule(spec *types.ScalingSchedule, scaler ScheduleScaler) (*ScalingSchedule, error) {
+	scalingSchedule := &ScalingSchedule{
+		ScheduleId: spec.ID,
+		Spec:       spec,
+		Created:    time.Now(),
+		scaler:     scaler,
+	}
+
+	if err := scalingSchedule.init(); err != nil {
+		return nil, err
+	}
+
+	if err := persistentStore.CreateScalingSchedule(context.TODO(), ScalingScheduleToRaft(scalingSchedule), nil); err != nil {
+		return nil, err
+	}
+
+	scalingSchedule.Start()
+
+	return scalingSchedule, nil
+}
+
+// validate the spec and parse the schedule
+func (scalingSchedule *ScalingSchedule) init() error {
+	spec := scalingSchedule.Spec
+	if len(spec.ID) == 0 || len(spec.AppId) == 0 {
+		return errors.New("id and app id of scaling schedule required")
+	}
+
+	if spec.Instances < 1 {
+		return errors.New("instances of scaling schedule should be positive")
+	}
+
+	if spec.MinInstances < 0 || spec.MaxInstances < 0 {
+		return errors.New("min and max instances of scaling schedule should not be negative")
+	}
+
+	if (spec.MinInstances > 0 && spec.Instances < spec.MinInstances) || (spec.MaxInstances > 0 && spec.Instances > spec.MaxInstances) {
+		return errors.New("instances of scaling schedule should be within its min and max instances")
+	}
+
+	timer, err := cron.NewTimer(spec.Schedule, spec.TimeZone, scalingSchedule.fire)
+	if err != nil {
+		return err
+	}
+
+	scalingSchedule.timer = timer
+
+	return nil
+}
+
+// Start applies the run missed while no leader was around, or the last one
+// if not applied yet, then waits for the next one.
+func (scalingSchedule *ScalingSchedule) Start() {
+	scalingSchedule.lock.Lock()
+
+	scalingSchedule.stopped = false
+
+	now := time.Now()
+	if pending := scalingSchedule.pendingRun(now); !pending.IsZero() {
+		logrus.Infof("scaling schedule %s: applying run of %s", scalingSchedule.ScheduleId, pending)
+		scalingSchedule.run(pending)
+	}
+
+	scalingSchedule.lock.Unlock()
+
+	if scalingSchedule.Next().IsZero() {
+		logrus.Warnf("scaling schedule %s: no more runs scheduled", scalingSchedule.ScheduleId)
+	}
+	scalingSchedule.timer.Start(now)
+}
+
+// Stop stops scheduling, instances are left as they are
+func (scalingSchedule *ScalingSchedule) Stop() {
+	scalingSchedule.timer.Stop()
+
+	scalingSchedule.lock.Lock()
+	defer scalingSchedule.lock.Unlock()
+
+	scalingSchedule.stopped = true
+
+	if scalingSchedule.retryTimer != nil {
+		scalingSchedule.retryTimer.Stop()
+	}
+}
+
+// Delete stops scheduling and removes the scaling schedule
+func (scalingSchedule *ScalingSchedule) Delete() error {
+	scalingSchedule.Stop()
+
+	return persistentStore.DeleteScalingSchedule(context.TODO(), scalingSchedule.ScheduleId, nil)
+}
+
+// Next returns the time of the next run, zero time if none
+func (scalingSchedule *ScalingSchedule) Next() time.Time {
+	return scalingSchedule.timer.Next(time.Now())
+}
+
+// Plan returns the runs to come before the time given, no more than limit
+func (scalingSchedule *ScalingSchedule) Plan(until time.Time, limit int) []*PlannedScaling {
+	spec := scalingSchedule.Spec
+
+	planned := make([]*PlannedScaling, 0)
+	for t := scalingSchedule.Next(); !t.IsZero() && t.Before(until) && len(planned) < limit; t = scalingSchedule.timer.Next(t) {
+		planned = append(planned, &PlannedScaling{
+			Time:         t,
+			ScheduleId:   scalingSchedule.ScheduleId,
+			AppId:        spec.AppId,
+			Instances:    spec.Instances,
+			MinInstances: spec.MinInstances,
+			MaxInstances: spec.MaxInstances,
+		})
+	}
+
+	return planned
+}
+
+// the most recent scheduled time passed since last run
+func (scalingSchedule *ScalingSchedule) lastMissed(now time.Time) time.Time {
+	since := scalingSchedule.LastScheduled
+	if since.IsZero() {
+		since = scalingSchedule.Created
+	}
+
+	return scalingSchedule.timer.LastMissed(since, now)
+}
+
+// the run missed, or the last one failed, zero time if none
+func (scalingSchedule *ScalingSchedule) pendingRun(now time.Time) time.Time {
+	if missed := scalingSchedule.lastMissed(now); !missed.IsZero() {
+		return missed
+	}
+
+	if len(scalingSchedule.LastError) > 0 {
+		return scalingSchedule.LastScheduled
+	}
+
+	return time.Time{}
+}
+
+func (scalingSchedule *ScalingSchedule) fire(scheduled time.Time) {
+	scalingSchedule.lock.Lock()
+	defer scalingSchedule.lock.Unlock()
+
+	scalingSchedule.run(scheduled)
+}
+
+// caller should hold the lock
+func (scalingSchedule *ScalingSchedule) run(scheduled time.Time) {
+	defer scalingSchedule.update()
+
+	if scalingSchedule.retryTimer != nil {
+		scalingSchedule.retryTimer.Stop()
+	}
+
+	scalingSchedule.LastScheduled = scheduled
+	scalingSchedule.LastError = ""
+
+	if err := scalingSchedule.apply(); err != nil {
+		logrus.Warnf("scaling schedule %s: scale app %s to %d instances got error: %s, retry in %s",
+			scalingSchedule.ScheduleId, scalingSchedule.Spec.AppId, scalingSchedule.Spec.Instances, err, SCALING_RETRY_INTERVAL)
+		scalingSchedule.LastError = err.Error()
+		scalingSchedule.retry(scheduled)
+	}
+}
+
+// retry the run till applied or the next run, caller should hold the lock
+func (scalingSchedule *ScalingSchedule) retry(scheduled time.Time) {
+	scalingSchedule.retryTimer = time.AfterFunc(SCALING_RETRY_INTERVAL, func() {
+		scalingSchedule.lock.Lock()
+		defer scalingSchedule.lock.Unlock()
+
+		if scalingSchedule.stopped || !scalingSchedule.LastScheduled.Equal(scheduled) {
+			return
+		}
+
+		if err := scalingSchedule.apply(); err != nil {
+			logrus.Debugf("scaling schedule %s: retry got error: %s", scalingSchedule.ScheduleId, err)
+			scalingSchedule.retry(scheduled)
+			return
+		}
+
+		logrus.Infof("scaling schedule %s: run of %s applied on retry", scalingSchedule.ScheduleId, scheduled)
+		scalingSchedule.LastError = ""
+		scalingSchedule.update()
+	})
+}
+
+// bound the autoscaler of the app first, so it doesn't scale the app back
+func (scalingSchedule *ScalingSchedule) apply() error {
+	spec := scalingSchedule.Spec
+	if spec.MinInstances > 0 || spec.MaxInstances > 0 {
+		if err := scalingSchedule.scaler.BoundAutoscaler(spec.AppId, spec.MinInstances, spec.MaxInstances); err != nil {
+			return err
+		}
+	}
+
+	app, err := scalingSchedule.scaler.InspectApp(spec.AppId)
+	if err != nil {
+		return err
+	}
+
+	current := app.CurrentVersion.Instances
+	if spec.Instances > current {
+		return scalingSchedule.scaler.ScaleUp(spec.AppId, int(spec.Instances-current), nil)
+	}
+
+	if spec.Instances < current {
+		return scalingSchedule.scaler.ScaleDown(spec.AppId, int(current-spec.Instances))
+	}
+
+	return nil
+}
+
+func (scalingSchedule *ScalingSchedule) update() {
+	if err := persistentStore.UpdateScalingSchedule(context.TODO(), ScalingScheduleToRaft(scalingSchedule), nil); err != nil {
+		logrus.Errorf("update scaling schedule %s got error: %s", scalingSchedule.ScheduleId, err)
+	}
+}
+
+type plannedByTime []*PlannedScaling
+
+func (p plannedByTime) Len() int           { return len(p) }
+func (p plannedByTime) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p plannedByTime) Less(i, j int) bool { return p[i].Time.Before(p[j].Time) }
+
+// SortPlannedScalings sorts runs of scaling schedules by time
+func SortPlannedScalings(planned []*PlannedScaling) {
+	sort.Sort(plannedByTime(planned))
+}
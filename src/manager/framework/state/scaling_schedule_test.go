@@ -0,0 +1,59 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testScheduleScaler struct {
+	testScaler
+	min, max int32
+}
+
+func (s *testScheduleScaler) BoundAutoscaler(appId string, minInstances, maxInstances int32) error {
+	s.min, s.max = minInstances, maxInstances
+	return nil
+}
+
+func TestScalingSchedule(t *testing.T) {
+	app, _ := newTestApp(nil)
+	app.CurrentVersion.Instances = 4
+	scaler := &testScheduleScaler{testScaler: testScaler{app: app}}
+
+	spec := &types.ScalingSchedule{ID: "morning", AppId: "test", Schedule: "0 8 * * *", TimeZone: "UTC", Instances: 10, MinInstances: 20}
+	scalingSchedule := &ScalingSchedule{ScheduleId: spec.ID, Spec: spec, scaler: scaler}
+	assert.NotNil(t, scalingSchedule.init())
+
+	spec.MinInstances, spec.MaxInstances = 6, 12
+	assert.Nil(t, scalingSchedule.init())
+
+	assert.Nil(t, scalingSchedule.apply())
+	assert.Equal(t, 6, scaler.scaled)
+	assert.Equal(t, int32(6), scaler.min)
+	assert.Equal(t, int32(12), scaler.max)
+
+	spec.Instances = 2
+	spec.MinInstances, spec.MaxInstances = 0, 0
+	scaler.scaled = 0
+	assert.Nil(t, scalingSchedule.apply())
+	assert.Equal(t, -2, scaler.scaled)
+
+	planned := scalingSchedule.Plan(time.Now().Add(72*time.Hour), 2)
+	assert.Len(t, planned, 2)
+	assert.Equal(t, 8, planned[0].Time.UTC().Hour())
+	assert.Equal(t, 24*time.Hour, planned[1].Time.Sub(planned[0].Time))
+
+	// the run missed during failover is the one pending, or the last failed
+	now := time.Date(2017, 6, 2, 9, 0, 0, 0, time.UTC)
+	scalingSchedule.Created = now.Add(-72 * time.Hour)
+	scalingSchedule.LastScheduled = time.Date(2017, 6, 1, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2017, 6, 2, 8, 0, 0, 0, time.UTC), scalingSchedule.pendingRun(now).UTC())
+
+	scalingSchedule.LastScheduled = time.Date(2017, 6, 2, 8, 0, 0, 0, time.UTC)
+	assert.True(t, scalingSchedule.pendingRun(now).IsZero())
+	scalingSchedule.LastError = "app not in normal state"
+	assert.Equal(t, scalingSchedule.LastScheduled, scalingSchedule.pendingRun(now))
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/Dataman-Cloud/swan/src/mesosproto/mesos"
 	"github.com/Dataman-Cloud/swan/src/types"
 
+	"github.com/Dataman-Cloud/swan-janitor/src/upstream"
 	"github.com/Sirupsen/logrus"
 	"golang.org/x/net/context"
 )
@@ -48,8 +50,19 @@ const (
 	SLOT_STATE_TASK_GONE             = "slot_task_gone"
 	SLOT_STATE_TASK_GONE_BY_OPERATOR = "slot_task_gone_by_operator"
 	SLOT_STATE_TASK_UNKNOWN          = "slot_task_unknown"
+
+	// task failed more than MaxRestarts of the version, swan stops relaunching it
+	SLOT_STATE_CRASH_LOOPING = "slot_crash_looping"
 )
 
+const DEFAULT_UNREACHABLE_GRACE_PERIOD = 5 * time.Minute
+
+// interval polling the connections to a task draining
+var drainPollInterval = 100 * time.Millisecond
+
+// requests in flight the janitor proxies to the target address
+var targetConnections = upstream.Connections
+
 type Slot struct {
 	Index   int
 	Id      string
@@ -64,27 +77,58 @@ type Slot struct {
 	AgentId       string
 	Ip            string
 	AgentHostName string
+	// attributes of the agent, used for constraint evaluation
+	AgentAttributes map[string]string
 
 	resourceReservationLock sync.Mutex
 
+	// why offers didn't match the slot pending for offers
+	placement     *PlacementDiagnosis
+	placementLock sync.Mutex
+
 	markForDeletion      bool
 	markForRollingUpdate bool
 
 	restartPolicy *RestartPolicy
+	runningSince  time.Time
+
+	// failures of tasks of proposed version during rolling update
+	UpdateFailures int
+	// replace task if it stays unreachable for too long
+	unreachableSince time.Time
+	unreachableTimer *time.Timer
+	// stops draining the task before killed
+	drainStopC chan struct{}
 
 	healthy bool
+	// results of each health check, and checkers of those probed by swan
+	checkResults   map[int]bool
+	healthCheckers []*HealthChecker
+	// unhealthy reports in a row of current task, and since when it is unhealthy
+	unhealthyReports int
+	unhealthySince   time.Time
+	unhealthyTimer   *time.Timer
+
+	// task running and passed its readiness check if any
+	ready            bool
+	readinessChecker *ReadinessChecker
+
+	// persistent volumes created for the slot, on the agent it is bound to
+	Volumes []*SlotVolume
+	// volumes created since last update, to record for the app index
+	volumesTouched bool
 
 	inTransaction bool
 	touched       bool
 }
 
 type SlotResource struct {
-	CPUOffered float64
-	MemOffered float64
+	CPUOffered  float64
+	MemOffered  float64
 	DiskOffered float64
 
-	CPUUsed float64
-	MemUsed float64
+	CPUUsed  float64
+	MemUsed  float64
 	DiskUsed float64
 }
 
@@ -110,19 +154,10 @@ func NewSlot(app *App, version *types.Version, index int) *Slot {
 		slot.Ip = app.CurrentVersion.Ip[index]
 	}
 
-	// initialize restart policy
-	testAndRestartFunc := func(s *Slot) bool {
-		if slot.Abnormal() {
-			s.Archive()
-			s.DispatchNewTask(slot.Version)
-		}
-
-		return false
-	}
+	slot.restartPolicy = NewRestartPolicy(slot, 0, testAndRestart)
 
-	//slot.restartPolicy = NewRestartPolicy(slot, slot.Version.BackoffSeconds,
-	//slot.Version.BackoffFactor, slot.Version.MaxLaunchDelaySeconds, testAndRestartFunc)
-	slot.restartPolicy = NewRestartPolicy(slot, time.Second*10, 1, time.Second*300, testAndRestartFunc)
+	// volumes created for the index before, e.g. by a slot scaled down
+	slot.Volumes = app.indexVolumes(index)
 
 	slot.create()
 
@@ -136,8 +171,9 @@ func (slot *Slot) KillTask() {
 
 	slot.StopRestartPolicy()
 
+	draining := slot.StateIs(SLOT_STATE_TASK_RUNNING)
 	slot.SetState(SLOT_STATE_PENDING_KILL)
-	slot.CurrentTask.Kill()
+	slot.killCurrentTask(draining)
 }
 
 // kill task and make slot sweeped after successfully kill task
@@ -148,8 +184,93 @@ func (slot *Slot) Kill() {
 	slot.StopRestartPolicy()
 
 	slot.SetMarkForDeletion(true)
+	draining := slot.StateIs(SLOT_STATE_TASK_RUNNING)
 	slot.SetState(SLOT_STATE_PENDING_KILL)
-	slot.CurrentTask.Kill()
+	slot.killCurrentTask(draining)
+}
+
+// task pending kill is out of service discovery already, a running one is
+// killed once the requests in flight to it complete, or after the drain
+// duration at most
+func (slot *Slot) killCurrentTask(draining bool) {
+	slot.stopDraining()
+
+	drain := slot.drainDuration()
+	if !draining || drain <= 0 {
+		slot.CurrentTask.Kill()
+		return
+	}
+
+	logrus.Infof("draining slot %s for %s at most before killing its task", slot.Id, drain)
+	slot.drainStopC = make(chan struct{})
+	go drainAndKill(slot.CurrentTask, slot.connectionsCounter(), drain, drainPollInterval, slot.drainStopC)
+}
+
+// poll the connections the janitor proxies to the task till none left
+func drainAndKill(task *Task, connections func() int, drain, interval time.Duration, stopC chan struct{}) {
+	deadline := time.NewTimer(drain)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-deadline.C:
+			task.Kill()
+			return
+		case <-ticker.C:
+			if connections() == 0 {
+				task.Kill()
+				return
+			}
+		}
+	}
+}
+
+// counts the connections in flight to the task
+func (slot *Slot) connectionsCounter() func() int {
+	addresses, connections := slot.targetAddresses(), targetConnections
+	return func() int {
+		active := 0
+		for _, address := range addresses {
+			active += connections(address)
+		}
+
+		return active
+	}
+}
+
+// addresses the janitor proxies requests of the task to, as the task events
+// sent to it
+func (slot *Slot) targetAddresses() []string {
+	if slot.App.IsFixed() {
+		return []string{net.JoinHostPort(slot.Ip, "")}
+	}
+
+	addresses := make([]string, 0)
+	for _, port := range slot.CurrentTask.HostPorts {
+		addresses = append(addresses, net.JoinHostPort(slot.AgentHostName, fmt.Sprintf("%d", port)))
+	}
+
+	return addresses
+}
+
+func (slot *Slot) drainDuration() time.Duration {
+	if slot.Version.KillPolicy == nil {
+		return 0
+	}
+
+	return time.Duration(slot.Version.KillPolicy.DrainDuration) * time.Millisecond
+}
+
+func (slot *Slot) stopDraining() {
+	if slot.drainStopC != nil {
+		close(slot.drainStopC)
+		slot.drainStopC = nil
+	}
 }
 
 func (slot *Slot) Archive() {
@@ -166,6 +287,8 @@ func (slot *Slot) DispatchNewTask(version *types.Version) {
 
 	slot.Version = version
 	slot.CurrentTask = NewTask(slot.Version, slot)
+	slot.runningSince = time.Time{}
+	slot.resetHealth()
 	slot.SetState(SLOT_STATE_PENDING_OFFER)
 
 	slot.App.OfferAllocatorRef.PutSlotBackToPendingQueue(slot)
@@ -178,75 +301,42 @@ func (slot *Slot) UpdateTask(version *types.Version, isRollingUpdate bool) {
 	defer slot.Commit()
 
 	slot.Version = version
+	slot.restartPolicy.Reset()
 	slot.SetMarkForRollingUpdate(isRollingUpdate)
 
 	slot.KillTask() // kill task but doesn't clean slot
 }
 
 func (slot *Slot) TestOfferMatch(ow *OfferWrapper) bool {
-	if slot.Version.Constraints != nil && len(slot.Version.Constraints) > 0 {
-		constraints := slot.filterConstraints(slot.Version.Constraints)
-		for _, constraint := range constraints {
-			cons := strings.Split(constraint, ":")
-			if cons[1] == "LIKE" {
-				for _, attr := range ow.Offer.Attributes {
-					var value string
-					name := attr.GetName()
-					switch attr.GetType() {
-					case mesos.Value_SCALAR:
-						value = fmt.Sprintf("%d", *attr.GetScalar().Value)
-					case mesos.Value_TEXT:
-						value = fmt.Sprintf("%s", *attr.GetText().Value)
-					default:
-						logrus.Errorf("Unsupported attribute value: %s", attr.GetType())
-					}
-
-					if name == cons[0] &&
-						strings.Contains(value, cons[2]) &&
-						ow.CpuRemain() > slot.Version.Cpus &&
-						ow.MemRemain() > slot.Version.Mem &&
-						ow.DiskRemain() > slot.Version.Disk {
-						return true
-					}
-				}
-				return false
-			}
-		}
-	}
-
-	return ow.CpuRemain() > slot.Version.Cpus &&
-		ow.MemRemain() > slot.Version.Mem &&
-		ow.DiskRemain() > slot.Version.Disk
+	return len(slot.offerMismatch(ow)) == 0
 }
 
-func (slot *Slot) filterConstraints(constraints []string) []string {
-	filteredConstraints := make([]string, 0)
-	for _, constraint := range constraints {
-		cons := strings.Split(constraint, ":")
-		if len(cons) > 3 || len(cons) < 2 {
-			logrus.Errorf("Malformed Constraints")
-			continue
-		}
-
-		if cons[1] != "UNIQUE" && cons[1] != "LIKE" {
-			logrus.Errorf("Constraints operator %s not supported", cons[1])
+// collect field values of agents where the other slots of the app were placed
+func (slot *Slot) placedFieldValues(field string) []string {
+	values := make([]string, 0)
+	for _, other := range slot.App.GetSlots() {
+		if other == slot || !other.Placed() {
 			continue
 		}
 
-		if cons[1] == "UNIQUE" && strings.ToLower(cons[0]) != "hostname" {
-			logrus.Errorf("Constraints operator UNIQUE only support 'hostname': %s", cons[0])
-			continue
+		if field == CONSTRAINT_FIELD_HOSTNAME {
+			values = append(values, other.AgentHostName)
+		} else if value, found := other.AgentAttributes[field]; found {
+			values = append(values, value)
 		}
+	}
 
-		if cons[1] == "LIKE" && len(cons) < 3 {
-			logrus.Errorf("Constraints operator LIKE required two operands")
-			continue
-		}
+	return values
+}
 
-		filteredConstraints = append(filteredConstraints, constraint)
+// slot got an agent for its current task, which might be still in
+// launching, and the task is not terminated yet
+func (slot *Slot) Placed() bool {
+	if slot.CurrentTask == nil || len(slot.CurrentTask.AgentId) == 0 {
+		return false
 	}
 
-	return filteredConstraints
+	return !slot.Terminated()
 }
 
 func (slot *Slot) ReserveOfferAndPrepareTaskInfo(ow *OfferWrapper) (*OfferWrapper, *mesos.TaskInfo) {
@@ -263,7 +353,7 @@ func (slot *Slot) ReserveOfferAndPrepareTaskInfo(ow *OfferWrapper) (*OfferWrappe
 		logrus.Errorf("update offer info of slot: %d failed, Error: %s", slot.Index, err.Error())
 	}
 
-	if slot.App.IsReplicates() { // reserve port only for replicates application
+	if slot.App.IsReplicates() && IsDockerContainer(slot.Version) { // reserve port only for replicates application
 		ow.PortUsedSize += len(slot.Version.Container.Docker.PortMappings)
 	}
 
@@ -280,6 +370,9 @@ func (slot *Slot) UpdateOfferInfo(offer *mesos.Offer) error {
 	slot.AgentHostName = offer.GetHostname()
 	slot.CurrentTask.AgentHostName = offer.GetHostname()
 
+	slot.AgentAttributes = OfferAttributes(offer)
+	slot.CurrentTask.AgentAttributes = slot.AgentAttributes
+
 	return WithConvertSlot(context.TODO(), slot, nil, persistentStore.UpdateSlot)
 }
 
@@ -291,7 +384,7 @@ func (slot *Slot) PrepareResources() []*mesos.Resource {
 	}
 
 	if slot.Version.Mem > 0 {
-		resources = append(resources, createScalarResource("mem", slot.Version.Cpus))
+		resources = append(resources, createScalarResource("mem", slot.Version.Mem))
 	}
 
 	if slot.Version.Disk > 0 {
@@ -320,6 +413,22 @@ func (slot *Slot) StateIs(state string) bool {
 func (slot *Slot) SetState(state string) error {
 	logrus.Infof("setting state for slot %s to %s", slot.Id, slot.State)
 
+	if state != SLOT_STATE_TASK_UNREACHABLE {
+		slot.stopUnreachableTimer()
+	}
+
+	if state != SLOT_STATE_PENDING_KILL {
+		slot.stopDraining()
+	}
+
+	if state != SLOT_STATE_TASK_RUNNING {
+		slot.stopUnhealthyTimer()
+		slot.stopHealthCheckers()
+		slot.stopReadinessCheck()
+		slot.ready = false
+	}
+
+	previousState := slot.State
 	slot.State = state
 	switch slot.State {
 	case SLOT_STATE_PENDING_KILL:
@@ -329,23 +438,56 @@ func (slot *Slot) SetState(state string) error {
 		slot.StopRestartPolicy()
 	case SLOT_STATE_TASK_FINISHED:
 		slot.StopRestartPolicy()
+		if slot.App.job != nil && !slot.markForDeletion {
+			slot.App.job.onTaskFinished(slot)
+		}
 	case SLOT_STATE_TASK_RUNNING:
-		slot.EmitTaskEvent(swanevent.EventTypeTaskAdd)
-	case SLOT_STATE_TASK_LOST:
+		slot.confirmVolumes()
+		slot.startHealthCheckers()
+		slot.startReadinessCheck()
+		if slot.runningSince.IsZero() {
+			slot.runningSince = time.Now()
+		}
+		if slot.App.OfferAllocatorRef != nil {
+			slot.App.OfferAllocatorRef.ResetLaunchDelay(slot.App.AppId)
+		}
+
+	case SLOT_STATE_TASK_FAILED, SLOT_STATE_TASK_LOST, SLOT_STATE_TASK_DROPPED,
+		SLOT_STATE_TASK_GONE, SLOT_STATE_TASK_GONE_BY_OPERATOR, SLOT_STATE_TASK_UNKNOWN:
+		slot.EmitTaskEvent(swanevent.EventTypeTaskRm)
+		if slot.App.job != nil && !slot.markForDeletion {
+			slot.App.job.onTaskFailed(slot) // bounded retries
+		} else if !slot.markForDeletion && !slot.markForRollingUpdate {
+			slot.scheduleRestart()
+		}
+
+	case SLOT_STATE_TASK_ERROR:
+		// task description is invalid, relaunch won't help
+		slot.EmitTaskEvent(swanevent.EventTypeTaskRm)
+		slot.StopRestartPolicy()
+		if slot.App.job != nil && !slot.markForDeletion {
+			slot.App.job.onTaskFailed(slot)
+		}
 
-	case SLOT_STATE_TASK_FAILED:
+	case SLOT_STATE_TASK_UNREACHABLE:
+		// task might be still running, wait for the agent to come back before replacing it
 		slot.EmitTaskEvent(swanevent.EventTypeTaskRm)
-		// restart if needed
+		slot.startUnreachableTimer()
 	default:
 	}
 
-	if slot.markForDeletion && (slot.StateIs(SLOT_STATE_TASK_KILLED) || slot.StateIs(SLOT_STATE_TASK_FINISHED) || slot.StateIs(SLOT_STATE_TASK_FAILED) || slot.StateIs(SLOT_STATE_TASK_LOST)) {
+	if slot.markForDeletion && slot.Terminated() {
 		// TODO remove slot from OfferAllocator
 		logrus.Infof("removeSlot func")
 		slot.App.RemoveSlot(slot.Index)
 	}
 
-	if slot.markForRollingUpdate && (slot.StateIs(SLOT_STATE_TASK_KILLED) || slot.StateIs(SLOT_STATE_TASK_FINISHED) || slot.StateIs(SLOT_STATE_TASK_FAILED) || slot.StateIs(SLOT_STATE_TASK_LOST)) {
+	if slot.markForRollingUpdate && slot.Terminated() {
+		if slot.failedInUpdate(previousState) {
+			slot.UpdateFailures += 1
+			slot.App.onUpdateFailure(slot, fmt.Sprintf("%s %s %s", slot.State, slot.CurrentTask.Reason, slot.CurrentTask.Message))
+		}
+
 		// TODO remove slot from OfferAllocator
 		logrus.Infof("archive current task")
 		slot.Archive()
@@ -353,7 +495,7 @@ func (slot *Slot) SetState(state string) error {
 	}
 
 	// skip app invalidation if slot state is not mesos driven
-	if (slot.State != SLOT_STATE_PENDING_OFFER) ||
+	if (slot.State != SLOT_STATE_PENDING_OFFER) &&
 		(slot.State != SLOT_STATE_PENDING_KILL) {
 		slot.App.Reevaluate()
 	}
@@ -362,15 +504,136 @@ func (slot *Slot) SetState(state string) error {
 	return nil
 }
 
+// task of proposed version terminated during rolling update without being killed by swan
+func (slot *Slot) failedInUpdate(previousState string) bool {
+	if !slot.runningProposedVersion() {
+		return false
+	}
+
+	if slot.StateIs(SLOT_STATE_TASK_KILLED) {
+		return previousState != SLOT_STATE_PENDING_KILL && previousState != SLOT_STATE_TASK_KILLING
+	}
+
+	return true
+}
+
+// task is of the proposed version of the app updating
+func (slot *Slot) runningProposedVersion() bool {
+	app := slot.App
+	return (app.StateIs(APP_STATE_MARK_FOR_UPDATING) || app.StateIs(APP_STATE_CANARY)) && app.ProposedVersion != nil &&
+		slot.CurrentTask.Version != nil && slot.CurrentTask.Version.ID == app.ProposedVersion.ID
+}
+
+// restart the failed task with backoff, or give up if restarted too many times
+func (slot *Slot) scheduleRestart() {
+	// task ran stable for a while before failing, it's not crash looping
+	if !slot.runningSince.IsZero() && time.Since(slot.runningSince) >= slot.restartPolicy.MaxLaunchDelaySeconds() {
+		slot.restartPolicy.Reset()
+	}
+	slot.runningSince = time.Time{}
+
+	// other pending slots of the app likely fail alike, hold them back as well
+	if slot.App.OfferAllocatorRef != nil && !slot.restartPolicy.Exhausted() {
+		slot.App.OfferAllocatorRef.DelayLaunch(slot.App.AppId, slot.restartPolicy.Delay())
+	}
+
+	if !slot.restartPolicy.ScheduleRestart() {
+		logrus.Warnf("slot %s restarted %d times, give up as crash looping", slot.Id, slot.restartPolicy.Restarts)
+		slot.State = SLOT_STATE_CRASH_LOOPING
+	}
+}
+
+func (slot *Slot) Restarts() int {
+	return slot.restartPolicy.Restarts
+}
+
+func testAndRestart(slot *Slot) bool {
+	if slot.Abnormal() {
+		slot.Archive()
+		slot.DispatchNewTask(slot.Version)
+	}
+
+	return false
+}
+
+// task of the slot won't run any more
+func (slot *Slot) Terminated() bool {
+	switch slot.State {
+	case SLOT_STATE_CRASH_LOOPING, SLOT_STATE_TASK_KILLED, SLOT_STATE_TASK_FINISHED, SLOT_STATE_TASK_FAILED,
+		SLOT_STATE_TASK_ERROR, SLOT_STATE_TASK_LOST, SLOT_STATE_TASK_DROPPED,
+		SLOT_STATE_TASK_GONE, SLOT_STATE_TASK_GONE_BY_OPERATOR, SLOT_STATE_TASK_UNKNOWN:
+		return true
+	}
+
+	return false
+}
+
+func (slot *Slot) unreachableGracePeriod() time.Duration {
+	if slot.App.Scontext != nil && slot.App.Scontext.Config.Scheduler.UnreachableGracePeriod > 0 {
+		return time.Duration(slot.App.Scontext.Config.Scheduler.UnreachableGracePeriod) * time.Second
+	}
+
+	return DEFAULT_UNREACHABLE_GRACE_PERIOD
+}
+
+// the expiry is sent to the scheduler loop as a health report
+func (slot *Slot) startUnreachableTimer() {
+	if slot.unreachableTimer != nil {
+		return
+	}
+
+	gracePeriod := slot.unreachableGracePeriod()
+	logrus.Infof("slot %s unreachable, replace it in %s if not back", slot.Id, gracePeriod)
+
+	slot.unreachableSince = time.Now()
+	slot.unreachableTimer = time.AfterFunc(gracePeriod, func() {
+		healthReports <- &HealthReport{slot: slot, unreachable: true}
+	})
+}
+
+func (slot *Slot) stopUnreachableTimer() {
+	slot.unreachableSince = time.Time{}
+	if slot.unreachableTimer != nil {
+		slot.unreachableTimer.Stop()
+		slot.unreachableTimer = nil
+	}
+}
+
+// called by the scheduler loop once the grace period expired
+func (slot *Slot) replaceUnreachableTask() {
+	// stale timer of an earlier unreachable period
+	if !slot.StateIs(SLOT_STATE_TASK_UNREACHABLE) || slot.unreachableSince.IsZero() ||
+		time.Since(slot.unreachableSince) < slot.unreachableGracePeriod() {
+		return
+	}
+	slot.unreachableSince = time.Time{}
+	slot.unreachableTimer = nil
+
+	logrus.Infof("slot %s unreachable for too long, replace task %s", slot.Id, slot.CurrentTask.TaskInfoId)
+
+	// kill reaches the agent once it reregisters, or the task turns out to be stale then
+	slot.CurrentTask.Kill()
+
+	if slot.markForDeletion {
+		slot.App.RemoveSlot(slot.Index)
+		return
+	}
+
+	slot.Archive()
+	slot.DispatchNewTask(slot.Version)
+}
+
+// cancel the pending restart if any
 func (slot *Slot) StopRestartPolicy() {
 	if slot.restartPolicy != nil {
 		slot.restartPolicy.Stop()
-		slot.restartPolicy = nil
 	}
 }
 
 func (slot *Slot) Abnormal() bool {
-	return slot.StateIs(SLOT_STATE_TASK_LOST) || slot.StateIs(SLOT_STATE_TASK_FAILED) || slot.StateIs(SLOT_STATE_TASK_LOST) || slot.StateIs(SLOT_STATE_TASK_FINISHED)
+	return slot.StateIs(SLOT_STATE_TASK_LOST) || slot.StateIs(SLOT_STATE_TASK_FAILED) || slot.StateIs(SLOT_STATE_TASK_FINISHED) ||
+		slot.StateIs(SLOT_STATE_TASK_DROPPED) || slot.StateIs(SLOT_STATE_TASK_GONE) ||
+		slot.StateIs(SLOT_STATE_TASK_GONE_BY_OPERATOR) || slot.StateIs(SLOT_STATE_TASK_UNKNOWN)
 }
 
 func (slot *Slot) Normal() bool {
@@ -381,9 +644,11 @@ func (slot *Slot) EmitTaskEvent(t string) {
 	if slot.App.IsFixed() {
 		e := &swanevent.Event{Type: t}
 		e.Payload = &swanevent.TaskInfo{
-			Ip:     slot.Ip,
-			TaskId: strings.ToLower(strings.Replace(slot.Id, "-", ".", -1)),
-			Type:   "a",
+			Ip:        slot.Ip,
+			TaskId:    strings.ToLower(strings.Replace(slot.Id, "-", ".", -1)),
+			Type:      "a",
+			AppId:     slot.App.AppId,
+			VersionId: slot.Version.ID,
 		}
 		slot.App.EmitEvent(e)
 
@@ -391,10 +656,12 @@ func (slot *Slot) EmitTaskEvent(t string) {
 		for _, port := range slot.CurrentTask.HostPorts {
 			e := &swanevent.Event{Type: t}
 			e.Payload = &swanevent.TaskInfo{
-				Ip:     slot.AgentHostName,
-				Port:   fmt.Sprintf("%d", port),
-				TaskId: strings.ToLower(strings.Replace(slot.Id, "-", ".", -1)),
-				Type:   "srv",
+				Ip:        slot.AgentHostName,
+				Port:      fmt.Sprintf("%d", port),
+				TaskId:    strings.ToLower(strings.Replace(slot.Id, "-", ".", -1)),
+				Type:      "srv",
+				AppId:     slot.App.AppId,
+				VersionId: slot.Version.ID,
 			}
 			slot.App.EmitEvent(e)
 		}
@@ -409,9 +676,90 @@ func (slot *Slot) Healthy() bool {
 	return slot.healthy
 }
 
+// SetHealthy records the health reported by mesos for the task
 func (slot *Slot) SetHealthy(healthy bool) {
+	slot.reportHealth(nil, healthy)
+}
+
+// task is out of service discovery while unhealthy, and replaced per the
+// unhealthy policy
+func (slot *Slot) applyHealth(healthy bool) {
 	slot.healthy = healthy
-	slot.Touch(false)
+	defer slot.Touch(false)
+
+	if healthy {
+		if !slot.unhealthySince.IsZero() && slot.Ready() {
+			logrus.Infof("slot %s healthy again", slot.Id)
+			slot.EmitTaskEvent(swanevent.EventTypeTaskAdd)
+		}
+		slot.unhealthyReports = 0
+		slot.unhealthySince = time.Time{}
+		slot.stopUnhealthyTimer()
+		return
+	}
+
+	if !slot.StateIs(SLOT_STATE_TASK_RUNNING) || slot.markForDeletion {
+		return
+	}
+
+	slot.unhealthyReports += 1
+	if slot.unhealthySince.IsZero() {
+		slot.unhealthySince = time.Now()
+		slot.EmitTaskEvent(swanevent.EventTypeTaskUnhealthy)
+
+		if slot.Version.MaxUnhealthySeconds > 0 {
+			slot.unhealthyTimer = time.AfterFunc(floatSeconds(slot.Version.MaxUnhealthySeconds), func() {
+				healthReports <- &HealthReport{slot: slot}
+			})
+		}
+	}
+
+	if slot.Version.MaxConsecutiveUnhealthy > 0 && slot.unhealthyReports >= int(slot.Version.MaxConsecutiveUnhealthy) {
+		slot.replaceUnhealthyTask()
+	}
+}
+
+// new task to be launched, forget health of the previous one
+func (slot *Slot) resetHealth() {
+	slot.healthy = false
+	slot.checkResults = nil
+	slot.stopHealthCheckers()
+	slot.unhealthyReports = 0
+	slot.unhealthySince = time.Time{}
+	slot.stopUnhealthyTimer()
+}
+
+func (slot *Slot) stopUnhealthyTimer() {
+	if slot.unhealthyTimer != nil {
+		slot.unhealthyTimer.Stop()
+		slot.unhealthyTimer = nil
+	}
+}
+
+func (slot *Slot) replaceUnhealthyTask() {
+	slot.stopUnhealthyTimer()
+	if !slot.StateIs(SLOT_STATE_TASK_RUNNING) || slot.healthy || slot.markForDeletion {
+		return
+	}
+
+	logrus.Warnf("slot %s unhealthy since %s with %d reports in a row, replace task %s",
+		slot.Id, slot.unhealthySince, slot.unhealthyReports, slot.CurrentTask.TaskInfoId)
+
+	// task of the proposed version never got healthy, or not anymore
+	if slot.runningProposedVersion() {
+		slot.UpdateFailures += 1
+		slot.App.onUpdateFailure(slot, fmt.Sprintf("unhealthy since %s with %d reports in a row", slot.unhealthySince, slot.unhealthyReports))
+
+		// update rolled back, the task is killed and replaced by the rollback
+		if slot.App.StateIs(APP_STATE_MARK_FOR_CANCEL_UPDATE) {
+			return
+		}
+	}
+
+	// status updates of the killed task are ignored as stale
+	slot.CurrentTask.Kill()
+	slot.Archive()
+	slot.DispatchNewTask(slot.Version)
 }
 
 func (slot *Slot) MarkForDeletion() bool {
@@ -461,12 +809,14 @@ func (slot *Slot) update() {
 	logrus.Debugf("update slot %s", slot.Id)
 	WithConvertSlot(context.TODO(), slot, nil, persistentStore.UpdateSlot)
 	slot.touched = false
+	slot.updateVolumes()
 }
 
 func (slot *Slot) create() {
 	logrus.Debugf("create slot %s", slot.Id)
 	WithConvertSlot(context.TODO(), slot, nil, persistentStore.CreateSlot)
 	slot.touched = false
+	slot.updateVolumes()
 }
 
 func (slot *Slot) remove() {
@@ -0,0 +1,183 @@
+package state
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+	"github.com/Dataman-Cloud/swan/src/types"
+
+	"github.com/Dataman-Cloud/swan-janitor/src/upstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailedInUpdate(t *testing.T) {
+	app, slot := newTestApp([]string{})
+	app.State = APP_STATE_MARK_FOR_UPDATING
+	app.ProposedVersion = &types.Version{ID: "2", AppId: app.AppId}
+	app.CurrentVersion.ID = "1"
+
+	// old task killed by the update itself
+	slot.State = SLOT_STATE_TASK_KILLED
+	assert.False(t, slot.failedInUpdate(SLOT_STATE_PENDING_KILL))
+
+	slot.CurrentTask.Version = app.ProposedVersion
+	assert.False(t, slot.failedInUpdate(SLOT_STATE_PENDING_KILL))
+	assert.True(t, slot.failedInUpdate(SLOT_STATE_TASK_RUNNING))
+
+	slot.State = SLOT_STATE_TASK_FAILED
+	assert.True(t, slot.failedInUpdate(SLOT_STATE_TASK_RUNNING))
+
+	app.State = APP_STATE_MARK_FOR_CANCEL_UPDATE
+	assert.False(t, slot.failedInUpdate(SLOT_STATE_TASK_RUNNING))
+}
+
+func TestUnhealthyInUpdate(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	app, slot := newTestApp([]string{}, newTestOffer("offer-1", "host-1"))
+	app.OfferAllocatorRef = NewOfferAllocator()
+	app.State = APP_STATE_MARK_FOR_UPDATING
+	app.CurrentVersion.ID = "1"
+	app.ProposedVersion = &types.Version{ID: "2", AppId: app.AppId, Instances: 1,
+		UpdatePolicy: &types.UpdatePolicy{Action: UPDATE_POLICY_ACTION_ROLLBACK, MaxRetries: 1, MaxFailovers: 1}}
+
+	// task of proposed version replaced, within MaxRetries
+	slot = app.slots[0]
+	slot.Version = app.ProposedVersion
+	slot.CurrentTask.Version = app.ProposedVersion
+	slot.markForRollingUpdate = true
+	slot.unhealthySince = time.Now()
+	slot.replaceUnhealthyTask()
+	assert.Equal(t, 1, slot.UpdateFailures)
+	assert.True(t, app.StateIs(APP_STATE_MARK_FOR_UPDATING))
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+	assert.Equal(t, SLOT_STATE_PENDING_OFFER, slot.State)
+	assert.Equal(t, app.ProposedVersion, slot.CurrentTask.Version)
+
+	// replaced again, update rolled back
+	slot.State = SLOT_STATE_TASK_RUNNING
+	slot.unhealthySince = time.Now()
+	slot.replaceUnhealthyTask()
+	assert.Equal(t, 2, slot.UpdateFailures)
+	assert.True(t, app.StateIs(APP_STATE_MARK_FOR_CANCEL_UPDATE))
+	assert.Equal(t, app.CurrentVersion, slot.Version)
+}
+
+func TestUnreachableTimerReportsToLoop(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	app, slot := newTestApp([]string{}, newTestOffer("offer-1", "host-1"))
+	app.OfferAllocatorRef = NewOfferAllocator()
+	app.Scontext.Config.Scheduler.UnreachableGracePeriod = 1
+	slot = app.slots[0]
+	slot.State = SLOT_STATE_TASK_UNREACHABLE
+	slot.startUnreachableTimer()
+
+	expired := func() *HealthReport {
+		select {
+		case report := <-HealthReports():
+			return report
+		case <-time.After(2 * time.Second):
+			t.Fatal("unreachable timer not reported")
+		}
+		return nil
+	}
+
+	// stale report of an earlier unreachable period
+	report := expired()
+	slot.stopUnreachableTimer()
+	slot.startUnreachableTimer()
+	report.Apply()
+	assert.Equal(t, SLOT_STATE_TASK_UNREACHABLE, slot.State)
+	assert.Equal(t, 0, len(calls))
+
+	expired().Apply()
+	assert.Equal(t, sched.Call_KILL, (<-calls).GetType())
+	assert.Equal(t, SLOT_STATE_PENDING_OFFER, slot.State)
+}
+
+func TestCheckAddress(t *testing.T) {
+	app, slot := newTestApp([]string{})
+	slot.Version.Container = &types.Container{Docker: &types.Docker{PortMappings: []*types.PortMapping{
+		{Name: "admin", ContainerPort: 9090}, {Name: "web", ContainerPort: 8080}}}}
+	slot.AgentHostName = "host1"
+	slot.CurrentTask.HostPorts = []uint64{31000, 31001}
+
+	address, err := slot.checkAddress("web", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "host1:31001", address)
+
+	_, err = slot.checkAddress("db", 0)
+	assert.NotNil(t, err)
+
+	app.Mode = APP_MODE_FIXED
+	slot.Ip = "192.168.1.10"
+	address, err = slot.checkAddress("", 8080)
+	assert.Nil(t, err)
+	assert.Equal(t, "192.168.1.10:8080", address)
+}
+
+func TestKillCurrentTaskDrains(t *testing.T) {
+	calls, tearDown := setUpTestStore()
+	defer tearDown()
+
+	var active int32 = 1
+	targetConnections = func(address string) int {
+		if address != "host1:31000" {
+			return 0
+		}
+		return int(atomic.LoadInt32(&active))
+	}
+	drainPollInterval = 10 * time.Millisecond
+	defer func() {
+		targetConnections = upstream.Connections
+		drainPollInterval = 100 * time.Millisecond
+	}()
+
+	_, slot := newTestApp([]string{})
+	slot.AgentHostName = "host1"
+	slot.CurrentTask.HostPorts = []uint64{31000}
+	slot.Version.KillPolicy = &types.KillPolicy{Duration: 5000, DrainDuration: 300}
+	assert.Equal(t, 300*time.Millisecond, slot.drainDuration())
+
+	// connections left, killed once the drain duration passed
+	slot.killCurrentTask(true)
+	select {
+	case <-calls:
+		t.Fatal("task killed before the drain ended")
+	case <-time.After(200 * time.Millisecond):
+	}
+	select {
+	case call := <-calls:
+		assert.Equal(t, sched.Call_KILL, call.GetType())
+	case <-time.After(time.Second):
+		t.Fatal("task not killed after the drain ended")
+	}
+
+	// no connections left, killed before the drain duration
+	slot.Version.KillPolicy.DrainDuration = 60000
+	slot.killCurrentTask(true)
+	atomic.StoreInt32(&active, 0)
+	select {
+	case call := <-calls:
+		assert.Equal(t, sched.Call_KILL, call.GetType())
+	case <-time.After(time.Second):
+		t.Fatal("task not killed once connections drained")
+	}
+
+	// task gone while draining, nothing left to kill
+	atomic.StoreInt32(&active, 1)
+	slot.killCurrentTask(true)
+	assert.NotNil(t, slot.drainStopC)
+	slot.stopDraining()
+	assert.Nil(t, slot.drainStopC)
+	select {
+	case <-calls:
+		t.Fatal("task killed after draining stopped")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
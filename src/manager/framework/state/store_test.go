@@ -0,0 +1,71 @@
+package state
+
+import (
+	"github.com/Dataman-Cloud/swan/src/config"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/mesos_connector"
+	"github.com/Dataman-Cloud/swan/src/manager/framework/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/Dataman-Cloud/swan/src/mesosproto/sched"
+
+	"golang.org/x/net/context"
+)
+
+// testStore persists nothing, for tests going through updates of apps,
+// slots and jobs
+type testStore struct {
+	store.Store
+}
+
+func (s *testStore) CreateApp(ctx context.Context, app *types.Application, cb func()) error {
+	return nil
+}
+
+func (s *testStore) UpdateApp(ctx context.Context, app *types.Application, cb func()) error {
+	return nil
+}
+
+func (s *testStore) CommitAppProposeVersion(ctx context.Context, app *types.Application, cb func()) error {
+	return nil
+}
+
+func (s *testStore) CreateSlot(ctx context.Context, slot *types.Slot, cb func()) error {
+	return nil
+}
+
+func (s *testStore) UpdateSlot(ctx context.Context, slot *types.Slot, cb func()) error {
+	return nil
+}
+
+func (s *testStore) DeleteSlot(ctx context.Context, appId, slotId string, cb func()) error {
+	return nil
+}
+
+func (s *testStore) UpdateTask(ctx context.Context, task *types.Task, cb func()) error {
+	return nil
+}
+
+func (s *testStore) UpdateAppVolumes(ctx context.Context, appVolumes *types.AppVolumes, cb func()) error {
+	return nil
+}
+
+func (s *testStore) CreateJob(ctx context.Context, job *types.Job, cb func()) error {
+	return nil
+}
+
+func (s *testStore) UpdateJob(ctx context.Context, job *types.Job, cb func()) error {
+	return nil
+}
+
+// persist into testStore and send mesos calls to the channel returned,
+// call the func returned when done
+func setUpTestStore() (chan *sched.Call, func()) {
+	SetStore(&testStore{})
+	connector := mesos_connector.NewMesosConnector(config.Scheduler{})
+
+	return connector.MesosCallChan, func() {
+		SetStore(nil)
+		for len(connector.MesosCallChan) > 0 {
+			<-connector.MesosCallChan
+		}
+	}
+}
@@ -17,6 +17,9 @@ import (
 
 const (
 	SWAN_RESERVED_NETWORK = "swan"
+
+	CONTAINER_TYPE_DOCKER = "DOCKER"
+	CONTAINER_TYPE_MESOS  = "MESOS" // docker image run by the mesos containerizer
 )
 
 type Task struct {
@@ -35,6 +38,8 @@ type Task struct {
 	Ip            string
 	AgentHostName string
 
+	AgentAttributes map[string]string
+
 	Reason  string
 	Message string
 	Source  string
@@ -66,48 +71,23 @@ func (task *Task) PrepareTaskInfo(ow *OfferWrapper) *mesos.TaskInfo {
 		TaskId: &mesos.TaskID{
 			Value: proto.String(task.TaskInfoId),
 		},
-		AgentId:   offer.AgentId,
-		Resources: task.Slot.PrepareResources(),
-		Command: &mesos.CommandInfo{
-			Shell: proto.Bool(false),
-			Value: nil,
-		},
-		Container: &mesos.ContainerInfo{
-			Type: mesos.ContainerInfo_DOCKER.Enum(),
-			Docker: &mesos.ContainerInfo_DockerInfo{
-				Image: &task.Slot.Version.Container.Docker.Image,
-			},
-		},
+		AgentId: offer.AgentId,
+		Command: task.prepareCommand(),
 	}
 
-	taskInfo.Container.Docker.Privileged = &task.Slot.Version.Container.Docker.Privileged
-	taskInfo.Container.Docker.ForcePullImage = &task.Slot.Version.Container.Docker.ForcePullImage
+	// volumes created first, on unreserved disk the task doesn't use otherwise
+	volumes := task.Slot.preparePersistentVolumes(ow)
+	taskInfo.Resources = append(ow.Allocate(task.Slot.PrepareResources()), volumes...)
 
-	for _, parameter := range task.Slot.Version.Container.Docker.Parameters {
-		taskInfo.Container.Docker.Parameters = append(taskInfo.Container.Docker.Parameters, &mesos.Parameter{
-			Key:   proto.String(parameter.Key),
-			Value: proto.String(parameter.Value),
-		})
-	}
-
-	// check if app run in fixed mode and has reserved enough IP
-	if task.Slot.App.IsFixed() {
-		taskInfo.Container.Docker.Parameters = append(taskInfo.Container.Docker.Parameters, &mesos.Parameter{
-			Key:   proto.String("ip"),
-			Value: proto.String(task.Slot.Ip),
-		})
-	}
-
-	for _, volume := range task.Slot.Version.Container.Volumes {
-		mode := mesos.Volume_RO
-		if volume.Mode == "RW" {
-			mode = mesos.Volume_RW
+	// no container for command-only app
+	if container := task.Slot.Version.Container; container != nil {
+		if strings.ToUpper(container.Type) == CONTAINER_TYPE_MESOS {
+			task.prepareMesosContainer(&taskInfo)
+		} else {
+			task.prepareDockerContainer(&taskInfo, ow)
 		}
-		taskInfo.Container.Volumes = append(taskInfo.Container.Volumes, &mesos.Volume{
-			ContainerPath: proto.String(volume.ContainerPath),
-			HostPath:      proto.String(volume.HostPath),
-			Mode:          &mode,
-		})
+
+		task.prepareVolumes(&taskInfo)
 	}
 
 	vars := make([]*mesos.Environment_Variable, 0)
@@ -147,99 +127,8 @@ func (task *Task) PrepareTaskInfo(ow *OfferWrapper) *mesos.TaskInfo {
 		}
 	}
 
-	switch task.Slot.Version.Container.Docker.Network {
-	case "NONE":
-		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_NONE.Enum()
-	case "HOST":
-		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_HOST.Enum()
-	case "BRIDGE":
-		ports := ow.PortsRemain()
-		if len(ports) < len(task.Slot.Version.Container.Docker.PortMappings) {
-			logrus.Errorf("No ports resource defined")
-			break
-		}
-
-		task.HostPorts = make([]uint64, 0)
-		for index, m := range task.Slot.Version.Container.Docker.PortMappings {
-			hostPort := ports[index]
-			task.HostPorts = append(task.HostPorts, hostPort)
-			taskInfo.Container.Docker.PortMappings = append(taskInfo.Container.Docker.PortMappings,
-				&mesos.ContainerInfo_DockerInfo_PortMapping{
-					HostPort:      proto.Uint32(uint32(hostPort)),
-					ContainerPort: proto.Uint32(uint32(m.ContainerPort)),
-					Protocol:      proto.String(m.Protocol),
-				},
-			)
-			taskInfo.Resources = append(taskInfo.Resources, &mesos.Resource{
-				Name: proto.String("ports"),
-				Type: mesos.Value_RANGES.Enum(),
-				Ranges: &mesos.Value_Ranges{
-					Range: []*mesos.Value_Range{
-						{
-							Begin: proto.Uint64(uint64(hostPort)),
-							End:   proto.Uint64(uint64(hostPort)),
-						},
-					},
-				},
-			})
-		}
-		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_BRIDGE.Enum()
-
-	case SWAN_RESERVED_NETWORK:
-		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_USER.Enum()
-		taskInfo.Container.NetworkInfos = append(taskInfo.Container.NetworkInfos, &mesos.NetworkInfo{
-			Name: proto.String(SWAN_RESERVED_NETWORK),
-		})
-
-	default:
-		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_NONE.Enum()
-	}
-
-	// setup task health check
-	if task.Slot.App.IsReplicates() {
-		if len(task.Slot.Version.HealthChecks) > 0 {
-			for _, healthCheck := range task.Slot.Version.HealthChecks {
-				var hostPort *uint32
-				for _, portMapping := range task.Slot.Version.Container.Docker.PortMappings {
-					if portMapping.Name == healthCheck.PortName {
-						containerPort := portMapping.ContainerPort
-						for _, portMapping := range taskInfo.Container.Docker.PortMappings {
-							if uint32(containerPort) == *portMapping.ContainerPort {
-								hostPort = portMapping.HostPort
-							}
-						}
-					}
-				}
-
-				protocol := strings.ToLower(healthCheck.Protocol)
-				if protocol == "http" {
-					taskInfo.HealthCheck = &mesos.HealthCheck{
-						Type: mesos.HealthCheck_HTTP.Enum(),
-						Http: &mesos.HealthCheck_HTTPCheckInfo{
-							Scheme:   proto.String("http"),
-							Port:     hostPort,
-							Path:     &healthCheck.Path,
-							Statuses: []uint32{uint32(200), uint32(201), uint32(301), uint32(302)},
-						},
-					}
-				}
-
-				if protocol == "tcp" {
-					taskInfo.HealthCheck = &mesos.HealthCheck{
-						Type: mesos.HealthCheck_TCP.Enum(),
-						Tcp: &mesos.HealthCheck_TCPCheckInfo{
-							Port: hostPort,
-						},
-					}
-				}
-
-				taskInfo.HealthCheck.IntervalSeconds = proto.Float64(healthCheck.IntervalSeconds)
-				taskInfo.HealthCheck.TimeoutSeconds = proto.Float64(healthCheck.TimeoutSeconds)
-				taskInfo.HealthCheck.ConsecutiveFailures = proto.Uint32(healthCheck.ConsecutiveFailures)
-				taskInfo.HealthCheck.GracePeriodSeconds = proto.Float64(healthCheck.GracePeriodSeconds)
-			}
-		}
-	}
+	// setup the health check run by mesos, the others probed by swan
+	task.prepareHealthCheck(&taskInfo)
 
 	return &taskInfo
 }
@@ -294,3 +183,140 @@ func (task *Task) Kill() {
 
 	mesos_connector.Instance().MesosCallChan <- call
 }
+
+// shell command, argv of command-only app, or entrypoint and args
+// overridden for the image
+func (task *Task) prepareCommand() *mesos.CommandInfo {
+	version := task.Slot.Version
+	command := &mesos.CommandInfo{
+		Shell: proto.Bool(false),
+	}
+
+	// docker containers always ignored the command, keep running their
+	// entrypoint
+	dockerContainer := version.Container != nil && strings.ToUpper(version.Container.Type) != CONTAINER_TYPE_MESOS
+
+	switch {
+	case len(version.Command) > 0 && !dockerContainer:
+		command.Shell = proto.Bool(true)
+		command.Value = proto.String(version.Command)
+
+	case version.Container == nil:
+		command.Value = proto.String(version.Args[0])
+		command.Arguments = version.Args
+
+	default:
+		if len(version.Container.Entrypoint) > 0 {
+			command.Value = proto.String(version.Container.Entrypoint)
+		}
+		command.Arguments = version.Args
+	}
+
+	return command
+}
+
+// docker image run by the mesos containerizer
+func (task *Task) prepareMesosContainer(taskInfo *mesos.TaskInfo) {
+	docker := task.Slot.Version.Container.Docker
+	taskInfo.Container = &mesos.ContainerInfo{
+		Type: mesos.ContainerInfo_MESOS.Enum(),
+		Mesos: &mesos.ContainerInfo_MesosInfo{
+			Image: &mesos.Image{
+				Type: mesos.Image_DOCKER.Enum(),
+				Docker: &mesos.Image_Docker{
+					Name: proto.String(docker.Image),
+				},
+				Cached: proto.Bool(!docker.ForcePullImage),
+			},
+		},
+	}
+}
+
+func (task *Task) prepareDockerContainer(taskInfo *mesos.TaskInfo, ow *OfferWrapper) {
+	taskInfo.Container = &mesos.ContainerInfo{
+		Type: mesos.ContainerInfo_DOCKER.Enum(),
+		Docker: &mesos.ContainerInfo_DockerInfo{
+			Image: &task.Slot.Version.Container.Docker.Image,
+		},
+	}
+
+	taskInfo.Container.Docker.Privileged = &task.Slot.Version.Container.Docker.Privileged
+	taskInfo.Container.Docker.ForcePullImage = &task.Slot.Version.Container.Docker.ForcePullImage
+
+	for _, parameter := range task.Slot.Version.Container.Docker.Parameters {
+		taskInfo.Container.Docker.Parameters = append(taskInfo.Container.Docker.Parameters, &mesos.Parameter{
+			Key:   proto.String(parameter.Key),
+			Value: proto.String(parameter.Value),
+		})
+	}
+
+	// check if app run in fixed mode and has reserved enough IP
+	if task.Slot.App.IsFixed() {
+		taskInfo.Container.Docker.Parameters = append(taskInfo.Container.Docker.Parameters, &mesos.Parameter{
+			Key:   proto.String("ip"),
+			Value: proto.String(task.Slot.Ip),
+		})
+	}
+
+	switch task.Slot.Version.Container.Docker.Network {
+	case "NONE":
+		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_NONE.Enum()
+	case "HOST":
+		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_HOST.Enum()
+	case "BRIDGE":
+		ports := ow.PortsRemain()
+		if len(ports) < len(task.Slot.Version.Container.Docker.PortMappings) {
+			logrus.Errorf("No ports resource defined")
+			break
+		}
+
+		task.HostPorts = make([]uint64, 0)
+		for index, m := range task.Slot.Version.Container.Docker.PortMappings {
+			hostPort := ports[index]
+			task.HostPorts = append(task.HostPorts, hostPort)
+			taskInfo.Container.Docker.PortMappings = append(taskInfo.Container.Docker.PortMappings,
+				&mesos.ContainerInfo_DockerInfo_PortMapping{
+					HostPort:      proto.Uint32(uint32(hostPort)),
+					ContainerPort: proto.Uint32(uint32(m.ContainerPort)),
+					Protocol:      proto.String(m.Protocol),
+				},
+			)
+			taskInfo.Resources = append(taskInfo.Resources, ow.Allocate([]*mesos.Resource{
+				createRangeResource("ports", hostPort, hostPort),
+			})...)
+		}
+		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_BRIDGE.Enum()
+
+	case SWAN_RESERVED_NETWORK:
+		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_USER.Enum()
+		taskInfo.Container.NetworkInfos = append(taskInfo.Container.NetworkInfos, &mesos.NetworkInfo{
+			Name: proto.String(SWAN_RESERVED_NETWORK),
+		})
+
+	default:
+		taskInfo.Container.Docker.Network = mesos.ContainerInfo_DockerInfo_NONE.Enum()
+	}
+}
+
+func (task *Task) prepareVolumes(taskInfo *mesos.TaskInfo) {
+	for _, volume := range task.Slot.Version.Container.Volumes {
+		if volume.Persistent != nil { // mounted from the sandbox, where mesos mounts the volume
+			taskInfo.Container.Volumes = append(taskInfo.Container.Volumes, &mesos.Volume{
+				ContainerPath: proto.String(volume.ContainerPath),
+				HostPath:      proto.String(sandboxPath(volume.ContainerPath)),
+				Mode:          mesos.Volume_RW.Enum(),
+			})
+			continue
+		}
+
+		mode := mesos.Volume_RO
+		if volume.Mode == "RW" {
+			mode = mesos.Volume_RW
+		}
+		taskInfo.Container.Volumes = append(taskInfo.Container.Volumes, &mesos.Volume{
+			ContainerPath: proto.String(volume.ContainerPath),
+			HostPath:      proto.String(volume.HostPath),
+			Mode:          &mode,
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAndFormatContainer(t *testing.T) {
+	version := &types.Version{Mode: string(APP_MODE_REPLICATES)}
+	assert.NotNil(t, validateAndFormatContainer(version))
+
+	version.Args = []string{"sleep", "100"}
+	assert.Nil(t, validateAndFormatContainer(version))
+
+	version.Mode = string(APP_MODE_FIXED)
+	assert.NotNil(t, validateAndFormatContainer(version))
+
+	version.Mode = string(APP_MODE_REPLICATES)
+	version.Container = &types.Container{Docker: &types.Docker{Image: "nginx"}}
+	assert.Nil(t, validateAndFormatContainer(version))
+	assert.Equal(t, CONTAINER_TYPE_DOCKER, version.Container.Type)
+
+	version.Container.Type = "mesos"
+	assert.Nil(t, validateAndFormatContainer(version))
+	assert.False(t, IsDockerContainer(version))
+
+	version.Container.Docker.PortMappings = []*types.PortMapping{{Name: "web", ContainerPort: 80}}
+	assert.NotNil(t, validateAndFormatContainer(version))
+
+	version.Container = &types.Container{Type: "rkt", Docker: &types.Docker{Image: "nginx"}}
+	assert.NotNil(t, validateAndFormatContainer(version))
+}
+
+func TestPrepareCommand(t *testing.T) {
+	version := &types.Version{Command: "python -m SimpleHTTPServer"}
+	task := &Task{Slot: &Slot{Version: version}}
+
+	command := task.prepareCommand()
+	assert.True(t, command.GetShell())
+	assert.Equal(t, "python -m SimpleHTTPServer", command.GetValue())
+
+	version.Command, version.Args = "", []string{"sleep", "100"}
+	command = task.prepareCommand()
+	assert.False(t, command.GetShell())
+	assert.Equal(t, "sleep", command.GetValue())
+	assert.Equal(t, []string{"sleep", "100"}, command.Arguments)
+
+	version.Container = &types.Container{Entrypoint: "/bin/sh", Docker: &types.Docker{Image: "busybox"}}
+	version.Args = []string{"-c", "echo hello"}
+	command = task.prepareCommand()
+	assert.Equal(t, "/bin/sh", command.GetValue())
+	assert.Equal(t, []string{"-c", "echo hello"}, command.Arguments)
+
+	// command of docker container ignored as before
+	version.Command = "python -m SimpleHTTPServer"
+	command = task.prepareCommand()
+	assert.False(t, command.GetShell())
+	assert.Equal(t, "/bin/sh", command.GetValue())
+
+	version.Container.Type = CONTAINER_TYPE_MESOS
+	command = task.prepareCommand()
+	assert.True(t, command.GetShell())
+	assert.Equal(t, "python -m SimpleHTTPServer", command.GetValue())
+}
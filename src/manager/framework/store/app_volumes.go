@@ -0,0 +1,50 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) UpdateAppVolumes(ctx context.Context, appVolumes *types.AppVolumes, cb func()) error {
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindUpdate,
+		Target: &types.StoreAction_AppVolumes{AppVolumes: appVolumes},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
+
+func (s *FrameworkStore) ListAppVolumes(appId string) ([]*types.AppVolumes, error) {
+	var volumes []*types.AppVolumes
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetAppVolumesListBucket(tx, appId)
+		if bkt == nil {
+			volumes = []*types.AppVolumes{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			volumesBucket := bkt.Bucket(k)
+			if volumesBucket == nil {
+				return nil
+			}
+
+			appVolumes := &types.AppVolumes{}
+			p := volumesBucket.Get(raftstore.BucketKeyData)
+			if err := appVolumes.Unmarshal(p); err != nil {
+				return err
+			}
+
+			volumes = append(volumes, appVolumes)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return volumes, nil
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) CreateAutoscaler(ctx context.Context, autoscaler *types.Autoscaler, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindCreate,
+		Target: &types.StoreAction_Autoscaler{Autoscaler: autoscaler},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) UpdateAutoscaler(ctx context.Context, autoscaler *types.Autoscaler, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindUpdate,
+		Target: &types.StoreAction_Autoscaler{Autoscaler: autoscaler},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) GetAutoscaler(appId string) (*types.Autoscaler, error) {
+	autoscaler := &types.Autoscaler{}
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		return raftstore.WithAutoscalerBucket(tx, appId, func(bkt *bolt.Bucket) error {
+			p := bkt.Get(raftstore.BucketKeyData)
+
+			return autoscaler.Unmarshal(p)
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return autoscaler, nil
+}
+
+func (s *FrameworkStore) ListAutoscalers() ([]*types.Autoscaler, error) {
+	var autoscalers []*types.Autoscaler
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetAutoscalersBucket(tx)
+		if bkt == nil {
+			autoscalers = []*types.Autoscaler{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			autoscalerBucket := raftstore.GetAutoscalerBucket(tx, string(k))
+			if autoscalerBucket == nil {
+				return nil
+			}
+
+			autoscaler := &types.Autoscaler{}
+			p := autoscalerBucket.Get(raftstore.BucketKeyData)
+			if err := autoscaler.Unmarshal(p); err != nil {
+				return err
+			}
+
+			autoscalers = append(autoscalers, autoscaler)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return autoscalers, nil
+}
+
+func (s *FrameworkStore) DeleteAutoscaler(ctx context.Context, appId string, cb func()) error {
+	removeAutoscaler := &types.Autoscaler{AppId: appId}
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindRemove,
+		Target: &types.StoreAction_Autoscaler{Autoscaler: removeAutoscaler},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) CreateCronJob(ctx context.Context, cronJob *types.CronJob, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindCreate,
+		Target: &types.StoreAction_CronJob{CronJob: cronJob},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) UpdateCronJob(ctx context.Context, cronJob *types.CronJob, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindUpdate,
+		Target: &types.StoreAction_CronJob{CronJob: cronJob},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) GetCronJob(cronJobId string) (*types.CronJob, error) {
+	cronJob := &types.CronJob{}
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		return raftstore.WithCronJobBucket(tx, cronJobId, func(bkt *bolt.Bucket) error {
+			p := bkt.Get(raftstore.BucketKeyData)
+
+			return cronJob.Unmarshal(p)
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return cronJob, nil
+}
+
+func (s *FrameworkStore) ListCronJobs() ([]*types.CronJob, error) {
+	var cronJobs []*types.CronJob
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetCronJobsBucket(tx)
+		if bkt == nil {
+			cronJobs = []*types.CronJob{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			cronJobBucket := raftstore.GetCronJobBucket(tx, string(k))
+			if cronJobBucket == nil {
+				return nil
+			}
+
+			cronJob := &types.CronJob{}
+			p := cronJobBucket.Get(raftstore.BucketKeyData)
+			if err := cronJob.Unmarshal(p); err != nil {
+				return err
+			}
+
+			cronJobs = append(cronJobs, cronJob)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return cronJobs, nil
+}
+
+func (s *FrameworkStore) DeleteCronJob(ctx context.Context, cronJobId string, cb func()) error {
+	removeCronJob := &types.CronJob{ID: cronJobId}
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindRemove,
+		Target: &types.StoreAction_CronJob{CronJob: removeCronJob},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
@@ -22,8 +22,38 @@ type Store interface {
 	ListSlots(appId string) ([]*types.Slot, error)
 	UpdateSlot(ctx context.Context, slot *types.Slot, cb func()) error
 	DeleteSlot(ctx context.Context, appId, slotId string, cb func()) error
+	UpdateAppVolumes(ctx context.Context, appVolumes *types.AppVolumes, cb func()) error
+	ListAppVolumes(appId string) ([]*types.AppVolumes, error)
 	UpdateTask(ctx context.Context, task *types.Task, cb func()) error
 	ListTasks(appId, slotId string) ([]*types.Task, error)
 	UpdateFrameworkId(ctx context.Context, frameworkId string, cb func()) error
 	GetFrameworkId() (string, error)
+	CreateJob(ctx context.Context, job *types.Job, cb func()) error
+	UpdateJob(ctx context.Context, job *types.Job, cb func()) error
+	GetJob(jobId string) (*types.Job, error)
+	ListJobs() ([]*types.Job, error)
+	DeleteJob(ctx context.Context, jobId string, cb func()) error
+	CreateCronJob(ctx context.Context, cronJob *types.CronJob, cb func()) error
+	UpdateCronJob(ctx context.Context, cronJob *types.CronJob, cb func()) error
+	GetCronJob(cronJobId string) (*types.CronJob, error)
+	ListCronJobs() ([]*types.CronJob, error)
+	DeleteCronJob(ctx context.Context, cronJobId string, cb func()) error
+	CreateQuota(ctx context.Context, quota *types.Quota, cb func()) error
+	UpdateQuota(ctx context.Context, quota *types.Quota, cb func()) error
+	GetQuota(runAs string) (*types.Quota, error)
+	ListQuotas() ([]*types.Quota, error)
+	DeleteQuota(ctx context.Context, runAs string, cb func()) error
+	CreateAutoscaler(ctx context.Context, autoscaler *types.Autoscaler, cb func()) error
+	UpdateAutoscaler(ctx context.Context, autoscaler *types.Autoscaler, cb func()) error
+	GetAutoscaler(appId string) (*types.Autoscaler, error)
+	ListAutoscalers() ([]*types.Autoscaler, error)
+	DeleteAutoscaler(ctx context.Context, appId string, cb func()) error
+	CreateScalingSchedule(ctx context.Context, scalingSchedule *types.ScalingSchedule, cb func()) error
+	UpdateScalingSchedule(ctx context.Context, scalingSchedule *types.ScalingSchedule, cb func()) error
+	GetScalingSchedule(scheduleId string) (*types.ScalingSchedule, error)
+	ListScalingSchedules() ([]*types.ScalingSchedule, error)
+	DeleteScalingSchedule(ctx context.Context, scheduleId string, cb func()) error
+	CreateVolumesDestroy(ctx context.Context, volumesDestroy *types.VolumesDestroy, cb func()) error
+	ListVolumesDestroys() ([]*types.VolumesDestroy, error)
+	DeleteVolumesDestroy(ctx context.Context, id string, cb func()) error
 }
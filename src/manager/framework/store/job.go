@@ -0,0 +1,85 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) CreateJob(ctx context.Context, job *types.Job, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindCreate,
+		Target: &types.StoreAction_Job{Job: job},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) UpdateJob(ctx context.Context, job *types.Job, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindUpdate,
+		Target: &types.StoreAction_Job{Job: job},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) GetJob(jobId string) (*types.Job, error) {
+	job := &types.Job{}
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		return raftstore.WithJobBucket(tx, jobId, func(bkt *bolt.Bucket) error {
+			p := bkt.Get(raftstore.BucketKeyData)
+
+			return job.Unmarshal(p)
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *FrameworkStore) ListJobs() ([]*types.Job, error) {
+	var jobs []*types.Job
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetJobsBucket(tx)
+		if bkt == nil {
+			jobs = []*types.Job{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			jobBucket := raftstore.GetJobBucket(tx, string(k))
+			if jobBucket == nil {
+				return nil
+			}
+
+			job := &types.Job{}
+			p := jobBucket.Get(raftstore.BucketKeyData)
+			if err := job.Unmarshal(p); err != nil {
+				return err
+			}
+
+			jobs = append(jobs, job)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (s *FrameworkStore) DeleteJob(ctx context.Context, jobId string, cb func()) error {
+	removeJob := &types.Job{ID: jobId}
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindRemove,
+		Target: &types.StoreAction_Job{Job: removeJob},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) CreateScalingSchedule(ctx context.Context, scalingSchedule *types.ScalingSchedule, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindCreate,
+		Target: &types.StoreAction_ScalingSchedule{ScalingSchedule: scalingSchedule},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) UpdateScalingSchedule(ctx context.Context, scalingSchedule *types.ScalingSchedule, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindUpdate,
+		Target: &types.StoreAction_ScalingSchedule{ScalingSchedule: scalingSchedule},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) GetScalingSchedule(scheduleId string) (*types.ScalingSchedule, error) {
+	scalingSchedule := &types.ScalingSchedule{}
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		return raftstore.WithScalingScheduleBucket(tx, scheduleId, func(bkt *bolt.Bucket) error {
+			p := bkt.Get(raftstore.BucketKeyData)
+
+			return scalingSchedule.Unmarshal(p)
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return scalingSchedule, nil
+}
+
+func (s *FrameworkStore) ListScalingSchedules() ([]*types.ScalingSchedule, error) {
+	var scalingSchedules []*types.ScalingSchedule
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetScalingSchedulesBucket(tx)
+		if bkt == nil {
+			scalingSchedules = []*types.ScalingSchedule{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			scalingScheduleBucket := raftstore.GetScalingScheduleBucket(tx, string(k))
+			if scalingScheduleBucket == nil {
+				return nil
+			}
+
+			scalingSchedule := &types.ScalingSchedule{}
+			p := scalingScheduleBucket.Get(raftstore.BucketKeyData)
+			if err := scalingSchedule.Unmarshal(p); err != nil {
+				return err
+			}
+
+			scalingSchedules = append(scalingSchedules, scalingSchedule)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return scalingSchedules, nil
+}
+
+func (s *FrameworkStore) DeleteScalingSchedule(ctx context.Context, scheduleId string, cb func()) error {
+	removeScalingSchedule := &types.ScalingSchedule{ID: scheduleId}
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindRemove,
+		Target: &types.StoreAction_ScalingSchedule{ScalingSchedule: removeScalingSchedule},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	raftstore "github.com/Dataman-Cloud/swan/src/manager/raft/store"
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+	"github.com/boltdb/bolt"
+
+	"golang.org/x/net/context"
+)
+
+func (s *FrameworkStore) CreateVolumesDestroy(ctx context.Context, volumesDestroy *types.VolumesDestroy, cb func()) error {
+	storeAction := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindCreate,
+		Target: &types.StoreAction_VolumesDestroy{VolumesDestroy: volumesDestroy},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeAction, cb)
+}
+
+func (s *FrameworkStore) ListVolumesDestroys() ([]*types.VolumesDestroy, error) {
+	var volumesDestroys []*types.VolumesDestroy
+
+	if err := s.BoltbDb.View(func(tx *bolt.Tx) error {
+		bkt := raftstore.GetVolumesDestroysBucket(tx)
+		if bkt == nil {
+			volumesDestroys = []*types.VolumesDestroy{}
+			return nil
+		}
+
+		return bkt.ForEach(func(k, v []byte) error {
+			volumesDestroyBucket := raftstore.GetVolumesDestroyBucket(tx, string(k))
+			if volumesDestroyBucket == nil {
+				return nil
+			}
+
+			volumesDestroy := &types.VolumesDestroy{}
+			p := volumesDestroyBucket.Get(raftstore.BucketKeyData)
+			if err := volumesDestroy.Unmarshal(p); err != nil {
+				return err
+			}
+
+			volumesDestroys = append(volumesDestroys, volumesDestroy)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return volumesDestroys, nil
+}
+
+func (s *FrameworkStore) DeleteVolumesDestroy(ctx context.Context, id string, cb func()) error {
+	removeVolumesDestroy := &types.VolumesDestroy{Id: id}
+	storeActions := []*types.StoreAction{&types.StoreAction{
+		Action: types.StoreActionKindRemove,
+		Target: &types.StoreAction_VolumesDestroy{VolumesDestroy: removeVolumesDestroy},
+	}}
+
+	return s.RaftNode.ProposeValue(ctx, storeActions, cb)
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"strconv"
+
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateAppVolumesBucketIfNotExists(tx *bolt.Tx, appId string, index int32, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyApps, []byte(appId), bucketKeyVolumes, volumesKey(index))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func GetAppVolumesBucket(tx *bolt.Tx, appId string, index int32) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyApps, []byte(appId), bucketKeyVolumes, volumesKey(index))
+}
+
+func GetAppVolumesListBucket(tx *bolt.Tx, appId string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyApps, []byte(appId), bucketKeyVolumes)
+}
+
+func volumesKey(index int32) []byte {
+	return []byte(strconv.Itoa(int(index)))
+}
+
+func putAppVolumes(tx *bolt.Tx, appVolumes *types.AppVolumes) error {
+	return withCreateAppVolumesBucketIfNotExists(tx, appVolumes.AppId, appVolumes.Index, func(bkt *bolt.Bucket) error {
+		p, err := appVolumes.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeAppVolumes(tx *bolt.Tx, appId string, index int32) error {
+	volumesBkt := GetAppVolumesListBucket(tx, appId)
+	if volumesBkt == nil {
+		return nil
+	}
+
+	return volumesBkt.DeleteBucket(volumesKey(index))
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateAutoscalerBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyAutoscalers, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func WithAutoscalerBucket(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt := GetAutoscalerBucket(tx, id)
+	if bkt == nil {
+		return ErrAutoscalerUnknown
+	}
+
+	return fn(bkt)
+}
+
+func GetAutoscalerBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyAutoscalers, []byte(id))
+}
+
+func GetAutoscalersBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyAutoscalers)
+}
+
+func putAutoscaler(tx *bolt.Tx, autoscaler *types.Autoscaler) error {
+	return withCreateAutoscalerBucketIfNotExists(tx, autoscaler.AppId, func(bkt *bolt.Bucket) error {
+		p, err := autoscaler.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeAutoscaler(tx *bolt.Tx, appId string) error {
+	autoscalersBkt := GetAutoscalersBucket(tx)
+	if autoscalersBkt == nil {
+		return nil
+	}
+
+	return autoscalersBkt.DeleteBucket([]byte(appId))
+}
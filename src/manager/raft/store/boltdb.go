@@ -13,28 +13,47 @@ type BoltbDb struct {
 }
 
 var (
-	bucketKeyStorageVersion = []byte("v1")
-	bucketKeyApps           = []byte("apps")
-	bucketKeyFramework      = []byte("framework")
-	bucketKeyTasks          = []byte("tasks")
-	bucketKeyVersions       = []byte("versions")
-	bucketKeySlots          = []byte("slots")
+	bucketKeyStorageVersion   = []byte("v1")
+	bucketKeyApps             = []byte("apps")
+	bucketKeyFramework        = []byte("framework")
+	bucketKeyTasks            = []byte("tasks")
+	bucketKeyVersions         = []byte("versions")
+	bucketKeySlots            = []byte("slots")
+	bucketKeyVolumes          = []byte("volumes")
+	bucketKeyJobs             = []byte("jobs")
+	bucketKeyCronJobs         = []byte("cronjobs")
+	bucketKeyQuotas           = []byte("quotas")
+	bucketKeyAutoscalers      = []byte("autoscalers")
+	bucketKeyScalingSchedules = []byte("scalingschedules")
+	bucketKeyVolumesDestroys  = []byte("volumesdestroys")
 
 	BucketKeyData = []byte("data")
 )
 
 var (
-	ErrAppUnknown              = errors.New("boltdb: app unknown")
-	ErrTaskUnknown             = errors.New("boltdb: task unknown")
-	ErrVersionUnknown          = errors.New("boltdb: version unknown")
-	ErrSlotUnknown             = errors.New("boltdb: slot unknow")
-	ErrNilStoreAction          = errors.New("boltdb: nil store action")
-	ErrUndefineStoreAction     = errors.New("boltdb: undefined store action")
-	ErrUndefineAppStoreAction  = errors.New("boltdb: undefined app store action")
-	ErrUndefineFrameworkAction = errors.New("boltdb: undefined framework store action")
-	ErrUndefineTaskAction      = errors.New("boltdb: undefined task store action")
-	ErrUndefineVersionAction   = errors.New("boltdb: undefined version store action")
-	ErrUndefineSlotAction      = errors.New("boltdb: undefined slot store action")
+	ErrAppUnknown                    = errors.New("boltdb: app unknown")
+	ErrTaskUnknown                   = errors.New("boltdb: task unknown")
+	ErrVersionUnknown                = errors.New("boltdb: version unknown")
+	ErrSlotUnknown                   = errors.New("boltdb: slot unknow")
+	ErrJobUnknown                    = errors.New("boltdb: job unknown")
+	ErrCronJobUnknown                = errors.New("boltdb: cron job unknown")
+	ErrQuotaUnknown                  = errors.New("boltdb: quota unknown")
+	ErrAutoscalerUnknown             = errors.New("boltdb: autoscaler unknown")
+	ErrScalingScheduleUnknown        = errors.New("boltdb: scaling schedule unknown")
+	ErrNilStoreAction                = errors.New("boltdb: nil store action")
+	ErrUndefineStoreAction           = errors.New("boltdb: undefined store action")
+	ErrUndefineAppStoreAction        = errors.New("boltdb: undefined app store action")
+	ErrUndefineFrameworkAction       = errors.New("boltdb: undefined framework store action")
+	ErrUndefineTaskAction            = errors.New("boltdb: undefined task store action")
+	ErrUndefineVersionAction         = errors.New("boltdb: undefined version store action")
+	ErrUndefineSlotAction            = errors.New("boltdb: undefined slot store action")
+	ErrUndefineJobAction             = errors.New("boltdb: undefined job store action")
+	ErrUndefineCronJobAction         = errors.New("boltdb: undefined cron job store action")
+	ErrUndefineQuotaAction           = errors.New("boltdb: undefined quota store action")
+	ErrUndefineAutoscalerAction      = errors.New("boltdb: undefined autoscaler store action")
+	ErrUndefineScalingScheduleAction = errors.New("boltdb: undefined scaling schedule store action")
+	ErrUndefineAppVolumesAction      = errors.New("boltdb: undefined app volumes store action")
+	ErrUndefineVolumesDestroyAction  = errors.New("boltdb: undefined volumes destroy store action")
 )
 
 func NewBoltbdStore(db *bolt.DB) (*BoltbDb, error) {
@@ -47,6 +66,30 @@ func NewBoltbdStore(db *bolt.DB) (*BoltbDb, error) {
 			return err
 		}
 
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyJobs); err != nil {
+			return err
+		}
+
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyCronJobs); err != nil {
+			return err
+		}
+
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyQuotas); err != nil {
+			return err
+		}
+
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyAutoscalers); err != nil {
+			return err
+		}
+
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyScalingSchedules); err != nil {
+			return err
+		}
+
+		if _, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyVolumesDestroys); err != nil {
+			return err
+		}
+
 		return nil
 
 	}); err != nil {
@@ -123,6 +166,20 @@ func doStoreAction(tx *bolt.Tx, action *types.StoreAction) error {
 		return doVersionStoreAction(tx, action.Action, action.GetVersion())
 	case *types.StoreAction_Slot:
 		return doSlotStoreAction(tx, action.Action, action.GetSlot())
+	case *types.StoreAction_Job:
+		return doJobStoreAction(tx, action.Action, action.GetJob())
+	case *types.StoreAction_CronJob:
+		return doCronJobStoreAction(tx, action.Action, action.GetCronJob())
+	case *types.StoreAction_Quota:
+		return doQuotaStoreAction(tx, action.Action, action.GetQuota())
+	case *types.StoreAction_Autoscaler:
+		return doAutoscalerStoreAction(tx, action.Action, action.GetAutoscaler())
+	case *types.StoreAction_ScalingSchedule:
+		return doScalingScheduleStoreAction(tx, action.Action, action.GetScalingSchedule())
+	case *types.StoreAction_AppVolumes:
+		return doAppVolumesStoreAction(tx, action.Action, action.GetAppVolumes())
+	case *types.StoreAction_VolumesDestroy:
+		return doVolumesDestroyStoreAction(tx, action.Action, action.GetVolumesDestroy())
 	default:
 		return ErrUndefineStoreAction
 	}
@@ -182,3 +239,80 @@ func doVersionStoreAction(tx *bolt.Tx, action types.StoreActionKind, version *ty
 		return ErrUndefineVersionAction
 	}
 }
+
+func doJobStoreAction(tx *bolt.Tx, action types.StoreActionKind, job *types.Job) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putJob(tx, job)
+	case types.StoreActionKindRemove:
+		return removeJob(tx, job.ID)
+	default:
+		return ErrUndefineJobAction
+	}
+}
+
+func doCronJobStoreAction(tx *bolt.Tx, action types.StoreActionKind, cronJob *types.CronJob) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putCronJob(tx, cronJob)
+	case types.StoreActionKindRemove:
+		return removeCronJob(tx, cronJob.ID)
+	default:
+		return ErrUndefineCronJobAction
+	}
+}
+
+func doQuotaStoreAction(tx *bolt.Tx, action types.StoreActionKind, quota *types.Quota) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putQuota(tx, quota)
+	case types.StoreActionKindRemove:
+		return removeQuota(tx, quota.RunAs)
+	default:
+		return ErrUndefineQuotaAction
+	}
+}
+
+func doAutoscalerStoreAction(tx *bolt.Tx, action types.StoreActionKind, autoscaler *types.Autoscaler) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putAutoscaler(tx, autoscaler)
+	case types.StoreActionKindRemove:
+		return removeAutoscaler(tx, autoscaler.AppId)
+	default:
+		return ErrUndefineAutoscalerAction
+	}
+}
+
+func doScalingScheduleStoreAction(tx *bolt.Tx, action types.StoreActionKind, scalingSchedule *types.ScalingSchedule) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putScalingSchedule(tx, scalingSchedule)
+	case types.StoreActionKindRemove:
+		return removeScalingSchedule(tx, scalingSchedule.ID)
+	default:
+		return ErrUndefineScalingScheduleAction
+	}
+}
+
+func doAppVolumesStoreAction(tx *bolt.Tx, action types.StoreActionKind, appVolumes *types.AppVolumes) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putAppVolumes(tx, appVolumes)
+	case types.StoreActionKindRemove:
+		return removeAppVolumes(tx, appVolumes.AppId, appVolumes.Index)
+	default:
+		return ErrUndefineAppVolumesAction
+	}
+}
+
+func doVolumesDestroyStoreAction(tx *bolt.Tx, action types.StoreActionKind, volumesDestroy *types.VolumesDestroy) error {
+	switch action {
+	case types.StoreActionKindCreate, types.StoreActionKindUpdate:
+		return putVolumesDestroy(tx, volumesDestroy)
+	case types.StoreActionKindRemove:
+		return removeVolumesDestroy(tx, volumesDestroy.Id)
+	default:
+		return ErrUndefineVolumesDestroyAction
+	}
+}
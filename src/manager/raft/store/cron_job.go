@@ -0,0 +1,53 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateCronJobBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyCronJobs, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func WithCronJobBucket(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt := GetCronJobBucket(tx, id)
+	if bkt == nil {
+		return ErrCronJobUnknown
+	}
+
+	return fn(bkt)
+}
+
+func GetCronJobBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyCronJobs, []byte(id))
+}
+
+func GetCronJobsBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyCronJobs)
+}
+
+func putCronJob(tx *bolt.Tx, cronJob *types.CronJob) error {
+	return withCreateCronJobBucketIfNotExists(tx, cronJob.ID, func(bkt *bolt.Bucket) error {
+		p, err := cronJob.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeCronJob(tx *bolt.Tx, cronJobId string) error {
+	cronJobsBkt := GetCronJobsBucket(tx)
+	if cronJobsBkt == nil {
+		return nil
+	}
+
+	return cronJobsBkt.DeleteBucket([]byte(cronJobId))
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateJobBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyJobs, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func WithJobBucket(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt := GetJobBucket(tx, id)
+	if bkt == nil {
+		return ErrJobUnknown
+	}
+
+	return fn(bkt)
+}
+
+func GetJobBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyJobs, []byte(id))
+}
+
+func GetJobsBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyJobs)
+}
+
+func putJob(tx *bolt.Tx, job *types.Job) error {
+	return withCreateJobBucketIfNotExists(tx, job.ID, func(bkt *bolt.Bucket) error {
+		p, err := job.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeJob(tx *bolt.Tx, jobId string) error {
+	jobsBkt := GetJobsBucket(tx)
+	if jobsBkt == nil {
+		return nil
+	}
+
+	return jobsBkt.DeleteBucket([]byte(jobId))
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateQuotaBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyQuotas, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func WithQuotaBucket(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt := GetQuotaBucket(tx, id)
+	if bkt == nil {
+		return ErrQuotaUnknown
+	}
+
+	return fn(bkt)
+}
+
+func GetQuotaBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyQuotas, []byte(id))
+}
+
+func GetQuotasBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyQuotas)
+}
+
+func putQuota(tx *bolt.Tx, quota *types.Quota) error {
+	return withCreateQuotaBucketIfNotExists(tx, quota.RunAs, func(bkt *bolt.Bucket) error {
+		p, err := quota.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeQuota(tx *bolt.Tx, runAs string) error {
+	quotasBkt := GetQuotasBucket(tx)
+	if quotasBkt == nil {
+		return nil
+	}
+
+	return quotasBkt.DeleteBucket([]byte(runAs))
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateScalingScheduleBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyScalingSchedules, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func WithScalingScheduleBucket(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt := GetScalingScheduleBucket(tx, id)
+	if bkt == nil {
+		return ErrScalingScheduleUnknown
+	}
+
+	return fn(bkt)
+}
+
+func GetScalingScheduleBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyScalingSchedules, []byte(id))
+}
+
+func GetScalingSchedulesBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyScalingSchedules)
+}
+
+func putScalingSchedule(tx *bolt.Tx, scalingSchedule *types.ScalingSchedule) error {
+	return withCreateScalingScheduleBucketIfNotExists(tx, scalingSchedule.ID, func(bkt *bolt.Bucket) error {
+		p, err := scalingSchedule.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeScalingSchedule(tx *bolt.Tx, scheduleId string) error {
+	scalingSchedulesBkt := GetScalingSchedulesBucket(tx)
+	if scalingSchedulesBkt == nil {
+		return nil
+	}
+
+	return scalingSchedulesBkt.DeleteBucket([]byte(scheduleId))
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"github.com/Dataman-Cloud/swan/src/manager/raft/types"
+
+	"github.com/boltdb/bolt"
+)
+
+func withCreateVolumesDestroyBucketIfNotExists(tx *bolt.Tx, id string, fn func(bkt *bolt.Bucket) error) error {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyStorageVersion, bucketKeyVolumesDestroys, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	return fn(bkt)
+}
+
+func GetVolumesDestroyBucket(tx *bolt.Tx, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyVolumesDestroys, []byte(id))
+}
+
+func GetVolumesDestroysBucket(tx *bolt.Tx) *bolt.Bucket {
+	return getBucket(tx, bucketKeyStorageVersion, bucketKeyVolumesDestroys)
+}
+
+func putVolumesDestroy(tx *bolt.Tx, volumesDestroy *types.VolumesDestroy) error {
+	return withCreateVolumesDestroyBucketIfNotExists(tx, volumesDestroy.Id, func(bkt *bolt.Bucket) error {
+		p, err := volumesDestroy.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(BucketKeyData, p)
+	})
+}
+
+func removeVolumesDestroy(tx *bolt.Tx, id string) error {
+	volumesDestroysBkt := GetVolumesDestroysBucket(tx)
+	if volumesDestroysBkt == nil {
+		return nil
+	}
+
+	return volumesDestroysBkt.DeleteBucket([]byte(id))
+}
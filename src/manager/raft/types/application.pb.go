@@ -17,13 +17,23 @@
 		Parameter
 		PortMapping
 		Volume
+		PersistentVolume
 		KillPolicy
 		UpdatePolicy
 		HealthCheck
+		ReadinessCheck
 		Command
 		Slot
+		SlotVolume
+		AppVolumes
+		VolumesDestroy
 		RestartPolicy
 		Task
+		Job
+		CronJob
+		Quota
+		Autoscaler
+		ScalingSchedule
 		InternalRaftRequest
 		StoreAction
 		Framework
@@ -65,6 +75,9 @@ type Application struct {
 	State           string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
 	CreatedAt       int64    `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt       int64    `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	StateMessage    string   `protobuf:"bytes,9,opt,name=stateMessage,proto3" json:"stateMessage,omitempty"`
+	UpdatePaused    bool     `protobuf:"varint,10,opt,name=updatePaused,proto3" json:"updatePaused,omitempty"`
+	CanaryWeight    float64  `protobuf:"fixed64,11,opt,name=canaryWeight,proto3" json:"canaryWeight,omitempty"`
 }
 
 func (m *Application) Reset()                    { *m = Application{} }
@@ -73,25 +86,35 @@ func (*Application) ProtoMessage()               {}
 func (*Application) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{0} }
 
 type Version struct {
-	ID                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PerviousVersionID string            `protobuf:"bytes,2,opt,name=perviousVersionID,proto3" json:"perviousVersionID,omitempty"`
-	Command           string            `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
-	Cpus              float64           `protobuf:"fixed64,4,opt,name=cpus,proto3" json:"cpus,omitempty"`
-	Mem               float64           `protobuf:"fixed64,5,opt,name=mem,proto3" json:"mem,omitempty"`
-	Disk              float64           `protobuf:"fixed64,6,opt,name=disk,proto3" json:"disk,omitempty"`
-	Instances         int32             `protobuf:"varint,7,opt,name=instances,proto3" json:"instances,omitempty"`
-	RunAs             string            `protobuf:"bytes,8,opt,name=runAs,proto3" json:"runAs,omitempty"`
-	Container         *Container        `protobuf:"bytes,9,opt,name=container" json:"container,omitempty"`
-	Labels            map[string]string `protobuf:"bytes,10,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	HealthChecks      []*HealthCheck    `protobuf:"bytes,11,rep,name=healthChecks" json:"healthChecks,omitempty"`
-	Env               map[string]string `protobuf:"bytes,12,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	KillPolicy        *KillPolicy       `protobuf:"bytes,13,opt,name=killPolicy" json:"killPolicy,omitempty"`
-	UpdatePolicy      *UpdatePolicy     `protobuf:"bytes,14,opt,name=updatePolicy" json:"updatePolicy,omitempty"`
-	Constraints       []string          `protobuf:"bytes,15,rep,name=constraints" json:"constraints,omitempty"`
-	Uris              []string          `protobuf:"bytes,16,rep,name=uris" json:"uris,omitempty"`
-	Ip                []string          `protobuf:"bytes,17,rep,name=ip" json:"ip,omitempty"`
-	Mode              string            `protobuf:"bytes,18,opt,name=mode,proto3" json:"mode,omitempty"`
-	AppId             string            `protobuf:"bytes,19,opt,name=appId,proto3" json:"appId,omitempty"`
+	ID                      string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PerviousVersionID       string            `protobuf:"bytes,2,opt,name=perviousVersionID,proto3" json:"perviousVersionID,omitempty"`
+	Command                 string            `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Cpus                    float64           `protobuf:"fixed64,4,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Mem                     float64           `protobuf:"fixed64,5,opt,name=mem,proto3" json:"mem,omitempty"`
+	Disk                    float64           `protobuf:"fixed64,6,opt,name=disk,proto3" json:"disk,omitempty"`
+	Instances               int32             `protobuf:"varint,7,opt,name=instances,proto3" json:"instances,omitempty"`
+	RunAs                   string            `protobuf:"bytes,8,opt,name=runAs,proto3" json:"runAs,omitempty"`
+	Container               *Container        `protobuf:"bytes,9,opt,name=container" json:"container,omitempty"`
+	Labels                  map[string]string `protobuf:"bytes,10,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HealthChecks            []*HealthCheck    `protobuf:"bytes,11,rep,name=healthChecks" json:"healthChecks,omitempty"`
+	Env                     map[string]string `protobuf:"bytes,12,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KillPolicy              *KillPolicy       `protobuf:"bytes,13,opt,name=killPolicy" json:"killPolicy,omitempty"`
+	UpdatePolicy            *UpdatePolicy     `protobuf:"bytes,14,opt,name=updatePolicy" json:"updatePolicy,omitempty"`
+	Constraints             []string          `protobuf:"bytes,15,rep,name=constraints" json:"constraints,omitempty"`
+	Uris                    []string          `protobuf:"bytes,16,rep,name=uris" json:"uris,omitempty"`
+	Ip                      []string          `protobuf:"bytes,17,rep,name=ip" json:"ip,omitempty"`
+	Mode                    string            `protobuf:"bytes,18,opt,name=mode,proto3" json:"mode,omitempty"`
+	AppId                   string            `protobuf:"bytes,19,opt,name=appId,proto3" json:"appId,omitempty"`
+	PlacementStrategy       string            `protobuf:"bytes,20,opt,name=placementStrategy,proto3" json:"placementStrategy,omitempty"`
+	BackoffSeconds          float64           `protobuf:"fixed64,21,opt,name=backoffSeconds,proto3" json:"backoffSeconds,omitempty"`
+	BackoffFactor           float64           `protobuf:"fixed64,22,opt,name=backoffFactor,proto3" json:"backoffFactor,omitempty"`
+	MaxLaunchDelaySeconds   float64           `protobuf:"fixed64,23,opt,name=maxLaunchDelaySeconds,proto3" json:"maxLaunchDelaySeconds,omitempty"`
+	MaxRestarts             int32             `protobuf:"varint,24,opt,name=maxRestarts,proto3" json:"maxRestarts,omitempty"`
+	MaxConsecutiveUnhealthy int32             `protobuf:"varint,25,opt,name=maxConsecutiveUnhealthy,proto3" json:"maxConsecutiveUnhealthy,omitempty"`
+	MaxUnhealthySeconds     float64           `protobuf:"fixed64,26,opt,name=maxUnhealthySeconds,proto3" json:"maxUnhealthySeconds,omitempty"`
+	ReadinessCheck          *ReadinessCheck   `protobuf:"bytes,27,opt,name=readinessCheck" json:"readinessCheck,omitempty"`
+	Args                    []string          `protobuf:"bytes,28,rep,name=args" json:"args,omitempty"`
+	Priority                int32             `protobuf:"varint,29,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *Version) Reset()                    { *m = Version{} }
@@ -100,9 +123,10 @@ func (*Version) ProtoMessage()               {}
 func (*Version) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{1} }
 
 type Container struct {
-	Type    string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Docker  *Docker   `protobuf:"bytes,2,opt,name=docker" json:"docker,omitempty"`
-	Volumes []*Volume `protobuf:"bytes,3,rep,name=volumes" json:"volumes,omitempty"`
+	Type       string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Docker     *Docker   `protobuf:"bytes,2,opt,name=docker" json:"docker,omitempty"`
+	Volumes    []*Volume `protobuf:"bytes,3,rep,name=volumes" json:"volumes,omitempty"`
+	Entrypoint string    `protobuf:"bytes,4,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
@@ -146,9 +170,10 @@ func (*PortMapping) ProtoMessage()               {}
 func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{5} }
 
 type Volume struct {
-	ContainerPath string `protobuf:"bytes,1,opt,name=containerPath,proto3" json:"containerPath,omitempty"`
-	HostPath      string `protobuf:"bytes,2,opt,name=hostPath,proto3" json:"hostPath,omitempty"`
-	Mode          string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	ContainerPath string            `protobuf:"bytes,1,opt,name=containerPath,proto3" json:"containerPath,omitempty"`
+	HostPath      string            `protobuf:"bytes,2,opt,name=hostPath,proto3" json:"hostPath,omitempty"`
+	Mode          string            `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Persistent    *PersistentVolume `protobuf:"bytes,4,opt,name=persistent" json:"persistent,omitempty"`
 }
 
 func (m *Volume) Reset()                    { *m = Volume{} }
@@ -156,26 +181,38 @@ func (m *Volume) String() string            { return proto.CompactTextString(m)
 func (*Volume) ProtoMessage()               {}
 func (*Volume) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{6} }
 
+type PersistentVolume struct {
+	Size_ float64 `protobuf:"fixed64,1,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *PersistentVolume) Reset()                    { *m = PersistentVolume{} }
+func (m *PersistentVolume) String() string            { return proto.CompactTextString(m) }
+func (*PersistentVolume) ProtoMessage()               {}
+func (*PersistentVolume) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{7} }
+
 type KillPolicy struct {
-	Duration int64 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Duration      int64 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	DrainDuration int64 `protobuf:"varint,2,opt,name=drainDuration,proto3" json:"drainDuration,omitempty"`
 }
 
 func (m *KillPolicy) Reset()                    { *m = KillPolicy{} }
 func (m *KillPolicy) String() string            { return proto.CompactTextString(m) }
 func (*KillPolicy) ProtoMessage()               {}
-func (*KillPolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{7} }
+func (*KillPolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{8} }
 
 type UpdatePolicy struct {
 	UpdateDelay  int32  `protobuf:"varint,1,opt,name=updateDelay,proto3" json:"updateDelay,omitempty"`
 	MaxRetries   int32  `protobuf:"varint,2,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
 	MaxFailovers int32  `protobuf:"varint,3,opt,name=maxFailovers,proto3" json:"maxFailovers,omitempty"`
 	Action       string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Mode         string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	BatchSize    string `protobuf:"bytes,6,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
 }
 
 func (m *UpdatePolicy) Reset()                    { *m = UpdatePolicy{} }
 func (m *UpdatePolicy) String() string            { return proto.CompactTextString(m) }
 func (*UpdatePolicy) ProtoMessage()               {}
-func (*UpdatePolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{8} }
+func (*UpdatePolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{9} }
 
 type HealthCheck struct {
 	ID                  string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -195,7 +232,21 @@ type HealthCheck struct {
 func (m *HealthCheck) Reset()                    { *m = HealthCheck{} }
 func (m *HealthCheck) String() string            { return proto.CompactTextString(m) }
 func (*HealthCheck) ProtoMessage()               {}
-func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{9} }
+func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{10} }
+
+type ReadinessCheck struct {
+	Protocol        string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	PortName        string  `protobuf:"bytes,2,opt,name=portName,proto3" json:"portName,omitempty"`
+	Path            string  `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	IntervalSeconds float64 `protobuf:"fixed64,4,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`
+	TimeoutSeconds  float64 `protobuf:"fixed64,5,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	Port            int32   `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *ReadinessCheck) Reset()                    { *m = ReadinessCheck{} }
+func (m *ReadinessCheck) String() string            { return proto.CompactTextString(m) }
+func (*ReadinessCheck) ProtoMessage()               {}
+func (*ReadinessCheck) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{11} }
 
 type Command struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -204,7 +255,7 @@ type Command struct {
 func (m *Command) Reset()                    { *m = Command{} }
 func (m *Command) String() string            { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()               {}
-func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{10} }
+func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{12} }
 
 type Slot struct {
 	Index                int32          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -217,43 +268,182 @@ type Slot struct {
 	Healthy              bool           `protobuf:"varint,8,opt,name=healthy,proto3" json:"healthy,omitempty"`
 	CurrentTask          *Task          `protobuf:"bytes,9,opt,name=CurrentTask" json:"CurrentTask,omitempty"`
 	RestartPolicy        *RestartPolicy `protobuf:"bytes,10,opt,name=restartPolicy" json:"restartPolicy,omitempty"`
+	UpdateFailures       int32          `protobuf:"varint,11,opt,name=updateFailures,proto3" json:"updateFailures,omitempty"`
+	Ready                bool           `protobuf:"varint,12,opt,name=ready,proto3" json:"ready,omitempty"`
+	Volumes              []*SlotVolume  `protobuf:"bytes,13,rep,name=volumes" json:"volumes,omitempty"`
 }
 
 func (m *Slot) Reset()                    { *m = Slot{} }
 func (m *Slot) String() string            { return proto.CompactTextString(m) }
 func (*Slot) ProtoMessage()               {}
-func (*Slot) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{11} }
+func (*Slot) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{13} }
+
+type SlotVolume struct {
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContainerPath string  `protobuf:"bytes,2,opt,name=containerPath,proto3" json:"containerPath,omitempty"`
+	Size_         float64 `protobuf:"fixed64,3,opt,name=size,proto3" json:"size,omitempty"`
+	AgentId       string  `protobuf:"bytes,4,opt,name=agentId,proto3" json:"agentId,omitempty"`
+	Pending       bool    `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *SlotVolume) Reset()                    { *m = SlotVolume{} }
+func (m *SlotVolume) String() string            { return proto.CompactTextString(m) }
+func (*SlotVolume) ProtoMessage()               {}
+func (*SlotVolume) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{14} }
+
+// persistent volumes created for the slot index of the app, kept apart from
+// the slot so they survive scaling down
+type AppVolumes struct {
+	AppId   string        `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Index   int32         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Volumes []*SlotVolume `protobuf:"bytes,3,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *AppVolumes) Reset()                    { *m = AppVolumes{} }
+func (m *AppVolumes) String() string            { return proto.CompactTextString(m) }
+func (*AppVolumes) ProtoMessage()               {}
+func (*AppVolumes) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{15} }
+
+// persistent volumes of a deleted app to destroy on an agent, once the tasks
+// of the app are gone
+type VolumesDestroy struct {
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId     string        `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	Role      string        `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AgentId   string        `protobuf:"bytes,4,opt,name=agentId,proto3" json:"agentId,omitempty"`
+	Volumes   []*SlotVolume `protobuf:"bytes,5,rep,name=volumes" json:"volumes,omitempty"`
+	CreatedAt int64         `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *VolumesDestroy) Reset()                    { *m = VolumesDestroy{} }
+func (m *VolumesDestroy) String() string            { return proto.CompactTextString(m) }
+func (*VolumesDestroy) ProtoMessage()               {}
+func (*VolumesDestroy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{16} }
 
 type RestartPolicy struct {
+	Restarts int32 `protobuf:"varint,1,opt,name=restarts,proto3" json:"restarts,omitempty"`
 }
 
 func (m *RestartPolicy) Reset()                    { *m = RestartPolicy{} }
 func (m *RestartPolicy) String() string            { return proto.CompactTextString(m) }
 func (*RestartPolicy) ProtoMessage()               {}
-func (*RestartPolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{12} }
+func (*RestartPolicy) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{17} }
 
 type Task struct {
-	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskInfoId    string   `protobuf:"bytes,2,opt,name=taskInfoId,proto3" json:"taskInfoId,omitempty"`
-	AppId         string   `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"`
-	VersionId     string   `protobuf:"bytes,4,opt,name=versionId,proto3" json:"versionId,omitempty"`
-	SlotId        string   `protobuf:"bytes,5,opt,name=slotId,proto3" json:"slotId,omitempty"`
-	State         string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	Stdout        string   `protobuf:"bytes,7,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	Stderr        string   `protobuf:"bytes,8,opt,name=stderr,proto3" json:"stderr,omitempty"`
-	HostPorts     []uint64 `protobuf:"varint,9,rep,packed,name=hostPorts" json:"hostPorts,omitempty"`
-	OfferId       string   `protobuf:"bytes,10,opt,name=offerId,proto3" json:"offerId,omitempty"`
-	AgentId       string   `protobuf:"bytes,11,opt,name=agentId,proto3" json:"agentId,omitempty"`
-	Ip            string   `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
-	AgentHostName string   `protobuf:"bytes,13,opt,name=agentHostName,proto3" json:"agentHostName,omitempty"`
-	Reason        string   `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
-	CreatedAt     int64    `protobuf:"varint,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Id              string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskInfoId      string            `protobuf:"bytes,2,opt,name=taskInfoId,proto3" json:"taskInfoId,omitempty"`
+	AppId           string            `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"`
+	VersionId       string            `protobuf:"bytes,4,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	SlotId          string            `protobuf:"bytes,5,opt,name=slotId,proto3" json:"slotId,omitempty"`
+	State           string            `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Stdout          string            `protobuf:"bytes,7,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr          string            `protobuf:"bytes,8,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	HostPorts       []uint64          `protobuf:"varint,9,rep,packed,name=hostPorts" json:"hostPorts,omitempty"`
+	OfferId         string            `protobuf:"bytes,10,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	AgentId         string            `protobuf:"bytes,11,opt,name=agentId,proto3" json:"agentId,omitempty"`
+	Ip              string            `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
+	AgentHostName   string            `protobuf:"bytes,13,opt,name=agentHostName,proto3" json:"agentHostName,omitempty"`
+	Reason          string            `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt       int64             `protobuf:"varint,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	AgentAttributes map[string]string `protobuf:"bytes,16,rep,name=agentAttributes" json:"agentAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (m *Task) String() string            { return proto.CompactTextString(m) }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{13} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{18} }
+
+type Job struct {
+	ID                    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Completions           int32  `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
+	Parallelism           int32  `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	BackoffLimit          int32  `protobuf:"varint,4,opt,name=backoffLimit,proto3" json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds int64  `protobuf:"varint,5,opt,name=activeDeadlineSeconds,proto3" json:"activeDeadlineSeconds,omitempty"`
+	State                 string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Message               string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Succeeded             int32  `protobuf:"varint,8,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed                int32  `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	StartedAt             int64  `protobuf:"varint,10,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	CompletedAt           int64  `protobuf:"varint,11,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+}
+
+func (m *Job) Reset()                    { *m = Job{} }
+func (m *Job) String() string            { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()               {}
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{19} }
+
+type CronJob struct {
+	ID                      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule                string   `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	TimeZone                string   `protobuf:"bytes,3,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	ConcurrencyPolicy       string   `protobuf:"bytes,4,opt,name=concurrencyPolicy,proto3" json:"concurrencyPolicy,omitempty"`
+	HistoryLimit            int32    `protobuf:"varint,5,opt,name=historyLimit,proto3" json:"historyLimit,omitempty"`
+	StartingDeadlineSeconds int64    `protobuf:"varint,6,opt,name=startingDeadlineSeconds,proto3" json:"startingDeadlineSeconds,omitempty"`
+	Completions             int32    `protobuf:"varint,7,opt,name=completions,proto3" json:"completions,omitempty"`
+	Parallelism             int32    `protobuf:"varint,8,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	BackoffLimit            int32    `protobuf:"varint,9,opt,name=backoffLimit,proto3" json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds   int64    `protobuf:"varint,10,opt,name=activeDeadlineSeconds,proto3" json:"activeDeadlineSeconds,omitempty"`
+	Template                *Version `protobuf:"bytes,11,opt,name=template" json:"template,omitempty"`
+	CreatedAt               int64    `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastScheduledAt         int64    `protobuf:"varint,13,opt,name=lastScheduledAt,proto3" json:"lastScheduledAt,omitempty"`
+	Runs                    []string `protobuf:"bytes,14,rep,name=runs" json:"runs,omitempty"`
+}
+
+func (m *CronJob) Reset()                    { *m = CronJob{} }
+func (m *CronJob) String() string            { return proto.CompactTextString(m) }
+func (*CronJob) ProtoMessage()               {}
+func (*CronJob) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{20} }
+
+type Quota struct {
+	RunAs     string  `protobuf:"bytes,1,opt,name=runAs,proto3" json:"runAs,omitempty"`
+	Cpus      float64 `protobuf:"fixed64,2,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Mem       float64 `protobuf:"fixed64,3,opt,name=mem,proto3" json:"mem,omitempty"`
+	Disk      float64 `protobuf:"fixed64,4,opt,name=disk,proto3" json:"disk,omitempty"`
+	Instances int32   `protobuf:"varint,5,opt,name=instances,proto3" json:"instances,omitempty"`
+	Ips       int32   `protobuf:"varint,6,opt,name=ips,proto3" json:"ips,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (m *Quota) String() string            { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{21} }
+
+type Autoscaler struct {
+	AppId                    string  `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	MinInstances             int32   `protobuf:"varint,2,opt,name=minInstances,proto3" json:"minInstances,omitempty"`
+	MaxInstances             int32   `protobuf:"varint,3,opt,name=maxInstances,proto3" json:"maxInstances,omitempty"`
+	Metric                   string  `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Target                   float64 `protobuf:"fixed64,5,opt,name=target,proto3" json:"target,omitempty"`
+	Query                    string  `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	IntervalSeconds          int64   `protobuf:"varint,7,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`
+	ScaleUpCooldownSeconds   int64   `protobuf:"varint,8,opt,name=scaleUpCooldownSeconds,proto3" json:"scaleUpCooldownSeconds,omitempty"`
+	ScaleDownCooldownSeconds int64   `protobuf:"varint,9,opt,name=scaleDownCooldownSeconds,proto3" json:"scaleDownCooldownSeconds,omitempty"`
+	CreatedAt                int64   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastScaledAt             int64   `protobuf:"varint,11,opt,name=lastScaledAt,proto3" json:"lastScaledAt,omitempty"`
+}
+
+func (m *Autoscaler) Reset()                    { *m = Autoscaler{} }
+func (m *Autoscaler) String() string            { return proto.CompactTextString(m) }
+func (*Autoscaler) ProtoMessage()               {}
+func (*Autoscaler) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{22} }
+
+type ScalingSchedule struct {
+	ID              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId           string `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	Schedule        string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	TimeZone        string `protobuf:"bytes,4,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	Instances       int32  `protobuf:"varint,5,opt,name=instances,proto3" json:"instances,omitempty"`
+	MinInstances    int32  `protobuf:"varint,6,opt,name=minInstances,proto3" json:"minInstances,omitempty"`
+	MaxInstances    int32  `protobuf:"varint,7,opt,name=maxInstances,proto3" json:"maxInstances,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastScheduledAt int64  `protobuf:"varint,9,opt,name=lastScheduledAt,proto3" json:"lastScheduledAt,omitempty"`
+	LastError       string `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+}
+
+func (m *ScalingSchedule) Reset()                    { *m = ScalingSchedule{} }
+func (m *ScalingSchedule) String() string            { return proto.CompactTextString(m) }
+func (*ScalingSchedule) ProtoMessage()               {}
+func (*ScalingSchedule) Descriptor() ([]byte, []int) { return fileDescriptorApplication, []int{23} }
 
 func init() {
 	proto.RegisterType((*Application)(nil), "types.Application")
@@ -263,13 +453,23 @@ func init() {
 	proto.RegisterType((*Parameter)(nil), "types.Parameter")
 	proto.RegisterType((*PortMapping)(nil), "types.PortMapping")
 	proto.RegisterType((*Volume)(nil), "types.Volume")
+	proto.RegisterType((*PersistentVolume)(nil), "types.PersistentVolume")
 	proto.RegisterType((*KillPolicy)(nil), "types.KillPolicy")
 	proto.RegisterType((*UpdatePolicy)(nil), "types.UpdatePolicy")
 	proto.RegisterType((*HealthCheck)(nil), "types.HealthCheck")
+	proto.RegisterType((*ReadinessCheck)(nil), "types.ReadinessCheck")
 	proto.RegisterType((*Command)(nil), "types.Command")
 	proto.RegisterType((*Slot)(nil), "types.Slot")
+	proto.RegisterType((*SlotVolume)(nil), "types.SlotVolume")
+	proto.RegisterType((*AppVolumes)(nil), "types.AppVolumes")
+	proto.RegisterType((*VolumesDestroy)(nil), "types.VolumesDestroy")
 	proto.RegisterType((*RestartPolicy)(nil), "types.RestartPolicy")
 	proto.RegisterType((*Task)(nil), "types.Task")
+	proto.RegisterType((*Job)(nil), "types.Job")
+	proto.RegisterType((*CronJob)(nil), "types.CronJob")
+	proto.RegisterType((*Quota)(nil), "types.Quota")
+	proto.RegisterType((*Autoscaler)(nil), "types.Autoscaler")
+	proto.RegisterType((*ScalingSchedule)(nil), "types.ScalingSchedule")
 }
 func (this *Application) VerboseEqual(that interface{}) error {
 	if that == nil {
@@ -320,6 +520,15 @@ func (this *Application) VerboseEqual(that interface{}) error {
 	if this.UpdatedAt != that1.UpdatedAt {
 		return fmt.Errorf("UpdatedAt this(%v) Not Equal that(%v)", this.UpdatedAt, that1.UpdatedAt)
 	}
+	if this.StateMessage != that1.StateMessage {
+		return fmt.Errorf("StateMessage this(%v) Not Equal that(%v)", this.StateMessage, that1.StateMessage)
+	}
+	if this.UpdatePaused != that1.UpdatePaused {
+		return fmt.Errorf("UpdatePaused this(%v) Not Equal that(%v)", this.UpdatePaused, that1.UpdatePaused)
+	}
+	if this.CanaryWeight != that1.CanaryWeight {
+		return fmt.Errorf("CanaryWeight this(%v) Not Equal that(%v)", this.CanaryWeight, that1.CanaryWeight)
+	}
 	return nil
 }
 func (this *Application) Equal(that interface{}) bool {
@@ -371,6 +580,15 @@ func (this *Application) Equal(that interface{}) bool {
 	if this.UpdatedAt != that1.UpdatedAt {
 		return false
 	}
+	if this.StateMessage != that1.StateMessage {
+		return false
+	}
+	if this.UpdatePaused != that1.UpdatePaused {
+		return false
+	}
+	if this.CanaryWeight != that1.CanaryWeight {
+		return false
+	}
 	return true
 }
 func (this *Version) VerboseEqual(that interface{}) error {
@@ -485,6 +703,41 @@ func (this *Version) VerboseEqual(that interface{}) error {
 	if this.AppId != that1.AppId {
 		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
 	}
+	if this.PlacementStrategy != that1.PlacementStrategy {
+		return fmt.Errorf("PlacementStrategy this(%v) Not Equal that(%v)", this.PlacementStrategy, that1.PlacementStrategy)
+	}
+	if this.BackoffSeconds != that1.BackoffSeconds {
+		return fmt.Errorf("BackoffSeconds this(%v) Not Equal that(%v)", this.BackoffSeconds, that1.BackoffSeconds)
+	}
+	if this.BackoffFactor != that1.BackoffFactor {
+		return fmt.Errorf("BackoffFactor this(%v) Not Equal that(%v)", this.BackoffFactor, that1.BackoffFactor)
+	}
+	if this.MaxLaunchDelaySeconds != that1.MaxLaunchDelaySeconds {
+		return fmt.Errorf("MaxLaunchDelaySeconds this(%v) Not Equal that(%v)", this.MaxLaunchDelaySeconds, that1.MaxLaunchDelaySeconds)
+	}
+	if this.MaxRestarts != that1.MaxRestarts {
+		return fmt.Errorf("MaxRestarts this(%v) Not Equal that(%v)", this.MaxRestarts, that1.MaxRestarts)
+	}
+	if this.MaxConsecutiveUnhealthy != that1.MaxConsecutiveUnhealthy {
+		return fmt.Errorf("MaxConsecutiveUnhealthy this(%v) Not Equal that(%v)", this.MaxConsecutiveUnhealthy, that1.MaxConsecutiveUnhealthy)
+	}
+	if this.MaxUnhealthySeconds != that1.MaxUnhealthySeconds {
+		return fmt.Errorf("MaxUnhealthySeconds this(%v) Not Equal that(%v)", this.MaxUnhealthySeconds, that1.MaxUnhealthySeconds)
+	}
+	if !this.ReadinessCheck.Equal(that1.ReadinessCheck) {
+		return fmt.Errorf("ReadinessCheck this(%v) Not Equal that(%v)", this.ReadinessCheck, that1.ReadinessCheck)
+	}
+	if len(this.Args) != len(that1.Args) {
+		return fmt.Errorf("Args this(%v) Not Equal that(%v)", len(this.Args), len(that1.Args))
+	}
+	for i := range this.Args {
+		if this.Args[i] != that1.Args[i] {
+			return fmt.Errorf("Args this[%v](%v) Not Equal that[%v](%v)", i, this.Args[i], i, that1.Args[i])
+		}
+	}
+	if this.Priority != that1.Priority {
+		return fmt.Errorf("Priority this(%v) Not Equal that(%v)", this.Priority, that1.Priority)
+	}
 	return nil
 }
 func (this *Version) Equal(that interface{}) bool {
@@ -599,6 +852,41 @@ func (this *Version) Equal(that interface{}) bool {
 	if this.AppId != that1.AppId {
 		return false
 	}
+	if this.PlacementStrategy != that1.PlacementStrategy {
+		return false
+	}
+	if this.BackoffSeconds != that1.BackoffSeconds {
+		return false
+	}
+	if this.BackoffFactor != that1.BackoffFactor {
+		return false
+	}
+	if this.MaxLaunchDelaySeconds != that1.MaxLaunchDelaySeconds {
+		return false
+	}
+	if this.MaxRestarts != that1.MaxRestarts {
+		return false
+	}
+	if this.MaxConsecutiveUnhealthy != that1.MaxConsecutiveUnhealthy {
+		return false
+	}
+	if this.MaxUnhealthySeconds != that1.MaxUnhealthySeconds {
+		return false
+	}
+	if !this.ReadinessCheck.Equal(that1.ReadinessCheck) {
+		return false
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if this.Args[i] != that1.Args[i] {
+			return false
+		}
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *Container) VerboseEqual(that interface{}) error {
@@ -640,6 +928,9 @@ func (this *Container) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Volumes this[%v](%v) Not Equal that[%v](%v)", i, this.Volumes[i], i, that1.Volumes[i])
 		}
 	}
+	if this.Entrypoint != that1.Entrypoint {
+		return fmt.Errorf("Entrypoint this(%v) Not Equal that(%v)", this.Entrypoint, that1.Entrypoint)
+	}
 	return nil
 }
 func (this *Container) Equal(that interface{}) bool {
@@ -681,6 +972,9 @@ func (this *Container) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Entrypoint != that1.Entrypoint {
+		return false
+	}
 	return true
 }
 func (this *Docker) VerboseEqual(that interface{}) error {
@@ -965,6 +1259,9 @@ func (this *Volume) VerboseEqual(that interface{}) error {
 	if this.Mode != that1.Mode {
 		return fmt.Errorf("Mode this(%v) Not Equal that(%v)", this.Mode, that1.Mode)
 	}
+	if !this.Persistent.Equal(that1.Persistent) {
+		return fmt.Errorf("Persistent this(%v) Not Equal that(%v)", this.Persistent, that1.Persistent)
+	}
 	return nil
 }
 func (this *Volume) Equal(that interface{}) bool {
@@ -1001,6 +1298,69 @@ func (this *Volume) Equal(that interface{}) bool {
 	if this.Mode != that1.Mode {
 		return false
 	}
+	if !this.Persistent.Equal(that1.Persistent) {
+		return false
+	}
+	return true
+}
+func (this *PersistentVolume) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PersistentVolume)
+	if !ok {
+		that2, ok := that.(PersistentVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PersistentVolume")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PersistentVolume but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PersistentVolume but is not nil && this == nil")
+	}
+	if this.Size_ != that1.Size_ {
+		return fmt.Errorf("Size_ this(%v) Not Equal that(%v)", this.Size_, that1.Size_)
+	}
+	return nil
+}
+func (this *PersistentVolume) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PersistentVolume)
+	if !ok {
+		that2, ok := that.(PersistentVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
 	return true
 }
 func (this *KillPolicy) VerboseEqual(that interface{}) error {
@@ -1031,6 +1391,9 @@ func (this *KillPolicy) VerboseEqual(that interface{}) error {
 	if this.Duration != that1.Duration {
 		return fmt.Errorf("Duration this(%v) Not Equal that(%v)", this.Duration, that1.Duration)
 	}
+	if this.DrainDuration != that1.DrainDuration {
+		return fmt.Errorf("DrainDuration this(%v) Not Equal that(%v)", this.DrainDuration, that1.DrainDuration)
+	}
 	return nil
 }
 func (this *KillPolicy) Equal(that interface{}) bool {
@@ -1061,6 +1424,9 @@ func (this *KillPolicy) Equal(that interface{}) bool {
 	if this.Duration != that1.Duration {
 		return false
 	}
+	if this.DrainDuration != that1.DrainDuration {
+		return false
+	}
 	return true
 }
 func (this *UpdatePolicy) VerboseEqual(that interface{}) error {
@@ -1100,6 +1466,12 @@ func (this *UpdatePolicy) VerboseEqual(that interface{}) error {
 	if this.Action != that1.Action {
 		return fmt.Errorf("Action this(%v) Not Equal that(%v)", this.Action, that1.Action)
 	}
+	if this.Mode != that1.Mode {
+		return fmt.Errorf("Mode this(%v) Not Equal that(%v)", this.Mode, that1.Mode)
+	}
+	if this.BatchSize != that1.BatchSize {
+		return fmt.Errorf("BatchSize this(%v) Not Equal that(%v)", this.BatchSize, that1.BatchSize)
+	}
 	return nil
 }
 func (this *UpdatePolicy) Equal(that interface{}) bool {
@@ -1139,6 +1511,12 @@ func (this *UpdatePolicy) Equal(that interface{}) bool {
 	if this.Action != that1.Action {
 		return false
 	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
 	return true
 }
 func (this *HealthCheck) VerboseEqual(that interface{}) error {
@@ -1267,6 +1645,96 @@ func (this *HealthCheck) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReadinessCheck) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ReadinessCheck)
+	if !ok {
+		that2, ok := that.(ReadinessCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ReadinessCheck")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ReadinessCheck but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ReadinessCheck but is not nil && this == nil")
+	}
+	if this.Protocol != that1.Protocol {
+		return fmt.Errorf("Protocol this(%v) Not Equal that(%v)", this.Protocol, that1.Protocol)
+	}
+	if this.PortName != that1.PortName {
+		return fmt.Errorf("PortName this(%v) Not Equal that(%v)", this.PortName, that1.PortName)
+	}
+	if this.Path != that1.Path {
+		return fmt.Errorf("Path this(%v) Not Equal that(%v)", this.Path, that1.Path)
+	}
+	if this.IntervalSeconds != that1.IntervalSeconds {
+		return fmt.Errorf("IntervalSeconds this(%v) Not Equal that(%v)", this.IntervalSeconds, that1.IntervalSeconds)
+	}
+	if this.TimeoutSeconds != that1.TimeoutSeconds {
+		return fmt.Errorf("TimeoutSeconds this(%v) Not Equal that(%v)", this.TimeoutSeconds, that1.TimeoutSeconds)
+	}
+	if this.Port != that1.Port {
+		return fmt.Errorf("Port this(%v) Not Equal that(%v)", this.Port, that1.Port)
+	}
+	return nil
+}
+func (this *ReadinessCheck) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ReadinessCheck)
+	if !ok {
+		that2, ok := that.(ReadinessCheck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if this.PortName != that1.PortName {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.IntervalSeconds != that1.IntervalSeconds {
+		return false
+	}
+	if this.TimeoutSeconds != that1.TimeoutSeconds {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	return true
+}
 func (this *Command) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -1382,6 +1850,20 @@ func (this *Slot) VerboseEqual(that interface{}) error {
 	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
 		return fmt.Errorf("RestartPolicy this(%v) Not Equal that(%v)", this.RestartPolicy, that1.RestartPolicy)
 	}
+	if this.UpdateFailures != that1.UpdateFailures {
+		return fmt.Errorf("UpdateFailures this(%v) Not Equal that(%v)", this.UpdateFailures, that1.UpdateFailures)
+	}
+	if this.Ready != that1.Ready {
+		return fmt.Errorf("Ready this(%v) Not Equal that(%v)", this.Ready, that1.Ready)
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return fmt.Errorf("Volumes this(%v) Not Equal that(%v)", len(this.Volumes), len(that1.Volumes))
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return fmt.Errorf("Volumes this[%v](%v) Not Equal that[%v](%v)", i, this.Volumes[i], i, that1.Volumes[i])
+		}
+	}
 	return nil
 }
 func (this *Slot) Equal(that interface{}) bool {
@@ -1439,9 +1921,23 @@ func (this *Slot) Equal(that interface{}) bool {
 	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
 		return false
 	}
+	if this.UpdateFailures != that1.UpdateFailures {
+		return false
+	}
+	if this.Ready != that1.Ready {
+		return false
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return false
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *RestartPolicy) VerboseEqual(that interface{}) error {
+func (this *SlotVolume) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1449,26 +1945,41 @@ func (this *RestartPolicy) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*RestartPolicy)
+	that1, ok := that.(*SlotVolume)
 	if !ok {
-		that2, ok := that.(RestartPolicy)
+		that2, ok := that.(SlotVolume)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *RestartPolicy")
+			return fmt.Errorf("that is not of type *SlotVolume")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *RestartPolicy but is nil && this != nil")
+		return fmt.Errorf("that is type *SlotVolume but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *RestartPolicy but is not nil && this == nil")
+		return fmt.Errorf("that is type *SlotVolume but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.ContainerPath != that1.ContainerPath {
+		return fmt.Errorf("ContainerPath this(%v) Not Equal that(%v)", this.ContainerPath, that1.ContainerPath)
+	}
+	if this.Size_ != that1.Size_ {
+		return fmt.Errorf("Size_ this(%v) Not Equal that(%v)", this.Size_, that1.Size_)
+	}
+	if this.AgentId != that1.AgentId {
+		return fmt.Errorf("AgentId this(%v) Not Equal that(%v)", this.AgentId, that1.AgentId)
+	}
+	if this.Pending != that1.Pending {
+		return fmt.Errorf("Pending this(%v) Not Equal that(%v)", this.Pending, that1.Pending)
 	}
 	return nil
 }
-func (this *RestartPolicy) Equal(that interface{}) bool {
+func (this *SlotVolume) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
 			return true
@@ -1476,9 +1987,9 @@ func (this *RestartPolicy) Equal(that interface{}) bool {
 		return false
 	}
 
-	that1, ok := that.(*RestartPolicy)
+	that1, ok := that.(*SlotVolume)
 	if !ok {
-		that2, ok := that.(RestartPolicy)
+		that2, ok := that.(SlotVolume)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1493,9 +2004,24 @@ func (this *RestartPolicy) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ContainerPath != that1.ContainerPath {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.AgentId != that1.AgentId {
+		return false
+	}
+	if this.Pending != that1.Pending {
+		return false
+	}
 	return true
 }
-func (this *Task) VerboseEqual(that interface{}) error {
+func (this *AppVolumes) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1503,13 +2029,255 @@ func (this *Task) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Task)
+	that1, ok := that.(*AppVolumes)
 	if !ok {
-		that2, ok := that.(Task)
+		that2, ok := that.(AppVolumes)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Task")
+			return fmt.Errorf("that is not of type *AppVolumes")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AppVolumes but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AppVolumes but is not nil && this == nil")
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.Index != that1.Index {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return fmt.Errorf("Volumes this(%v) Not Equal that(%v)", len(this.Volumes), len(that1.Volumes))
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return fmt.Errorf("Volumes this[%v](%v) Not Equal that[%v](%v)", i, this.Volumes[i], i, that1.Volumes[i])
+		}
+	}
+	return nil
+}
+func (this *AppVolumes) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*AppVolumes)
+	if !ok {
+		that2, ok := that.(AppVolumes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return false
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VolumesDestroy) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*VolumesDestroy)
+	if !ok {
+		that2, ok := that.(VolumesDestroy)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VolumesDestroy")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VolumesDestroy but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VolumesDestroy but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
+	}
+	if this.Role != that1.Role {
+		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
+	}
+	if this.AgentId != that1.AgentId {
+		return fmt.Errorf("AgentId this(%v) Not Equal that(%v)", this.AgentId, that1.AgentId)
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return fmt.Errorf("Volumes this(%v) Not Equal that(%v)", len(this.Volumes), len(that1.Volumes))
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return fmt.Errorf("Volumes this[%v](%v) Not Equal that[%v](%v)", i, this.Volumes[i], i, that1.Volumes[i])
+		}
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
+	}
+	return nil
+}
+func (this *VolumesDestroy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VolumesDestroy)
+	if !ok {
+		that2, ok := that.(VolumesDestroy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.AppId != that1.AppId {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.AgentId != that1.AgentId {
+		return false
+	}
+	if len(this.Volumes) != len(that1.Volumes) {
+		return false
+	}
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(that1.Volumes[i]) {
+			return false
+		}
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
+}
+func (this *RestartPolicy) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RestartPolicy)
+	if !ok {
+		that2, ok := that.(RestartPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RestartPolicy")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RestartPolicy but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RestartPolicy but is not nil && this == nil")
+	}
+	if this.Restarts != that1.Restarts {
+		return fmt.Errorf("Restarts this(%v) Not Equal that(%v)", this.Restarts, that1.Restarts)
+	}
+	return nil
+}
+func (this *RestartPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RestartPolicy)
+	if !ok {
+		that2, ok := that.(RestartPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Restarts != that1.Restarts {
+		return false
+	}
+	return true
+}
+func (this *Task) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Task)
+	if !ok {
+		that2, ok := that.(Task)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Task")
 		}
 	}
 	if that1 == nil {
@@ -1570,6 +2338,14 @@ func (this *Task) VerboseEqual(that interface{}) error {
 	if this.CreatedAt != that1.CreatedAt {
 		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
 	}
+	if len(this.AgentAttributes) != len(that1.AgentAttributes) {
+		return fmt.Errorf("AgentAttributes this(%v) Not Equal that(%v)", len(this.AgentAttributes), len(that1.AgentAttributes))
+	}
+	for i := range this.AgentAttributes {
+		if this.AgentAttributes[i] != that1.AgentAttributes[i] {
+			return fmt.Errorf("AgentAttributes this[%v](%v) Not Equal that[%v](%v)", i, this.AgentAttributes[i], i, that1.AgentAttributes[i])
+		}
+	}
 	return nil
 }
 func (this *Task) Equal(that interface{}) bool {
@@ -1647,2055 +2423,6865 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if len(this.AgentAttributes) != len(that1.AgentAttributes) {
+		return false
+	}
+	for i := range this.AgentAttributes {
+		if this.AgentAttributes[i] != that1.AgentAttributes[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *Application) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Job) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&types.Application{")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	if this.Version != nil {
-		s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+
+	that1, ok := that.(*Job)
+	if !ok {
+		that2, ok := that.(Job)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Job")
+		}
 	}
-	if this.ProposedVersion != nil {
-		s = append(s, "ProposedVersion: "+fmt.Sprintf("%#v", this.ProposedVersion)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Job but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Job but is not nil && this == nil")
 	}
-	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
-	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Version) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	s := make([]string, 0, 23)
-	s = append(s, "&types.Version{")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	s = append(s, "PerviousVersionID: "+fmt.Sprintf("%#v", this.PerviousVersionID)+",\n")
-	s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
-	s = append(s, "Cpus: "+fmt.Sprintf("%#v", this.Cpus)+",\n")
-	s = append(s, "Mem: "+fmt.Sprintf("%#v", this.Mem)+",\n")
-	s = append(s, "Disk: "+fmt.Sprintf("%#v", this.Disk)+",\n")
-	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
-	s = append(s, "RunAs: "+fmt.Sprintf("%#v", this.RunAs)+",\n")
-	if this.Container != nil {
-		s = append(s, "Container: "+fmt.Sprintf("%#v", this.Container)+",\n")
+	if this.Completions != that1.Completions {
+		return fmt.Errorf("Completions this(%v) Not Equal that(%v)", this.Completions, that1.Completions)
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k, _ := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if this.Parallelism != that1.Parallelism {
+		return fmt.Errorf("Parallelism this(%v) Not Equal that(%v)", this.Parallelism, that1.Parallelism)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
+	if this.BackoffLimit != that1.BackoffLimit {
+		return fmt.Errorf("BackoffLimit this(%v) Not Equal that(%v)", this.BackoffLimit, that1.BackoffLimit)
 	}
-	mapStringForLabels += "}"
-	if this.Labels != nil {
-		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	if this.ActiveDeadlineSeconds != that1.ActiveDeadlineSeconds {
+		return fmt.Errorf("ActiveDeadlineSeconds this(%v) Not Equal that(%v)", this.ActiveDeadlineSeconds, that1.ActiveDeadlineSeconds)
 	}
-	if this.HealthChecks != nil {
-		s = append(s, "HealthChecks: "+fmt.Sprintf("%#v", this.HealthChecks)+",\n")
+	if this.State != that1.State {
+		return fmt.Errorf("State this(%v) Not Equal that(%v)", this.State, that1.State)
 	}
-	keysForEnv := make([]string, 0, len(this.Env))
-	for k, _ := range this.Env {
-		keysForEnv = append(keysForEnv, k)
+	if this.Message != that1.Message {
+		return fmt.Errorf("Message this(%v) Not Equal that(%v)", this.Message, that1.Message)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForEnv)
-	mapStringForEnv := "map[string]string{"
-	for _, k := range keysForEnv {
-		mapStringForEnv += fmt.Sprintf("%#v: %#v,", k, this.Env[k])
+	if this.Succeeded != that1.Succeeded {
+		return fmt.Errorf("Succeeded this(%v) Not Equal that(%v)", this.Succeeded, that1.Succeeded)
 	}
-	mapStringForEnv += "}"
-	if this.Env != nil {
-		s = append(s, "Env: "+mapStringForEnv+",\n")
+	if this.Failed != that1.Failed {
+		return fmt.Errorf("Failed this(%v) Not Equal that(%v)", this.Failed, that1.Failed)
 	}
-	if this.KillPolicy != nil {
-		s = append(s, "KillPolicy: "+fmt.Sprintf("%#v", this.KillPolicy)+",\n")
+	if this.StartedAt != that1.StartedAt {
+		return fmt.Errorf("StartedAt this(%v) Not Equal that(%v)", this.StartedAt, that1.StartedAt)
 	}
-	if this.UpdatePolicy != nil {
-		s = append(s, "UpdatePolicy: "+fmt.Sprintf("%#v", this.UpdatePolicy)+",\n")
+	if this.CompletedAt != that1.CompletedAt {
+		return fmt.Errorf("CompletedAt this(%v) Not Equal that(%v)", this.CompletedAt, that1.CompletedAt)
 	}
-	s = append(s, "Constraints: "+fmt.Sprintf("%#v", this.Constraints)+",\n")
-	s = append(s, "Uris: "+fmt.Sprintf("%#v", this.Uris)+",\n")
-	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
-	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
-	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Container) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Job) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&types.Container{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.Docker != nil {
-		s = append(s, "Docker: "+fmt.Sprintf("%#v", this.Docker)+",\n")
+
+	that1, ok := that.(*Job)
+	if !ok {
+		that2, ok := that.(Job)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Volumes != nil {
-		s = append(s, "Volumes: "+fmt.Sprintf("%#v", this.Volumes)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Docker) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ID != that1.ID {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&types.Docker{")
-	s = append(s, "ForcePullImage: "+fmt.Sprintf("%#v", this.ForcePullImage)+",\n")
-	s = append(s, "Image: "+fmt.Sprintf("%#v", this.Image)+",\n")
-	s = append(s, "Network: "+fmt.Sprintf("%#v", this.Network)+",\n")
-	if this.Parameters != nil {
-		s = append(s, "Parameters: "+fmt.Sprintf("%#v", this.Parameters)+",\n")
+	if this.Completions != that1.Completions {
+		return false
 	}
-	if this.PortMappings != nil {
-		s = append(s, "PortMappings: "+fmt.Sprintf("%#v", this.PortMappings)+",\n")
+	if this.Parallelism != that1.Parallelism {
+		return false
 	}
-	s = append(s, "Privileged: "+fmt.Sprintf("%#v", this.Privileged)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Parameter) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.BackoffLimit != that1.BackoffLimit {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&types.Parameter{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PortMapping) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ActiveDeadlineSeconds != that1.ActiveDeadlineSeconds {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&types.PortMapping{")
-	s = append(s, "ContainerPort: "+fmt.Sprintf("%#v", this.ContainerPort)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Volume) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.State != that1.State {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&types.Volume{")
-	s = append(s, "ContainerPath: "+fmt.Sprintf("%#v", this.ContainerPath)+",\n")
-	s = append(s, "HostPath: "+fmt.Sprintf("%#v", this.HostPath)+",\n")
-	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *KillPolicy) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Message != that1.Message {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&types.KillPolicy{")
-	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *UpdatePolicy) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Succeeded != that1.Succeeded {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&types.UpdatePolicy{")
-	s = append(s, "UpdateDelay: "+fmt.Sprintf("%#v", this.UpdateDelay)+",\n")
-	s = append(s, "MaxRetries: "+fmt.Sprintf("%#v", this.MaxRetries)+",\n")
-	s = append(s, "MaxFailovers: "+fmt.Sprintf("%#v", this.MaxFailovers)+",\n")
-	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HealthCheck) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Failed != that1.Failed {
+		return false
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&types.HealthCheck{")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
-	s = append(s, "Port: "+fmt.Sprintf("%#v", this.Port)+",\n")
-	s = append(s, "PortIndex: "+fmt.Sprintf("%#v", this.PortIndex)+",\n")
-	s = append(s, "PortName: "+fmt.Sprintf("%#v", this.PortName)+",\n")
-	if this.Command != nil {
-		s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
+	if this.StartedAt != that1.StartedAt {
+		return false
 	}
-	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
-	s = append(s, "ConsecutiveFailures: "+fmt.Sprintf("%#v", this.ConsecutiveFailures)+",\n")
-	s = append(s, "GracePeriodSeconds: "+fmt.Sprintf("%#v", this.GracePeriodSeconds)+",\n")
-	s = append(s, "IntervalSeconds: "+fmt.Sprintf("%#v", this.IntervalSeconds)+",\n")
-	s = append(s, "TimeoutSeconds: "+fmt.Sprintf("%#v", this.TimeoutSeconds)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Command) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.CompletedAt != that1.CompletedAt {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&types.Command{")
-	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Slot) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CronJob) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 14)
-	s = append(s, "&types.Slot{")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
-	s = append(s, "VersionId: "+fmt.Sprintf("%#v", this.VersionId)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "MarkForDeletion: "+fmt.Sprintf("%#v", this.MarkForDeletion)+",\n")
-	s = append(s, "MarkForRollingUpdate: "+fmt.Sprintf("%#v", this.MarkForRollingUpdate)+",\n")
-	s = append(s, "Healthy: "+fmt.Sprintf("%#v", this.Healthy)+",\n")
-	if this.CurrentTask != nil {
-		s = append(s, "CurrentTask: "+fmt.Sprintf("%#v", this.CurrentTask)+",\n")
+
+	that1, ok := that.(*CronJob)
+	if !ok {
+		that2, ok := that.(CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CronJob")
+		}
 	}
-	if this.RestartPolicy != nil {
-		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CronJob but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CronJob but is not nil && this == nil")
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RestartPolicy) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&types.RestartPolicy{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Task) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Schedule != that1.Schedule {
+		return fmt.Errorf("Schedule this(%v) Not Equal that(%v)", this.Schedule, that1.Schedule)
 	}
-	s := make([]string, 0, 19)
-	s = append(s, "&types.Task{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "TaskInfoId: "+fmt.Sprintf("%#v", this.TaskInfoId)+",\n")
-	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
-	s = append(s, "VersionId: "+fmt.Sprintf("%#v", this.VersionId)+",\n")
-	s = append(s, "SlotId: "+fmt.Sprintf("%#v", this.SlotId)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "Stdout: "+fmt.Sprintf("%#v", this.Stdout)+",\n")
-	s = append(s, "Stderr: "+fmt.Sprintf("%#v", this.Stderr)+",\n")
-	s = append(s, "HostPorts: "+fmt.Sprintf("%#v", this.HostPorts)+",\n")
-	s = append(s, "OfferId: "+fmt.Sprintf("%#v", this.OfferId)+",\n")
-	s = append(s, "AgentId: "+fmt.Sprintf("%#v", this.AgentId)+",\n")
-	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
-	s = append(s, "AgentHostName: "+fmt.Sprintf("%#v", this.AgentHostName)+",\n")
-	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
-	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringApplication(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.TimeZone != that1.TimeZone {
+		return fmt.Errorf("TimeZone this(%v) Not Equal that(%v)", this.TimeZone, that1.TimeZone)
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func extensionToGoStringApplication(m github_com_gogo_protobuf_proto.Message) string {
-	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
-		return "nil"
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return fmt.Errorf("ConcurrencyPolicy this(%v) Not Equal that(%v)", this.ConcurrencyPolicy, that1.ConcurrencyPolicy)
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+	if this.HistoryLimit != that1.HistoryLimit {
+		return fmt.Errorf("HistoryLimit this(%v) Not Equal that(%v)", this.HistoryLimit, that1.HistoryLimit)
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	if this.StartingDeadlineSeconds != that1.StartingDeadlineSeconds {
+		return fmt.Errorf("StartingDeadlineSeconds this(%v) Not Equal that(%v)", this.StartingDeadlineSeconds, that1.StartingDeadlineSeconds)
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
-}
-func (m *Application) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Completions != that1.Completions {
+		return fmt.Errorf("Completions this(%v) Not Equal that(%v)", this.Completions, that1.Completions)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Application) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.Parallelism != that1.Parallelism {
+		return fmt.Errorf("Parallelism this(%v) Not Equal that(%v)", this.Parallelism, that1.Parallelism)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if this.BackoffLimit != that1.BackoffLimit {
+		return fmt.Errorf("BackoffLimit this(%v) Not Equal that(%v)", this.BackoffLimit, that1.BackoffLimit)
 	}
-	if m.Version != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Version.Size()))
-		n1, err := m.Version.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	if this.ActiveDeadlineSeconds != that1.ActiveDeadlineSeconds {
+		return fmt.Errorf("ActiveDeadlineSeconds this(%v) Not Equal that(%v)", this.ActiveDeadlineSeconds, that1.ActiveDeadlineSeconds)
 	}
-	if m.ProposedVersion != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.ProposedVersion.Size()))
-		n2, err := m.ProposedVersion.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if !this.Template.Equal(that1.Template) {
+		return fmt.Errorf("Template this(%v) Not Equal that(%v)", this.Template, that1.Template)
 	}
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return fmt.Errorf("LastScheduledAt this(%v) Not Equal that(%v)", this.LastScheduledAt, that1.LastScheduledAt)
 	}
-	if m.CreatedAt != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	if len(this.Runs) != len(that1.Runs) {
+		return fmt.Errorf("Runs this(%v) Not Equal that(%v)", len(this.Runs), len(that1.Runs))
 	}
-	if m.UpdatedAt != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.UpdatedAt))
+	for i := range this.Runs {
+		if this.Runs[i] != that1.Runs[i] {
+			return fmt.Errorf("Runs this[%v](%v) Not Equal that[%v](%v)", i, this.Runs[i], i, that1.Runs[i])
+		}
 	}
-	return i, nil
+	return nil
 }
-
-func (m *Version) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *CronJob) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	that1, ok := that.(*CronJob)
+	if !ok {
+		that2, ok := that.(CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.PerviousVersionID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.PerviousVersionID)))
-		i += copy(dAtA[i:], m.PerviousVersionID)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if len(m.Command) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Command)))
-		i += copy(dAtA[i:], m.Command)
+	if this.ID != that1.ID {
+		return false
 	}
-	if m.Cpus != 0 {
-		dAtA[i] = 0x21
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Cpus))))
+	if this.Schedule != that1.Schedule {
+		return false
 	}
-	if m.Mem != 0 {
-		dAtA[i] = 0x29
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Mem))))
+	if this.TimeZone != that1.TimeZone {
+		return false
 	}
-	if m.Disk != 0 {
-		dAtA[i] = 0x31
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Disk))))
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return false
 	}
-	if m.Instances != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Instances))
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
 	}
-	if len(m.RunAs) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.RunAs)))
-		i += copy(dAtA[i:], m.RunAs)
+	if this.StartingDeadlineSeconds != that1.StartingDeadlineSeconds {
+		return false
 	}
-	if m.Container != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Container.Size()))
-		n3, err := m.Container.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	if this.Completions != that1.Completions {
+		return false
 	}
-	if len(m.Labels) > 0 {
-		for k, _ := range m.Labels {
-			dAtA[i] = 0x52
-			i++
-			v := m.Labels[k]
-			mapSize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
-			i = encodeVarintApplication(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	if this.Parallelism != that1.Parallelism {
+		return false
 	}
-	if len(m.HealthChecks) > 0 {
-		for _, msg := range m.HealthChecks {
-			dAtA[i] = 0x5a
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.BackoffLimit != that1.BackoffLimit {
+		return false
 	}
-	if len(m.Env) > 0 {
-		for k, _ := range m.Env {
-			dAtA[i] = 0x62
-			i++
-			v := m.Env[k]
-			mapSize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
-			i = encodeVarintApplication(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+	if this.ActiveDeadlineSeconds != that1.ActiveDeadlineSeconds {
+		return false
+	}
+	if !this.Template.Equal(that1.Template) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return false
+	}
+	if len(this.Runs) != len(that1.Runs) {
+		return false
+	}
+	for i := range this.Runs {
+		if this.Runs[i] != that1.Runs[i] {
+			return false
 		}
 	}
-	if m.KillPolicy != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.KillPolicy.Size()))
-		n4, err := m.KillPolicy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	return true
+}
+func (this *Quota) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i += n4
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if m.UpdatePolicy != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.UpdatePolicy.Size()))
-		n5, err := m.UpdatePolicy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Quota")
 		}
-		i += n5
 	}
-	if len(m.Constraints) > 0 {
-		for _, s := range m.Constraints {
-			dAtA[i] = 0x7a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *Quota but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Quota but is not nil && this == nil")
 	}
-	if len(m.Uris) > 0 {
-		for _, s := range m.Uris {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if this.RunAs != that1.RunAs {
+		return fmt.Errorf("RunAs this(%v) Not Equal that(%v)", this.RunAs, that1.RunAs)
 	}
-	if len(m.Ip) > 0 {
-		for _, s := range m.Ip {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x1
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if this.Cpus != that1.Cpus {
+		return fmt.Errorf("Cpus this(%v) Not Equal that(%v)", this.Cpus, that1.Cpus)
 	}
-	if len(m.Mode) > 0 {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Mode)))
-		i += copy(dAtA[i:], m.Mode)
+	if this.Mem != that1.Mem {
+		return fmt.Errorf("Mem this(%v) Not Equal that(%v)", this.Mem, that1.Mem)
 	}
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+	if this.Disk != that1.Disk {
+		return fmt.Errorf("Disk this(%v) Not Equal that(%v)", this.Disk, that1.Disk)
 	}
-	return i, nil
-}
-
-func (m *Container) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Instances != that1.Instances {
+		return fmt.Errorf("Instances this(%v) Not Equal that(%v)", this.Instances, that1.Instances)
 	}
-	return dAtA[:n], nil
+	if this.Ips != that1.Ips {
+		return fmt.Errorf("Ips this(%v) Not Equal that(%v)", this.Ips, that1.Ips)
+	}
+	return nil
 }
-
-func (m *Container) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Type) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+func (this *Quota) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	if m.Docker != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Docker.Size()))
-		n6, err := m.Docker.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n6
 	}
-	if len(m.Volumes) > 0 {
-		for _, msg := range m.Volumes {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if that1 == nil {
+		if this == nil {
+			return true
 		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Docker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.RunAs != that1.RunAs {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Cpus != that1.Cpus {
+		return false
+	}
+	if this.Mem != that1.Mem {
+		return false
+	}
+	if this.Disk != that1.Disk {
+		return false
+	}
+	if this.Instances != that1.Instances {
+		return false
+	}
+	if this.Ips != that1.Ips {
+		return false
+	}
+	return true
 }
+func (this *Autoscaler) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Docker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ForcePullImage {
-		dAtA[i] = 0x8
-		i++
-		if m.ForcePullImage {
-			dAtA[i] = 1
+	that1, ok := that.(*Autoscaler)
+	if !ok {
+		that2, ok := that.(Autoscaler)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return fmt.Errorf("that is not of type *Autoscaler")
 		}
-		i++
 	}
-	if len(m.Image) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Image)))
-		i += copy(dAtA[i:], m.Image)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Autoscaler but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Autoscaler but is not nil && this == nil")
 	}
-	if len(m.Network) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Network)))
-		i += copy(dAtA[i:], m.Network)
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
 	}
-	if len(m.Parameters) > 0 {
-		for _, msg := range m.Parameters {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.MinInstances != that1.MinInstances {
+		return fmt.Errorf("MinInstances this(%v) Not Equal that(%v)", this.MinInstances, that1.MinInstances)
 	}
-	if len(m.PortMappings) > 0 {
-		for _, msg := range m.PortMappings {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.MaxInstances != that1.MaxInstances {
+		return fmt.Errorf("MaxInstances this(%v) Not Equal that(%v)", this.MaxInstances, that1.MaxInstances)
 	}
-	if m.Privileged {
-		dAtA[i] = 0x30
-		i++
-		if m.Privileged {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Metric != that1.Metric {
+		return fmt.Errorf("Metric this(%v) Not Equal that(%v)", this.Metric, that1.Metric)
 	}
-	return i, nil
-}
-
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Target != that1.Target {
+		return fmt.Errorf("Target this(%v) Not Equal that(%v)", this.Target, that1.Target)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.Query != that1.Query {
+		return fmt.Errorf("Query this(%v) Not Equal that(%v)", this.Query, that1.Query)
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	if this.IntervalSeconds != that1.IntervalSeconds {
+		return fmt.Errorf("IntervalSeconds this(%v) Not Equal that(%v)", this.IntervalSeconds, that1.IntervalSeconds)
 	}
-	return i, nil
-}
-
-func (m *PortMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.ScaleUpCooldownSeconds != that1.ScaleUpCooldownSeconds {
+		return fmt.Errorf("ScaleUpCooldownSeconds this(%v) Not Equal that(%v)", this.ScaleUpCooldownSeconds, that1.ScaleUpCooldownSeconds)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PortMapping) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ContainerPort != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.ContainerPort))
+	if this.ScaleDownCooldownSeconds != that1.ScaleDownCooldownSeconds {
+		return fmt.Errorf("ScaleDownCooldownSeconds this(%v) Not Equal that(%v)", this.ScaleDownCooldownSeconds, that1.ScaleDownCooldownSeconds)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
 	}
-	if len(m.Protocol) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Protocol)))
-		i += copy(dAtA[i:], m.Protocol)
+	if this.LastScaledAt != that1.LastScaledAt {
+		return fmt.Errorf("LastScaledAt this(%v) Not Equal that(%v)", this.LastScaledAt, that1.LastScaledAt)
 	}
-	return i, nil
+	return nil
 }
-
-func (m *Volume) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Autoscaler) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerPath) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.ContainerPath)))
-		i += copy(dAtA[i:], m.ContainerPath)
+	that1, ok := that.(*Autoscaler)
+	if !ok {
+		that2, ok := that.(Autoscaler)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.HostPath) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.HostPath)))
-		i += copy(dAtA[i:], m.HostPath)
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	if len(m.Mode) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Mode)))
-		i += copy(dAtA[i:], m.Mode)
+	if this.AppId != that1.AppId {
+		return false
 	}
-	return i, nil
-}
-
-func (m *KillPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.MinInstances != that1.MinInstances {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KillPolicy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Duration != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Duration))
+	if this.MaxInstances != that1.MaxInstances {
+		return false
 	}
-	return i, nil
-}
-
-func (m *UpdatePolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Metric != that1.Metric {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateDelay != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.UpdateDelay))
+	if this.Target != that1.Target {
+		return false
 	}
-	if m.MaxRetries != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.MaxRetries))
+	if this.Query != that1.Query {
+		return false
 	}
-	if m.MaxFailovers != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.MaxFailovers))
+	if this.IntervalSeconds != that1.IntervalSeconds {
+		return false
 	}
-	if len(m.Action) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
-		i += copy(dAtA[i:], m.Action)
+	if this.ScaleUpCooldownSeconds != that1.ScaleUpCooldownSeconds {
+		return false
 	}
-	return i, nil
-}
-
-func (m *HealthCheck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.ScaleDownCooldownSeconds != that1.ScaleDownCooldownSeconds {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.LastScaledAt != that1.LastScaledAt {
+		return false
+	}
+	return true
 }
+func (this *ScalingSchedule) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	that1, ok := that.(*ScalingSchedule)
+	if !ok {
+		that2, ok := that.(ScalingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ScalingSchedule")
+		}
 	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ScalingSchedule but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ScalingSchedule but is not nil && this == nil")
 	}
-	if len(m.Protocol) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Protocol)))
-		i += copy(dAtA[i:], m.Protocol)
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	if m.Port != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Port))
+	if this.AppId != that1.AppId {
+		return fmt.Errorf("AppId this(%v) Not Equal that(%v)", this.AppId, that1.AppId)
 	}
-	if m.PortIndex != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.PortIndex))
+	if this.Schedule != that1.Schedule {
+		return fmt.Errorf("Schedule this(%v) Not Equal that(%v)", this.Schedule, that1.Schedule)
 	}
-	if len(m.PortName) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.PortName)))
-		i += copy(dAtA[i:], m.PortName)
+	if this.TimeZone != that1.TimeZone {
+		return fmt.Errorf("TimeZone this(%v) Not Equal that(%v)", this.TimeZone, that1.TimeZone)
 	}
-	if m.Command != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Command.Size()))
-		n7, err := m.Command.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	if this.Instances != that1.Instances {
+		return fmt.Errorf("Instances this(%v) Not Equal that(%v)", this.Instances, that1.Instances)
 	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+	if this.MinInstances != that1.MinInstances {
+		return fmt.Errorf("MinInstances this(%v) Not Equal that(%v)", this.MinInstances, that1.MinInstances)
 	}
-	if m.ConsecutiveFailures != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.ConsecutiveFailures))
+	if this.MaxInstances != that1.MaxInstances {
+		return fmt.Errorf("MaxInstances this(%v) Not Equal that(%v)", this.MaxInstances, that1.MaxInstances)
 	}
-	if m.GracePeriodSeconds != 0 {
-		dAtA[i] = 0x51
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.GracePeriodSeconds))))
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
 	}
-	if m.IntervalSeconds != 0 {
-		dAtA[i] = 0x59
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.IntervalSeconds))))
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return fmt.Errorf("LastScheduledAt this(%v) Not Equal that(%v)", this.LastScheduledAt, that1.LastScheduledAt)
 	}
-	if m.TimeoutSeconds != 0 {
-		dAtA[i] = 0x61
-		i++
-		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.TimeoutSeconds))))
+	if this.LastError != that1.LastError {
+		return fmt.Errorf("LastError this(%v) Not Equal that(%v)", this.LastError, that1.LastError)
 	}
-	return i, nil
+	return nil
 }
-
-func (m *Command) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ScalingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Command) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	that1, ok := that.(*ScalingSchedule)
+	if !ok {
+		that2, ok := that.(ScalingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return i, nil
-}
-
-func (m *Slot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Slot) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.Index))
+	if this.ID != that1.ID {
+		return false
 	}
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if this.AppId != that1.AppId {
+		return false
 	}
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+	if this.Schedule != that1.Schedule {
+		return false
 	}
-	if len(m.VersionId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.VersionId)))
-		i += copy(dAtA[i:], m.VersionId)
+	if this.TimeZone != that1.TimeZone {
+		return false
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	if this.Instances != that1.Instances {
+		return false
 	}
-	if m.MarkForDeletion {
-		dAtA[i] = 0x30
-		i++
-		if m.MarkForDeletion {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.MinInstances != that1.MinInstances {
+		return false
 	}
-	if m.MarkForRollingUpdate {
-		dAtA[i] = 0x38
-		i++
-		if m.MarkForRollingUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.MaxInstances != that1.MaxInstances {
+		return false
 	}
-	if m.Healthy {
-		dAtA[i] = 0x40
-		i++
-		if m.Healthy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.CreatedAt != that1.CreatedAt {
+		return false
 	}
-	if m.CurrentTask != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.CurrentTask.Size()))
-		n8, err := m.CurrentTask.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	if this.LastScheduledAt != that1.LastScheduledAt {
+		return false
 	}
-	if m.RestartPolicy != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.RestartPolicy.Size()))
-		n9, err := m.RestartPolicy.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+	if this.LastError != that1.LastError {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *Application) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *Task) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 15)
+	s = append(s, "&types.Application{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Version != nil {
+		s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Task) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if this.ProposedVersion != nil {
+		s = append(s, "ProposedVersion: "+fmt.Sprintf("%#v", this.ProposedVersion)+",\n")
 	}
-	if len(m.TaskInfoId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.TaskInfoId)))
-		i += copy(dAtA[i:], m.TaskInfoId)
+	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "StateMessage: "+fmt.Sprintf("%#v", this.StateMessage)+",\n")
+	s = append(s, "UpdatePaused: "+fmt.Sprintf("%#v", this.UpdatePaused)+",\n")
+	s = append(s, "CanaryWeight: "+fmt.Sprintf("%#v", this.CanaryWeight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Version) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+	s := make([]string, 0, 33)
+	s = append(s, "&types.Version{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "PerviousVersionID: "+fmt.Sprintf("%#v", this.PerviousVersionID)+",\n")
+	s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
+	s = append(s, "Cpus: "+fmt.Sprintf("%#v", this.Cpus)+",\n")
+	s = append(s, "Mem: "+fmt.Sprintf("%#v", this.Mem)+",\n")
+	s = append(s, "Disk: "+fmt.Sprintf("%#v", this.Disk)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "RunAs: "+fmt.Sprintf("%#v", this.RunAs)+",\n")
+	if this.Container != nil {
+		s = append(s, "Container: "+fmt.Sprintf("%#v", this.Container)+",\n")
 	}
-	if len(m.VersionId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.VersionId)))
-		i += copy(dAtA[i:], m.VersionId)
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
 	}
-	if len(m.SlotId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.SlotId)))
-		i += copy(dAtA[i:], m.SlotId)
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
 	}
-	if len(m.Stdout) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Stdout)))
-		i += copy(dAtA[i:], m.Stdout)
+	if this.HealthChecks != nil {
+		s = append(s, "HealthChecks: "+fmt.Sprintf("%#v", this.HealthChecks)+",\n")
 	}
-	if len(m.Stderr) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Stderr)))
-		i += copy(dAtA[i:], m.Stderr)
+	keysForEnv := make([]string, 0, len(this.Env))
+	for k, _ := range this.Env {
+		keysForEnv = append(keysForEnv, k)
 	}
-	if len(m.HostPorts) > 0 {
-		dAtA11 := make([]byte, len(m.HostPorts)*10)
-		var j10 int
-		for _, num := range m.HostPorts {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
-		}
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(j10))
-		i += copy(dAtA[i:], dAtA11[:j10])
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEnv)
+	mapStringForEnv := "map[string]string{"
+	for _, k := range keysForEnv {
+		mapStringForEnv += fmt.Sprintf("%#v: %#v,", k, this.Env[k])
 	}
-	if len(m.OfferId) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.OfferId)))
-		i += copy(dAtA[i:], m.OfferId)
+	mapStringForEnv += "}"
+	if this.Env != nil {
+		s = append(s, "Env: "+mapStringForEnv+",\n")
 	}
-	if len(m.AgentId) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentId)))
-		i += copy(dAtA[i:], m.AgentId)
+	if this.KillPolicy != nil {
+		s = append(s, "KillPolicy: "+fmt.Sprintf("%#v", this.KillPolicy)+",\n")
 	}
-	if len(m.Ip) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Ip)))
-		i += copy(dAtA[i:], m.Ip)
+	if this.UpdatePolicy != nil {
+		s = append(s, "UpdatePolicy: "+fmt.Sprintf("%#v", this.UpdatePolicy)+",\n")
 	}
-	if len(m.AgentHostName) > 0 {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentHostName)))
-		i += copy(dAtA[i:], m.AgentHostName)
+	s = append(s, "Constraints: "+fmt.Sprintf("%#v", this.Constraints)+",\n")
+	s = append(s, "Uris: "+fmt.Sprintf("%#v", this.Uris)+",\n")
+	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "PlacementStrategy: "+fmt.Sprintf("%#v", this.PlacementStrategy)+",\n")
+	s = append(s, "BackoffSeconds: "+fmt.Sprintf("%#v", this.BackoffSeconds)+",\n")
+	s = append(s, "BackoffFactor: "+fmt.Sprintf("%#v", this.BackoffFactor)+",\n")
+	s = append(s, "MaxLaunchDelaySeconds: "+fmt.Sprintf("%#v", this.MaxLaunchDelaySeconds)+",\n")
+	s = append(s, "MaxRestarts: "+fmt.Sprintf("%#v", this.MaxRestarts)+",\n")
+	s = append(s, "MaxConsecutiveUnhealthy: "+fmt.Sprintf("%#v", this.MaxConsecutiveUnhealthy)+",\n")
+	s = append(s, "MaxUnhealthySeconds: "+fmt.Sprintf("%#v", this.MaxUnhealthySeconds)+",\n")
+	if this.ReadinessCheck != nil {
+		s = append(s, "ReadinessCheck: "+fmt.Sprintf("%#v", this.ReadinessCheck)+",\n")
+	}
+	s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Container) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+	s := make([]string, 0, 8)
+	s = append(s, "&types.Container{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Docker != nil {
+		s = append(s, "Docker: "+fmt.Sprintf("%#v", this.Docker)+",\n")
 	}
-	if m.CreatedAt != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	if this.Volumes != nil {
+		s = append(s, "Volumes: "+fmt.Sprintf("%#v", this.Volumes)+",\n")
 	}
-	return i, nil
-}
-
-func encodeFixed64Application(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+	s = append(s, "Entrypoint: "+fmt.Sprintf("%#v", this.Entrypoint)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func encodeFixed32Application(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+func (this *Docker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&types.Docker{")
+	s = append(s, "ForcePullImage: "+fmt.Sprintf("%#v", this.ForcePullImage)+",\n")
+	s = append(s, "Image: "+fmt.Sprintf("%#v", this.Image)+",\n")
+	s = append(s, "Network: "+fmt.Sprintf("%#v", this.Network)+",\n")
+	if this.Parameters != nil {
+		s = append(s, "Parameters: "+fmt.Sprintf("%#v", this.Parameters)+",\n")
+	}
+	if this.PortMappings != nil {
+		s = append(s, "PortMappings: "+fmt.Sprintf("%#v", this.PortMappings)+",\n")
+	}
+	s = append(s, "Privileged: "+fmt.Sprintf("%#v", this.Privileged)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *Parameter) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	s := make([]string, 0, 6)
+	s = append(s, "&types.Parameter{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func NewPopulatedApplication(r randyApplication, easy bool) *Application {
-	this := &Application{}
-	this.ID = string(randStringApplication(r))
-	this.Name = string(randStringApplication(r))
-	if r.Intn(10) != 0 {
-		this.Version = NewPopulatedVersion(r, easy)
+func (this *PortMapping) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		this.ProposedVersion = NewPopulatedVersion(r, easy)
+	s := make([]string, 0, 7)
+	s = append(s, "&types.PortMapping{")
+	s = append(s, "ContainerPort: "+fmt.Sprintf("%#v", this.ContainerPort)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Volume) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.ClusterId = string(randStringApplication(r))
-	this.State = string(randStringApplication(r))
-	this.CreatedAt = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.CreatedAt *= -1
+	s := make([]string, 0, 8)
+	s = append(s, "&types.Volume{")
+	s = append(s, "ContainerPath: "+fmt.Sprintf("%#v", this.ContainerPath)+",\n")
+	s = append(s, "HostPath: "+fmt.Sprintf("%#v", this.HostPath)+",\n")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	if this.Persistent != nil {
+		s = append(s, "Persistent: "+fmt.Sprintf("%#v", this.Persistent)+",\n")
 	}
-	this.UpdatedAt = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.UpdatedAt *= -1
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PersistentVolume) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 5)
+	s = append(s, "&types.PersistentVolume{")
+	s = append(s, "Size_: "+fmt.Sprintf("%#v", this.Size_)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KillPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 6)
+	s = append(s, "&types.KillPolicy{")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "DrainDuration: "+fmt.Sprintf("%#v", this.DrainDuration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedVersion(r randyApplication, easy bool) *Version {
-	this := &Version{}
-	this.ID = string(randStringApplication(r))
-	this.PerviousVersionID = string(randStringApplication(r))
-	this.Command = string(randStringApplication(r))
-	this.Cpus = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Cpus *= -1
+func (this *UpdatePolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.Mem = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Mem *= -1
+	s := make([]string, 0, 10)
+	s = append(s, "&types.UpdatePolicy{")
+	s = append(s, "UpdateDelay: "+fmt.Sprintf("%#v", this.UpdateDelay)+",\n")
+	s = append(s, "MaxRetries: "+fmt.Sprintf("%#v", this.MaxRetries)+",\n")
+	s = append(s, "MaxFailovers: "+fmt.Sprintf("%#v", this.MaxFailovers)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HealthCheck) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.Disk = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Disk *= -1
+	s := make([]string, 0, 16)
+	s = append(s, "&types.HealthCheck{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "Port: "+fmt.Sprintf("%#v", this.Port)+",\n")
+	s = append(s, "PortIndex: "+fmt.Sprintf("%#v", this.PortIndex)+",\n")
+	s = append(s, "PortName: "+fmt.Sprintf("%#v", this.PortName)+",\n")
+	if this.Command != nil {
+		s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
 	}
-	this.Instances = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Instances *= -1
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "ConsecutiveFailures: "+fmt.Sprintf("%#v", this.ConsecutiveFailures)+",\n")
+	s = append(s, "GracePeriodSeconds: "+fmt.Sprintf("%#v", this.GracePeriodSeconds)+",\n")
+	s = append(s, "IntervalSeconds: "+fmt.Sprintf("%#v", this.IntervalSeconds)+",\n")
+	s = append(s, "TimeoutSeconds: "+fmt.Sprintf("%#v", this.TimeoutSeconds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReadinessCheck) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.RunAs = string(randStringApplication(r))
-	if r.Intn(10) != 0 {
-		this.Container = NewPopulatedContainer(r, easy)
+	s := make([]string, 0, 10)
+	s = append(s, "&types.ReadinessCheck{")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "PortName: "+fmt.Sprintf("%#v", this.PortName)+",\n")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "IntervalSeconds: "+fmt.Sprintf("%#v", this.IntervalSeconds)+",\n")
+	s = append(s, "TimeoutSeconds: "+fmt.Sprintf("%#v", this.TimeoutSeconds)+",\n")
+	s = append(s, "Port: "+fmt.Sprintf("%#v", this.Port)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Command) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		v1 := r.Intn(10)
-		this.Labels = make(map[string]string)
-		for i := 0; i < v1; i++ {
-			this.Labels[randStringApplication(r)] = randStringApplication(r)
-		}
-	}
-	if r.Intn(10) != 0 {
-		v2 := r.Intn(5)
-		this.HealthChecks = make([]*HealthCheck, v2)
-		for i := 0; i < v2; i++ {
-			this.HealthChecks[i] = NewPopulatedHealthCheck(r, easy)
-		}
-	}
-	if r.Intn(10) != 0 {
-		v3 := r.Intn(10)
-		this.Env = make(map[string]string)
-		for i := 0; i < v3; i++ {
-			this.Env[randStringApplication(r)] = randStringApplication(r)
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&types.Command{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Slot) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		this.KillPolicy = NewPopulatedKillPolicy(r, easy)
+	s := make([]string, 0, 17)
+	s = append(s, "&types.Slot{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "VersionId: "+fmt.Sprintf("%#v", this.VersionId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "MarkForDeletion: "+fmt.Sprintf("%#v", this.MarkForDeletion)+",\n")
+	s = append(s, "MarkForRollingUpdate: "+fmt.Sprintf("%#v", this.MarkForRollingUpdate)+",\n")
+	s = append(s, "Healthy: "+fmt.Sprintf("%#v", this.Healthy)+",\n")
+	if this.CurrentTask != nil {
+		s = append(s, "CurrentTask: "+fmt.Sprintf("%#v", this.CurrentTask)+",\n")
 	}
-	if r.Intn(10) != 0 {
-		this.UpdatePolicy = NewPopulatedUpdatePolicy(r, easy)
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
 	}
-	v4 := r.Intn(10)
-	this.Constraints = make([]string, v4)
-	for i := 0; i < v4; i++ {
-		this.Constraints[i] = string(randStringApplication(r))
+	s = append(s, "UpdateFailures: "+fmt.Sprintf("%#v", this.UpdateFailures)+",\n")
+	s = append(s, "Ready: "+fmt.Sprintf("%#v", this.Ready)+",\n")
+	if this.Volumes != nil {
+		s = append(s, "Volumes: "+fmt.Sprintf("%#v", this.Volumes)+",\n")
 	}
-	v5 := r.Intn(10)
-	this.Uris = make([]string, v5)
-	for i := 0; i < v5; i++ {
-		this.Uris[i] = string(randStringApplication(r))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SlotVolume) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	v6 := r.Intn(10)
-	this.Ip = make([]string, v6)
-	for i := 0; i < v6; i++ {
-		this.Ip[i] = string(randStringApplication(r))
+	s := make([]string, 0, 9)
+	s = append(s, "&types.SlotVolume{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ContainerPath: "+fmt.Sprintf("%#v", this.ContainerPath)+",\n")
+	s = append(s, "Size_: "+fmt.Sprintf("%#v", this.Size_)+",\n")
+	s = append(s, "AgentId: "+fmt.Sprintf("%#v", this.AgentId)+",\n")
+	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AppVolumes) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.Mode = string(randStringApplication(r))
-	this.AppId = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 7)
+	s = append(s, "&types.AppVolumes{")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	if this.Volumes != nil {
+		s = append(s, "Volumes: "+fmt.Sprintf("%#v", this.Volumes)+",\n")
 	}
-	return this
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedContainer(r randyApplication, easy bool) *Container {
-	this := &Container{}
-	this.Type = string(randStringApplication(r))
-	if r.Intn(10) != 0 {
-		this.Docker = NewPopulatedDocker(r, easy)
+func (this *VolumesDestroy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		v7 := r.Intn(5)
-		this.Volumes = make([]*Volume, v7)
-		for i := 0; i < v7; i++ {
-			this.Volumes[i] = NewPopulatedVolume(r, easy)
-		}
+	s := make([]string, 0, 10)
+	s = append(s, "&types.VolumesDestroy{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "AgentId: "+fmt.Sprintf("%#v", this.AgentId)+",\n")
+	if this.Volumes != nil {
+		s = append(s, "Volumes: "+fmt.Sprintf("%#v", this.Volumes)+",\n")
 	}
-	if !easy && r.Intn(10) != 0 {
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestartPolicy) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 5)
+	s = append(s, "&types.RestartPolicy{")
+	s = append(s, "Restarts: "+fmt.Sprintf("%#v", this.Restarts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedDocker(r randyApplication, easy bool) *Docker {
-	this := &Docker{}
-	this.ForcePullImage = bool(bool(r.Intn(2) == 0))
-	this.Image = string(randStringApplication(r))
-	this.Network = string(randStringApplication(r))
-	if r.Intn(10) != 0 {
-		v8 := r.Intn(5)
-		this.Parameters = make([]*Parameter, v8)
-		for i := 0; i < v8; i++ {
-			this.Parameters[i] = NewPopulatedParameter(r, easy)
-		}
+func (this *Task) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if r.Intn(10) != 0 {
-		v9 := r.Intn(5)
-		this.PortMappings = make([]*PortMapping, v9)
-		for i := 0; i < v9; i++ {
-			this.PortMappings[i] = NewPopulatedPortMapping(r, easy)
-		}
+	s := make([]string, 0, 20)
+	s = append(s, "&types.Task{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "TaskInfoId: "+fmt.Sprintf("%#v", this.TaskInfoId)+",\n")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "VersionId: "+fmt.Sprintf("%#v", this.VersionId)+",\n")
+	s = append(s, "SlotId: "+fmt.Sprintf("%#v", this.SlotId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Stdout: "+fmt.Sprintf("%#v", this.Stdout)+",\n")
+	s = append(s, "Stderr: "+fmt.Sprintf("%#v", this.Stderr)+",\n")
+	s = append(s, "HostPorts: "+fmt.Sprintf("%#v", this.HostPorts)+",\n")
+	s = append(s, "OfferId: "+fmt.Sprintf("%#v", this.OfferId)+",\n")
+	s = append(s, "AgentId: "+fmt.Sprintf("%#v", this.AgentId)+",\n")
+	s = append(s, "Ip: "+fmt.Sprintf("%#v", this.Ip)+",\n")
+	s = append(s, "AgentHostName: "+fmt.Sprintf("%#v", this.AgentHostName)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	keysForAgentAttributes := make([]string, 0, len(this.AgentAttributes))
+	for k, _ := range this.AgentAttributes {
+		keysForAgentAttributes = append(keysForAgentAttributes, k)
 	}
-	this.Privileged = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAgentAttributes)
+	mapStringForAgentAttributes := "map[string]string{"
+	for _, k := range keysForAgentAttributes {
+		mapStringForAgentAttributes += fmt.Sprintf("%#v: %#v,", k, this.AgentAttributes[k])
 	}
-	return this
+	mapStringForAgentAttributes += "}"
+	if this.AgentAttributes != nil {
+		s = append(s, "AgentAttributes: "+mapStringForAgentAttributes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedParameter(r randyApplication, easy bool) *Parameter {
-	this := &Parameter{}
-	this.Key = string(randStringApplication(r))
-	this.Value = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+func (this *Job) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 15)
+	s = append(s, "&types.Job{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Completions: "+fmt.Sprintf("%#v", this.Completions)+",\n")
+	s = append(s, "Parallelism: "+fmt.Sprintf("%#v", this.Parallelism)+",\n")
+	s = append(s, "BackoffLimit: "+fmt.Sprintf("%#v", this.BackoffLimit)+",\n")
+	s = append(s, "ActiveDeadlineSeconds: "+fmt.Sprintf("%#v", this.ActiveDeadlineSeconds)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "Succeeded: "+fmt.Sprintf("%#v", this.Succeeded)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "CompletedAt: "+fmt.Sprintf("%#v", this.CompletedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedPortMapping(r randyApplication, easy bool) *PortMapping {
-	this := &PortMapping{}
-	this.ContainerPort = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.ContainerPort *= -1
+func (this *CronJob) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.Name = string(randStringApplication(r))
-	this.Protocol = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 18)
+	s = append(s, "&types.CronJob{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "ConcurrencyPolicy: "+fmt.Sprintf("%#v", this.ConcurrencyPolicy)+",\n")
+	s = append(s, "HistoryLimit: "+fmt.Sprintf("%#v", this.HistoryLimit)+",\n")
+	s = append(s, "StartingDeadlineSeconds: "+fmt.Sprintf("%#v", this.StartingDeadlineSeconds)+",\n")
+	s = append(s, "Completions: "+fmt.Sprintf("%#v", this.Completions)+",\n")
+	s = append(s, "Parallelism: "+fmt.Sprintf("%#v", this.Parallelism)+",\n")
+	s = append(s, "BackoffLimit: "+fmt.Sprintf("%#v", this.BackoffLimit)+",\n")
+	s = append(s, "ActiveDeadlineSeconds: "+fmt.Sprintf("%#v", this.ActiveDeadlineSeconds)+",\n")
+	if this.Template != nil {
+		s = append(s, "Template: "+fmt.Sprintf("%#v", this.Template)+",\n")
 	}
-	return this
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "LastScheduledAt: "+fmt.Sprintf("%#v", this.LastScheduledAt)+",\n")
+	s = append(s, "Runs: "+fmt.Sprintf("%#v", this.Runs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedVolume(r randyApplication, easy bool) *Volume {
-	this := &Volume{}
-	this.ContainerPath = string(randStringApplication(r))
-	this.HostPath = string(randStringApplication(r))
-	this.Mode = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+func (this *Quota) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 10)
+	s = append(s, "&types.Quota{")
+	s = append(s, "RunAs: "+fmt.Sprintf("%#v", this.RunAs)+",\n")
+	s = append(s, "Cpus: "+fmt.Sprintf("%#v", this.Cpus)+",\n")
+	s = append(s, "Mem: "+fmt.Sprintf("%#v", this.Mem)+",\n")
+	s = append(s, "Disk: "+fmt.Sprintf("%#v", this.Disk)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "Ips: "+fmt.Sprintf("%#v", this.Ips)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedKillPolicy(r randyApplication, easy bool) *KillPolicy {
-	this := &KillPolicy{}
-	this.Duration = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Duration *= -1
+func (this *Autoscaler) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
+	s := make([]string, 0, 15)
+	s = append(s, "&types.Autoscaler{")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "MinInstances: "+fmt.Sprintf("%#v", this.MinInstances)+",\n")
+	s = append(s, "MaxInstances: "+fmt.Sprintf("%#v", this.MaxInstances)+",\n")
+	s = append(s, "Metric: "+fmt.Sprintf("%#v", this.Metric)+",\n")
+	s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	s = append(s, "IntervalSeconds: "+fmt.Sprintf("%#v", this.IntervalSeconds)+",\n")
+	s = append(s, "ScaleUpCooldownSeconds: "+fmt.Sprintf("%#v", this.ScaleUpCooldownSeconds)+",\n")
+	s = append(s, "ScaleDownCooldownSeconds: "+fmt.Sprintf("%#v", this.ScaleDownCooldownSeconds)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "LastScaledAt: "+fmt.Sprintf("%#v", this.LastScaledAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScalingSchedule) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
+	s := make([]string, 0, 14)
+	s = append(s, "&types.ScalingSchedule{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "AppId: "+fmt.Sprintf("%#v", this.AppId)+",\n")
+	s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "MinInstances: "+fmt.Sprintf("%#v", this.MinInstances)+",\n")
+	s = append(s, "MaxInstances: "+fmt.Sprintf("%#v", this.MaxInstances)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "LastScheduledAt: "+fmt.Sprintf("%#v", this.LastScheduledAt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedUpdatePolicy(r randyApplication, easy bool) *UpdatePolicy {
-	this := &UpdatePolicy{}
-	this.UpdateDelay = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.UpdateDelay *= -1
+func valueToGoStringApplication(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	this.MaxRetries = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.MaxRetries *= -1
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringApplication(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
 	}
-	this.MaxFailovers = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.MaxFailovers *= -1
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
 	}
-	this.Action = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
 	}
-	return this
+	s += strings.Join(ss, ",") + "})"
+	return s
 }
-
-func NewPopulatedHealthCheck(r randyApplication, easy bool) *HealthCheck {
-	this := &HealthCheck{}
-	this.ID = string(randStringApplication(r))
-	this.Address = string(randStringApplication(r))
-	this.Protocol = string(randStringApplication(r))
-	this.Port = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Port *= -1
+func (m *Application) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	this.PortIndex = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.PortIndex *= -1
+	return dAtA[:n], nil
+}
+
+func (m *Application) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	this.PortName = string(randStringApplication(r))
-	if r.Intn(10) != 0 {
-		this.Command = NewPopulatedCommand(r, easy)
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	this.Path = string(randStringApplication(r))
-	this.ConsecutiveFailures = uint32(r.Uint32())
-	this.GracePeriodSeconds = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.GracePeriodSeconds *= -1
+	if m.Version != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Version.Size()))
+		n1, err := m.Version.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	this.IntervalSeconds = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.IntervalSeconds *= -1
+	if m.ProposedVersion != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ProposedVersion.Size()))
+		n2, err := m.ProposedVersion.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	this.TimeoutSeconds = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.TimeoutSeconds *= -1
+	if len(m.ClusterId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ClusterId)))
+		i += copy(dAtA[i:], m.ClusterId)
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.State) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
-	return this
-}
-
-func NewPopulatedCommand(r randyApplication, easy bool) *Command {
-	this := &Command{}
-	this.Value = string(randStringApplication(r))
-	if !easy && r.Intn(10) != 0 {
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
 	}
-	return this
-}
-
-func NewPopulatedSlot(r randyApplication, easy bool) *Slot {
-	this := &Slot{}
-	this.Index = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Index *= -1
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.UpdatedAt))
 	}
-	this.Id = string(randStringApplication(r))
-	this.AppId = string(randStringApplication(r))
-	this.VersionId = string(randStringApplication(r))
-	this.State = string(randStringApplication(r))
-	this.MarkForDeletion = bool(bool(r.Intn(2) == 0))
-	this.MarkForRollingUpdate = bool(bool(r.Intn(2) == 0))
-	this.Healthy = bool(bool(r.Intn(2) == 0))
-	if r.Intn(10) != 0 {
-		this.CurrentTask = NewPopulatedTask(r, easy)
+	if len(m.StateMessage) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.StateMessage)))
+		i += copy(dAtA[i:], m.StateMessage)
 	}
-	if r.Intn(10) != 0 {
-		this.RestartPolicy = NewPopulatedRestartPolicy(r, easy)
+	if m.UpdatePaused {
+		dAtA[i] = 0x50
+		i++
+		if m.UpdatePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.CanaryWeight != 0 {
+		dAtA[i] = 0x59
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.CanaryWeight))))
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedRestartPolicy(r randyApplication, easy bool) *RestartPolicy {
-	this := &RestartPolicy{}
-	if !easy && r.Intn(10) != 0 {
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedTask(r randyApplication, easy bool) *Task {
-	this := &Task{}
-	this.Id = string(randStringApplication(r))
-	this.TaskInfoId = string(randStringApplication(r))
-	this.AppId = string(randStringApplication(r))
-	this.VersionId = string(randStringApplication(r))
-	this.SlotId = string(randStringApplication(r))
-	this.State = string(randStringApplication(r))
-	this.Stdout = string(randStringApplication(r))
-	this.Stderr = string(randStringApplication(r))
-	v10 := r.Intn(10)
-	this.HostPorts = make([]uint64, v10)
-	for i := 0; i < v10; i++ {
-		this.HostPorts[i] = uint64(uint64(r.Uint32()))
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	this.OfferId = string(randStringApplication(r))
-	this.AgentId = string(randStringApplication(r))
-	this.Ip = string(randStringApplication(r))
-	this.AgentHostName = string(randStringApplication(r))
-	this.Reason = string(randStringApplication(r))
-	this.CreatedAt = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.CreatedAt *= -1
+	if len(m.PerviousVersionID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.PerviousVersionID)))
+		i += copy(dAtA[i:], m.PerviousVersionID)
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.Command) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Command)))
+		i += copy(dAtA[i:], m.Command)
 	}
-	return this
-}
-
-type randyApplication interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApplication(r randyApplication) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if m.Cpus != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Cpus))))
 	}
-	return rune(ru + 61)
-}
-func randStringApplication(r randyApplication) string {
-	v11 := r.Intn(100)
-	tmps := make([]rune, v11)
-	for i := 0; i < v11; i++ {
-		tmps[i] = randUTF8RuneApplication(r)
+	if m.Mem != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Mem))))
 	}
-	return string(tmps)
-}
-func randUnrecognizedApplication(r randyApplication, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApplication(dAtA, r, fieldNumber, wire)
+	if m.Disk != 0 {
+		dAtA[i] = 0x31
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Disk))))
 	}
-	return dAtA
-}
-func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v12 := r.Int63()
-		if r.Intn(2) == 0 {
-			v12 *= -1
-		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v12))
-	case 1:
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if m.Instances != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Instances))
 	}
-	return dAtA
-}
-func encodeVarintPopulateApplication(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *Application) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ProposedVersion != nil {
-		l = m.ProposedVersion.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApplication(uint64(m.CreatedAt))
-	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovApplication(uint64(m.UpdatedAt))
-	}
-	return n
-}
-
-func (m *Version) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.PerviousVersionID)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Command)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Cpus != 0 {
-		n += 9
-	}
-	if m.Mem != 0 {
-		n += 9
-	}
-	if m.Disk != 0 {
-		n += 9
-	}
-	if m.Instances != 0 {
-		n += 1 + sovApplication(uint64(m.Instances))
-	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.RunAs) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.RunAs)))
+		i += copy(dAtA[i:], m.RunAs)
 	}
 	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovApplication(uint64(l))
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Container.Size()))
+		n3, err := m.Container.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
 	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplication(uint64(mapEntrySize))
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x52
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			i = encodeVarintApplication(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
 	if len(m.HealthChecks) > 0 {
-		for _, e := range m.HealthChecks {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+		for _, msg := range m.HealthChecks {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplication(uint64(mapEntrySize))
+		for k, _ := range m.Env {
+			dAtA[i] = 0x62
+			i++
+			v := m.Env[k]
+			mapSize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			i = encodeVarintApplication(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
 	if m.KillPolicy != nil {
-		l = m.KillPolicy.Size()
-		n += 1 + l + sovApplication(uint64(l))
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.KillPolicy.Size()))
+		n4, err := m.KillPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
 	if m.UpdatePolicy != nil {
-		l = m.UpdatePolicy.Size()
-		n += 1 + l + sovApplication(uint64(l))
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.UpdatePolicy.Size()))
+		n5, err := m.UpdatePolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	if len(m.Constraints) > 0 {
 		for _, s := range m.Constraints {
+			dAtA[i] = 0x7a
+			i++
 			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
 	if len(m.Uris) > 0 {
 		for _, s := range m.Uris {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
 			l = len(s)
-			n += 2 + l + sovApplication(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
 	if len(m.Ip) > 0 {
 		for _, s := range m.Ip {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
 			l = len(s)
-			n += 2 + l + sovApplication(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 2 + l + sovApplication(uint64(l))
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 2 + l + sovApplication(uint64(l))
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
 	}
-	return n
-}
-
-func (m *Container) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.PlacementStrategy) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.PlacementStrategy)))
+		i += copy(dAtA[i:], m.PlacementStrategy)
 	}
-	if m.Docker != nil {
-		l = m.Docker.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.BackoffSeconds != 0 {
+		dAtA[i] = 0xa9
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.BackoffSeconds))))
 	}
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.BackoffFactor != 0 {
+		dAtA[i] = 0xb1
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.BackoffFactor))))
 	}
-	return n
-}
-
-func (m *Docker) Size() (n int) {
-	var l int
-	_ = l
-	if m.ForcePullImage {
-		n += 2
+	if m.MaxLaunchDelaySeconds != 0 {
+		dAtA[i] = 0xb9
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.MaxLaunchDelaySeconds))))
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.MaxRestarts != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxRestarts))
 	}
-	l = len(m.Network)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.MaxConsecutiveUnhealthy != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxConsecutiveUnhealthy))
 	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+	if m.MaxUnhealthySeconds != 0 {
+		dAtA[i] = 0xd1
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.MaxUnhealthySeconds))))
+	}
+	if m.ReadinessCheck != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ReadinessCheck.Size()))
+		n6, err := m.ReadinessCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
 	}
-	if len(m.PortMappings) > 0 {
-		for _, e := range m.PortMappings {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.Privileged {
-		n += 2
+	if m.Priority != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Priority))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Parameter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+func (m *Container) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PortMapping) Size() (n int) {
+func (m *Container) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ContainerPort != 0 {
-		n += 1 + sovApplication(uint64(m.ContainerPort))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Docker != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Docker.Size()))
+		n7, err := m.Docker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Volumes) > 0 {
+		for _, msg := range m.Volumes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	if len(m.Entrypoint) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Entrypoint)))
+		i += copy(dAtA[i:], m.Entrypoint)
+	}
+	return i, nil
 }
 
-func (m *Volume) Size() (n int) {
+func (m *Docker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Docker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ForcePullImage {
+		dAtA[i] = 0x8
+		i++
+		if m.ForcePullImage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.HostPath)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Image) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
 	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Network) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Network)))
+		i += copy(dAtA[i:], m.Network)
 	}
-	return n
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PortMappings) > 0 {
+		for _, msg := range m.PortMappings {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Privileged {
+		dAtA[i] = 0x30
+		i++
+		if m.Privileged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *KillPolicy) Size() (n int) {
-	var l int
-	_ = l
-	if m.Duration != 0 {
-		n += 1 + sovApplication(uint64(m.Duration))
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdatePolicy) Size() (n int) {
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.UpdateDelay != 0 {
-		n += 1 + sovApplication(uint64(m.UpdateDelay))
-	}
-	if m.MaxRetries != 0 {
-		n += 1 + sovApplication(uint64(m.MaxRetries))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.MaxFailovers != 0 {
-		n += 1 + sovApplication(uint64(m.MaxFailovers))
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	return i, nil
+}
+
+func (m *PortMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HealthCheck) Size() (n int) {
+func (m *PortMapping) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ContainerPort != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ContainerPort))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
 	}
-	if m.Port != 0 {
-		n += 1 + sovApplication(uint64(m.Port))
-	}
-	if m.PortIndex != 0 {
-		n += 1 + sovApplication(uint64(m.PortIndex))
-	}
-	l = len(m.PortName)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Command != nil {
-		l = m.Command.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	return i, nil
+}
+
+func (m *Volume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ContainerPath)))
+		i += copy(dAtA[i:], m.ContainerPath)
 	}
-	if m.ConsecutiveFailures != 0 {
-		n += 1 + sovApplication(uint64(m.ConsecutiveFailures))
+	if len(m.HostPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.HostPath)))
+		i += copy(dAtA[i:], m.HostPath)
 	}
-	if m.GracePeriodSeconds != 0 {
-		n += 9
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	if m.IntervalSeconds != 0 {
-		n += 9
+	if m.Persistent != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Persistent.Size()))
+		n8, err := m.Persistent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	if m.TimeoutSeconds != 0 {
-		n += 9
+	return i, nil
+}
+
+func (m *PersistentVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Command) Size() (n int) {
+func (m *PersistentVolume) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Size_ != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Size_))))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Slot) Size() (n int) {
+func (m *KillPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KillPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovApplication(uint64(m.Index))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Duration != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Duration))
 	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.DrainDuration != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.DrainDuration))
 	}
-	l = len(m.VersionId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	return i, nil
+}
+
+func (m *UpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateDelay != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.UpdateDelay))
 	}
-	if m.MarkForDeletion {
-		n += 2
+	if m.MaxRetries != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxRetries))
 	}
-	if m.MarkForRollingUpdate {
-		n += 2
+	if m.MaxFailovers != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxFailovers))
 	}
-	if m.Healthy {
-		n += 2
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
 	}
-	if m.CurrentTask != nil {
-		l = m.CurrentTask.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	if m.RestartPolicy != nil {
-		l = m.RestartPolicy.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.BatchSize) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.BatchSize)))
+		i += copy(dAtA[i:], m.BatchSize)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RestartPolicy) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *HealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Task) Size() (n int) {
+func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.TaskInfoId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	l = len(m.VersionId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
 	}
-	l = len(m.SlotId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Port != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Port))
 	}
-	l = len(m.Stdout)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.PortIndex != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.PortIndex))
 	}
-	l = len(m.Stderr)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.PortName) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.PortName)))
+		i += copy(dAtA[i:], m.PortName)
 	}
-	if len(m.HostPorts) > 0 {
-		l = 0
-		for _, e := range m.HostPorts {
-			l += sovApplication(uint64(e))
+	if m.Command != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Command.Size()))
+		n9, err := m.Command.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovApplication(uint64(l)) + l
-	}
-	l = len(m.OfferId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+		i += n9
 	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ConsecutiveFailures))
 	}
-	l = len(m.AgentHostName)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.GracePeriodSeconds != 0 {
+		dAtA[i] = 0x51
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.GracePeriodSeconds))))
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovApplication(uint64(l))
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x59
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.IntervalSeconds))))
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApplication(uint64(m.CreatedAt))
+	if m.TimeoutSeconds != 0 {
+		dAtA[i] = 0x61
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.TimeoutSeconds))))
 	}
-	return n
+	return i, nil
 }
 
-func sovApplication(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ReadinessCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
+	}
+	if len(m.PortName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.PortName)))
+		i += copy(dAtA[i:], m.PortName)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.IntervalSeconds))))
+	}
+	if m.TimeoutSeconds != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.TimeoutSeconds))))
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *Command) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Command) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *Slot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.VersionId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.VersionId)))
+		i += copy(dAtA[i:], m.VersionId)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.MarkForDeletion {
+		dAtA[i] = 0x30
+		i++
+		if m.MarkForDeletion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MarkForRollingUpdate {
+		dAtA[i] = 0x38
+		i++
+		if m.MarkForRollingUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Healthy {
+		dAtA[i] = 0x40
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CurrentTask != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CurrentTask.Size()))
+		n10, err := m.CurrentTask.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.RestartPolicy != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.RestartPolicy.Size()))
+		n11, err := m.RestartPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.UpdateFailures != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.UpdateFailures))
+	}
+	if m.Ready {
+		dAtA[i] = 0x60
+		i++
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Volumes) > 0 {
+		for _, msg := range m.Volumes {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-func sozApplication(x uint64) (n int) {
-	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return i, nil
+}
+
+func (m *SlotVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotVolume) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.ContainerPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ContainerPath)))
+		i += copy(dAtA[i:], m.ContainerPath)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Size_))))
+	}
+	if len(m.AgentId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentId)))
+		i += copy(dAtA[i:], m.AgentId)
+	}
+	if m.Pending {
+		dAtA[i] = 0x28
+		i++
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AppVolumes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppVolumes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Volumes) > 0 {
+		for _, msg := range m.Volumes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *VolumesDestroy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumesDestroy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if len(m.AgentId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentId)))
+		i += copy(dAtA[i:], m.AgentId)
+	}
+	if len(m.Volumes) > 0 {
+		for _, msg := range m.Volumes {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	}
+	return i, nil
+}
+
+func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Restarts != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Restarts))
+	}
+	return i, nil
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.TaskInfoId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.TaskInfoId)))
+		i += copy(dAtA[i:], m.TaskInfoId)
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.VersionId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.VersionId)))
+		i += copy(dAtA[i:], m.VersionId)
+	}
+	if len(m.SlotId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.SlotId)))
+		i += copy(dAtA[i:], m.SlotId)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
+	}
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
+	}
+	if len(m.HostPorts) > 0 {
+		dAtA13 := make([]byte, len(m.HostPorts)*10)
+		var j12 int
+		for _, num := range m.HostPorts {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
+	}
+	if len(m.OfferId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.OfferId)))
+		i += copy(dAtA[i:], m.OfferId)
+	}
+	if len(m.AgentId) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentId)))
+		i += copy(dAtA[i:], m.AgentId)
+	}
+	if len(m.Ip) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Ip)))
+		i += copy(dAtA[i:], m.Ip)
+	}
+	if len(m.AgentHostName) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AgentHostName)))
+		i += copy(dAtA[i:], m.AgentHostName)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	}
+	if len(m.AgentAttributes) > 0 {
+		for k, _ := range m.AgentAttributes {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.AgentAttributes[k]
+			mapSize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			i = encodeVarintApplication(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Completions != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Completions))
+	}
+	if m.Parallelism != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Parallelism))
+	}
+	if m.BackoffLimit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.BackoffLimit))
+	}
+	if m.ActiveDeadlineSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ActiveDeadlineSeconds))
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Succeeded != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Failed))
+	}
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.StartedAt))
+	}
+	if m.CompletedAt != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CompletedAt))
+	}
+	return i, nil
+}
+
+func (m *CronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJob) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Schedule) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Schedule)))
+		i += copy(dAtA[i:], m.Schedule)
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if len(m.ConcurrencyPolicy) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
+		i += copy(dAtA[i:], m.ConcurrencyPolicy)
+	}
+	if m.HistoryLimit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.HistoryLimit))
+	}
+	if m.StartingDeadlineSeconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.StartingDeadlineSeconds))
+	}
+	if m.Completions != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Completions))
+	}
+	if m.Parallelism != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Parallelism))
+	}
+	if m.BackoffLimit != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.BackoffLimit))
+	}
+	if m.ActiveDeadlineSeconds != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ActiveDeadlineSeconds))
+	}
+	if m.Template != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Template.Size()))
+		n14, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.LastScheduledAt != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.LastScheduledAt))
+	}
+	if len(m.Runs) > 0 {
+		for _, s := range m.Runs {
+			dAtA[i] = 0x72
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunAs) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.RunAs)))
+		i += copy(dAtA[i:], m.RunAs)
+	}
+	if m.Cpus != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Cpus))))
+	}
+	if m.Mem != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Mem))))
+	}
+	if m.Disk != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Disk))))
+	}
+	if m.Instances != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Instances))
+	}
+	if m.Ips != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Ips))
+	}
+	return i, nil
+}
+
+func (m *Autoscaler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Autoscaler) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.MinInstances != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MinInstances))
+	}
+	if m.MaxInstances != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxInstances))
+	}
+	if len(m.Metric) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Metric)))
+		i += copy(dAtA[i:], m.Metric)
+	}
+	if m.Target != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Application(dAtA, i, uint64(math.Float64bits(float64(m.Target))))
+	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.IntervalSeconds))
+	}
+	if m.ScaleUpCooldownSeconds != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ScaleUpCooldownSeconds))
+	}
+	if m.ScaleDownCooldownSeconds != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.ScaleDownCooldownSeconds))
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.LastScaledAt != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.LastScaledAt))
+	}
+	return i, nil
+}
+
+func (m *ScalingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.Schedule) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Schedule)))
+		i += copy(dAtA[i:], m.Schedule)
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if m.Instances != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.Instances))
+	}
+	if m.MinInstances != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MinInstances))
+	}
+	if m.MaxInstances != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.MaxInstances))
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.LastScheduledAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.LastScheduledAt))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	return i, nil
+}
+
+func encodeFixed64Application(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Application(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedApplication(r randyApplication, easy bool) *Application {
+	this := &Application{}
+	this.ID = string(randStringApplication(r))
+	this.Name = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		this.Version = NewPopulatedVersion(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.ProposedVersion = NewPopulatedVersion(r, easy)
+	}
+	this.ClusterId = string(randStringApplication(r))
+	this.State = string(randStringApplication(r))
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	this.UpdatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.UpdatedAt *= -1
+	}
+	this.StateMessage = string(randStringApplication(r))
+	this.UpdatePaused = bool(bool(r.Intn(2) == 0))
+	this.CanaryWeight = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.CanaryWeight *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVersion(r randyApplication, easy bool) *Version {
+	this := &Version{}
+	this.ID = string(randStringApplication(r))
+	this.PerviousVersionID = string(randStringApplication(r))
+	this.Command = string(randStringApplication(r))
+	this.Cpus = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Cpus *= -1
+	}
+	this.Mem = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Mem *= -1
+	}
+	this.Disk = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Disk *= -1
+	}
+	this.Instances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Instances *= -1
+	}
+	this.RunAs = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		this.Container = NewPopulatedContainer(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v1; i++ {
+			this.Labels[randStringApplication(r)] = randStringApplication(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(5)
+		this.HealthChecks = make([]*HealthCheck, v2)
+		for i := 0; i < v2; i++ {
+			this.HealthChecks[i] = NewPopulatedHealthCheck(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(10)
+		this.Env = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.Env[randStringApplication(r)] = randStringApplication(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.KillPolicy = NewPopulatedKillPolicy(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.UpdatePolicy = NewPopulatedUpdatePolicy(r, easy)
+	}
+	v4 := r.Intn(10)
+	this.Constraints = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Constraints[i] = string(randStringApplication(r))
+	}
+	v5 := r.Intn(10)
+	this.Uris = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.Uris[i] = string(randStringApplication(r))
+	}
+	v6 := r.Intn(10)
+	this.Ip = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.Ip[i] = string(randStringApplication(r))
+	}
+	this.Mode = string(randStringApplication(r))
+	this.AppId = string(randStringApplication(r))
+	this.PlacementStrategy = string(randStringApplication(r))
+	this.BackoffSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.BackoffSeconds *= -1
+	}
+	this.BackoffFactor = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.BackoffFactor *= -1
+	}
+	this.MaxLaunchDelaySeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxLaunchDelaySeconds *= -1
+	}
+	this.MaxRestarts = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxRestarts *= -1
+	}
+	this.MaxConsecutiveUnhealthy = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxConsecutiveUnhealthy *= -1
+	}
+	this.MaxUnhealthySeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxUnhealthySeconds *= -1
+	}
+	if r.Intn(10) != 0 {
+		this.ReadinessCheck = NewPopulatedReadinessCheck(r, easy)
+	}
+	v7 := r.Intn(10)
+	this.Args = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.Args[i] = string(randStringApplication(r))
+	}
+	this.Priority = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Priority *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedContainer(r randyApplication, easy bool) *Container {
+	this := &Container{}
+	this.Type = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		this.Docker = NewPopulatedDocker(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		v8 := r.Intn(5)
+		this.Volumes = make([]*Volume, v8)
+		for i := 0; i < v8; i++ {
+			this.Volumes[i] = NewPopulatedVolume(r, easy)
+		}
+	}
+	this.Entrypoint = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDocker(r randyApplication, easy bool) *Docker {
+	this := &Docker{}
+	this.ForcePullImage = bool(bool(r.Intn(2) == 0))
+	this.Image = string(randStringApplication(r))
+	this.Network = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.Parameters = make([]*Parameter, v9)
+		for i := 0; i < v9; i++ {
+			this.Parameters[i] = NewPopulatedParameter(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v10 := r.Intn(5)
+		this.PortMappings = make([]*PortMapping, v10)
+		for i := 0; i < v10; i++ {
+			this.PortMappings[i] = NewPopulatedPortMapping(r, easy)
+		}
+	}
+	this.Privileged = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedParameter(r randyApplication, easy bool) *Parameter {
+	this := &Parameter{}
+	this.Key = string(randStringApplication(r))
+	this.Value = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPortMapping(r randyApplication, easy bool) *PortMapping {
+	this := &PortMapping{}
+	this.ContainerPort = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ContainerPort *= -1
+	}
+	this.Name = string(randStringApplication(r))
+	this.Protocol = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVolume(r randyApplication, easy bool) *Volume {
+	this := &Volume{}
+	this.ContainerPath = string(randStringApplication(r))
+	this.HostPath = string(randStringApplication(r))
+	this.Mode = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		this.Persistent = NewPopulatedPersistentVolume(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPersistentVolume(r randyApplication, easy bool) *PersistentVolume {
+	this := &PersistentVolume{}
+	this.Size_ = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Size_ *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedKillPolicy(r randyApplication, easy bool) *KillPolicy {
+	this := &KillPolicy{}
+	this.Duration = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Duration *= -1
+	}
+	this.DrainDuration = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DrainDuration *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdatePolicy(r randyApplication, easy bool) *UpdatePolicy {
+	this := &UpdatePolicy{}
+	this.UpdateDelay = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.UpdateDelay *= -1
+	}
+	this.MaxRetries = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxRetries *= -1
+	}
+	this.MaxFailovers = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxFailovers *= -1
+	}
+	this.Action = string(randStringApplication(r))
+	this.Mode = string(randStringApplication(r))
+	this.BatchSize = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHealthCheck(r randyApplication, easy bool) *HealthCheck {
+	this := &HealthCheck{}
+	this.ID = string(randStringApplication(r))
+	this.Address = string(randStringApplication(r))
+	this.Protocol = string(randStringApplication(r))
+	this.Port = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Port *= -1
+	}
+	this.PortIndex = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.PortIndex *= -1
+	}
+	this.PortName = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		this.Command = NewPopulatedCommand(r, easy)
+	}
+	this.Path = string(randStringApplication(r))
+	this.ConsecutiveFailures = uint32(r.Uint32())
+	this.GracePeriodSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.GracePeriodSeconds *= -1
+	}
+	this.IntervalSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.IntervalSeconds *= -1
+	}
+	this.TimeoutSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.TimeoutSeconds *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReadinessCheck(r randyApplication, easy bool) *ReadinessCheck {
+	this := &ReadinessCheck{}
+	this.Protocol = string(randStringApplication(r))
+	this.PortName = string(randStringApplication(r))
+	this.Path = string(randStringApplication(r))
+	this.IntervalSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.IntervalSeconds *= -1
+	}
+	this.TimeoutSeconds = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.TimeoutSeconds *= -1
+	}
+	this.Port = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Port *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCommand(r randyApplication, easy bool) *Command {
+	this := &Command{}
+	this.Value = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSlot(r randyApplication, easy bool) *Slot {
+	this := &Slot{}
+	this.Index = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
+	}
+	this.Id = string(randStringApplication(r))
+	this.AppId = string(randStringApplication(r))
+	this.VersionId = string(randStringApplication(r))
+	this.State = string(randStringApplication(r))
+	this.MarkForDeletion = bool(bool(r.Intn(2) == 0))
+	this.MarkForRollingUpdate = bool(bool(r.Intn(2) == 0))
+	this.Healthy = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		this.CurrentTask = NewPopulatedTask(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.RestartPolicy = NewPopulatedRestartPolicy(r, easy)
+	}
+	this.UpdateFailures = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.UpdateFailures *= -1
+	}
+	this.Ready = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v11 := r.Intn(5)
+		this.Volumes = make([]*SlotVolume, v11)
+		for i := 0; i < v11; i++ {
+			this.Volumes[i] = NewPopulatedSlotVolume(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSlotVolume(r randyApplication, easy bool) *SlotVolume {
+	this := &SlotVolume{}
+	this.Id = string(randStringApplication(r))
+	this.ContainerPath = string(randStringApplication(r))
+	this.Size_ = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Size_ *= -1
+	}
+	this.AgentId = string(randStringApplication(r))
+	this.Pending = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAppVolumes(r randyApplication, easy bool) *AppVolumes {
+	this := &AppVolumes{}
+	this.AppId = string(randStringApplication(r))
+	this.Index = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
+	}
+	if r.Intn(10) != 0 {
+		v12 := r.Intn(5)
+		this.Volumes = make([]*SlotVolume, v12)
+		for i := 0; i < v12; i++ {
+			this.Volumes[i] = NewPopulatedSlotVolume(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVolumesDestroy(r randyApplication, easy bool) *VolumesDestroy {
+	this := &VolumesDestroy{}
+	this.Id = string(randStringApplication(r))
+	this.AppId = string(randStringApplication(r))
+	this.Role = string(randStringApplication(r))
+	this.AgentId = string(randStringApplication(r))
+	if r.Intn(10) != 0 {
+		v13 := r.Intn(5)
+		this.Volumes = make([]*SlotVolume, v13)
+		for i := 0; i < v13; i++ {
+			this.Volumes[i] = NewPopulatedSlotVolume(r, easy)
+		}
+	}
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRestartPolicy(r randyApplication, easy bool) *RestartPolicy {
+	this := &RestartPolicy{}
+	this.Restarts = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Restarts *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTask(r randyApplication, easy bool) *Task {
+	this := &Task{}
+	this.Id = string(randStringApplication(r))
+	this.TaskInfoId = string(randStringApplication(r))
+	this.AppId = string(randStringApplication(r))
+	this.VersionId = string(randStringApplication(r))
+	this.SlotId = string(randStringApplication(r))
+	this.State = string(randStringApplication(r))
+	this.Stdout = string(randStringApplication(r))
+	this.Stderr = string(randStringApplication(r))
+	v14 := r.Intn(10)
+	this.HostPorts = make([]uint64, v14)
+	for i := 0; i < v14; i++ {
+		this.HostPorts[i] = uint64(uint64(r.Uint32()))
+	}
+	this.OfferId = string(randStringApplication(r))
+	this.AgentId = string(randStringApplication(r))
+	this.Ip = string(randStringApplication(r))
+	this.AgentHostName = string(randStringApplication(r))
+	this.Reason = string(randStringApplication(r))
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	if r.Intn(10) != 0 {
+		v15 := r.Intn(10)
+		this.AgentAttributes = make(map[string]string)
+		for i := 0; i < v15; i++ {
+			this.AgentAttributes[randStringApplication(r)] = randStringApplication(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedJob(r randyApplication, easy bool) *Job {
+	this := &Job{}
+	this.ID = string(randStringApplication(r))
+	this.Completions = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Completions *= -1
+	}
+	this.Parallelism = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Parallelism *= -1
+	}
+	this.BackoffLimit = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.BackoffLimit *= -1
+	}
+	this.ActiveDeadlineSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ActiveDeadlineSeconds *= -1
+	}
+	this.State = string(randStringApplication(r))
+	this.Message = string(randStringApplication(r))
+	this.Succeeded = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Succeeded *= -1
+	}
+	this.Failed = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Failed *= -1
+	}
+	this.StartedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.StartedAt *= -1
+	}
+	this.CompletedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CompletedAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCronJob(r randyApplication, easy bool) *CronJob {
+	this := &CronJob{}
+	this.ID = string(randStringApplication(r))
+	this.Schedule = string(randStringApplication(r))
+	this.TimeZone = string(randStringApplication(r))
+	this.ConcurrencyPolicy = string(randStringApplication(r))
+	this.HistoryLimit = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HistoryLimit *= -1
+	}
+	this.StartingDeadlineSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.StartingDeadlineSeconds *= -1
+	}
+	this.Completions = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Completions *= -1
+	}
+	this.Parallelism = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Parallelism *= -1
+	}
+	this.BackoffLimit = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.BackoffLimit *= -1
+	}
+	this.ActiveDeadlineSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ActiveDeadlineSeconds *= -1
+	}
+	if r.Intn(10) != 0 {
+		this.Template = NewPopulatedVersion(r, easy)
+	}
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	this.LastScheduledAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastScheduledAt *= -1
+	}
+	v16 := r.Intn(10)
+	this.Runs = make([]string, v16)
+	for i := 0; i < v16; i++ {
+		this.Runs[i] = string(randStringApplication(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedQuota(r randyApplication, easy bool) *Quota {
+	this := &Quota{}
+	this.RunAs = string(randStringApplication(r))
+	this.Cpus = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Cpus *= -1
+	}
+	this.Mem = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Mem *= -1
+	}
+	this.Disk = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Disk *= -1
+	}
+	this.Instances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Instances *= -1
+	}
+	this.Ips = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Ips *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAutoscaler(r randyApplication, easy bool) *Autoscaler {
+	this := &Autoscaler{}
+	this.AppId = string(randStringApplication(r))
+	this.MinInstances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinInstances *= -1
+	}
+	this.MaxInstances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxInstances *= -1
+	}
+	this.Metric = string(randStringApplication(r))
+	this.Target = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Target *= -1
+	}
+	this.Query = string(randStringApplication(r))
+	this.IntervalSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.IntervalSeconds *= -1
+	}
+	this.ScaleUpCooldownSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ScaleUpCooldownSeconds *= -1
+	}
+	this.ScaleDownCooldownSeconds = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ScaleDownCooldownSeconds *= -1
+	}
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	this.LastScaledAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastScaledAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScalingSchedule(r randyApplication, easy bool) *ScalingSchedule {
+	this := &ScalingSchedule{}
+	this.ID = string(randStringApplication(r))
+	this.AppId = string(randStringApplication(r))
+	this.Schedule = string(randStringApplication(r))
+	this.TimeZone = string(randStringApplication(r))
+	this.Instances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Instances *= -1
+	}
+	this.MinInstances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinInstances *= -1
+	}
+	this.MaxInstances = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxInstances *= -1
+	}
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	this.LastScheduledAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastScheduledAt *= -1
+	}
+	this.LastError = string(randStringApplication(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplication interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplication(r randyApplication) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplication(r randyApplication) string {
+	v17 := r.Intn(100)
+	tmps := make([]rune, v17)
+	for i := 0; i < v17; i++ {
+		tmps[i] = randUTF8RuneApplication(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplication(r randyApplication, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplication(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
+		v18 := r.Int63()
+		if r.Intn(2) == 0 {
+			v18 *= -1
+		}
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v18))
+	case 1:
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplication(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Application) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ProposedVersion != nil {
+		l = m.ProposedVersion.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.UpdatedAt))
+	}
+	l = len(m.StateMessage)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.UpdatePaused {
+		n += 2
+	}
+	if m.CanaryWeight != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.PerviousVersionID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Cpus != 0 {
+		n += 9
+	}
+	if m.Mem != 0 {
+		n += 9
+	}
+	if m.Disk != 0 {
+		n += 9
+	}
+	if m.Instances != 0 {
+		n += 1 + sovApplication(uint64(m.Instances))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplication(uint64(mapEntrySize))
+		}
+	}
+	if len(m.HealthChecks) > 0 {
+		for _, e := range m.HealthChecks {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplication(uint64(mapEntrySize))
+		}
+	}
+	if m.KillPolicy != nil {
+		l = m.KillPolicy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.UpdatePolicy != nil {
+		l = m.UpdatePolicy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Constraints) > 0 {
+		for _, s := range m.Constraints {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Uris) > 0 {
+		for _, s := range m.Uris {
+			l = len(s)
+			n += 2 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Ip) > 0 {
+		for _, s := range m.Ip {
+			l = len(s)
+			n += 2 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 2 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 2 + l + sovApplication(uint64(l))
+	}
+	l = len(m.PlacementStrategy)
+	if l > 0 {
+		n += 2 + l + sovApplication(uint64(l))
+	}
+	if m.BackoffSeconds != 0 {
+		n += 10
+	}
+	if m.BackoffFactor != 0 {
+		n += 10
+	}
+	if m.MaxLaunchDelaySeconds != 0 {
+		n += 10
+	}
+	if m.MaxRestarts != 0 {
+		n += 2 + sovApplication(uint64(m.MaxRestarts))
+	}
+	if m.MaxConsecutiveUnhealthy != 0 {
+		n += 2 + sovApplication(uint64(m.MaxConsecutiveUnhealthy))
+	}
+	if m.MaxUnhealthySeconds != 0 {
+		n += 10
+	}
+	if m.ReadinessCheck != nil {
+		l = m.ReadinessCheck.Size()
+		n += 2 + l + sovApplication(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 2 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Priority != 0 {
+		n += 2 + sovApplication(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *Container) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Docker != nil {
+		l = m.Docker.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Entrypoint)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *Docker) Size() (n int) {
+	var l int
+	_ = l
+	if m.ForcePullImage {
+		n += 2
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.PortMappings) > 0 {
+		for _, e := range m.PortMappings {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Privileged {
+		n += 2
+	}
+	return n
+}
+
+func (m *Parameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *PortMapping) Size() (n int) {
+	var l int
+	_ = l
+	if m.ContainerPort != 0 {
+		n += 1 + sovApplication(uint64(m.ContainerPort))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *Volume) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerPath)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.HostPath)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Persistent != nil {
+		l = m.Persistent.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *PersistentVolume) Size() (n int) {
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *KillPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		n += 1 + sovApplication(uint64(m.Duration))
+	}
+	if m.DrainDuration != 0 {
+		n += 1 + sovApplication(uint64(m.DrainDuration))
+	}
+	return n
+}
+
+func (m *UpdatePolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.UpdateDelay != 0 {
+		n += 1 + sovApplication(uint64(m.UpdateDelay))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovApplication(uint64(m.MaxRetries))
+	}
+	if m.MaxFailovers != 0 {
+		n += 1 + sovApplication(uint64(m.MaxFailovers))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.BatchSize)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *HealthCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovApplication(uint64(m.Port))
+	}
+	if m.PortIndex != 0 {
+		n += 1 + sovApplication(uint64(m.PortIndex))
+	}
+	l = len(m.PortName)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Command != nil {
+		l = m.Command.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovApplication(uint64(m.ConsecutiveFailures))
+	}
+	if m.GracePeriodSeconds != 0 {
+		n += 9
+	}
+	if m.IntervalSeconds != 0 {
+		n += 9
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ReadinessCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.PortName)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 9
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 9
+	}
+	if m.Port != 0 {
+		n += 1 + sovApplication(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *Command) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *Slot) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovApplication(uint64(m.Index))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.MarkForDeletion {
+		n += 2
+	}
+	if m.MarkForRollingUpdate {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.CurrentTask != nil {
+		l = m.CurrentTask.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.UpdateFailures != 0 {
+		n += 1 + sovApplication(uint64(m.UpdateFailures))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlotVolume) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.ContainerPath)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *AppVolumes) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovApplication(uint64(m.Index))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VolumesDestroy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *RestartPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Restarts != 0 {
+		n += 1 + sovApplication(uint64(m.Restarts))
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.TaskInfoId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.SlotId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.HostPorts) > 0 {
+		l = 0
+		for _, e := range m.HostPorts {
+			l += sovApplication(uint64(e))
+		}
+		n += 1 + sovApplication(uint64(l)) + l
+	}
+	l = len(m.OfferId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AgentHostName)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	if len(m.AgentAttributes) > 0 {
+		for k, v := range m.AgentAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplication(uint64(len(k))) + 1 + len(v) + sovApplication(uint64(len(v)))
+			n += mapEntrySize + 2 + sovApplication(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Completions != 0 {
+		n += 1 + sovApplication(uint64(m.Completions))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovApplication(uint64(m.Parallelism))
+	}
+	if m.BackoffLimit != 0 {
+		n += 1 + sovApplication(uint64(m.BackoffLimit))
+	}
+	if m.ActiveDeadlineSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.ActiveDeadlineSeconds))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovApplication(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovApplication(uint64(m.Failed))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovApplication(uint64(m.StartedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CompletedAt))
+	}
+	return n
+}
+
+func (m *CronJob) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.ConcurrencyPolicy)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovApplication(uint64(m.HistoryLimit))
+	}
+	if m.StartingDeadlineSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.StartingDeadlineSeconds))
+	}
+	if m.Completions != 0 {
+		n += 1 + sovApplication(uint64(m.Completions))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovApplication(uint64(m.Parallelism))
+	}
+	if m.BackoffLimit != 0 {
+		n += 1 + sovApplication(uint64(m.BackoffLimit))
+	}
+	if m.ActiveDeadlineSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.ActiveDeadlineSeconds))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	if m.LastScheduledAt != 0 {
+		n += 1 + sovApplication(uint64(m.LastScheduledAt))
+	}
+	if len(m.Runs) > 0 {
+		for _, s := range m.Runs {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Cpus != 0 {
+		n += 9
+	}
+	if m.Mem != 0 {
+		n += 9
+	}
+	if m.Disk != 0 {
+		n += 9
+	}
+	if m.Instances != 0 {
+		n += 1 + sovApplication(uint64(m.Instances))
+	}
+	if m.Ips != 0 {
+		n += 1 + sovApplication(uint64(m.Ips))
+	}
+	return n
+}
+
+func (m *Autoscaler) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.MinInstances != 0 {
+		n += 1 + sovApplication(uint64(m.MinInstances))
+	}
+	if m.MaxInstances != 0 {
+		n += 1 + sovApplication(uint64(m.MaxInstances))
+	}
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 9
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.IntervalSeconds))
+	}
+	if m.ScaleUpCooldownSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.ScaleUpCooldownSeconds))
+	}
+	if m.ScaleDownCooldownSeconds != 0 {
+		n += 1 + sovApplication(uint64(m.ScaleDownCooldownSeconds))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	if m.LastScaledAt != 0 {
+		n += 1 + sovApplication(uint64(m.LastScaledAt))
+	}
+	return n
+}
+
+func (m *ScalingSchedule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Instances != 0 {
+		n += 1 + sovApplication(uint64(m.Instances))
+	}
+	if m.MinInstances != 0 {
+		n += 1 + sovApplication(uint64(m.MinInstances))
+	}
+	if m.MaxInstances != 0 {
+		n += 1 + sovApplication(uint64(m.MaxInstances))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApplication(uint64(m.CreatedAt))
+	}
+	if m.LastScheduledAt != 0 {
+		n += 1 + sovApplication(uint64(m.LastScheduledAt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func sovApplication(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApplication(x uint64) (n int) {
+	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposedVersion == nil {
+				m.ProposedVersion = &Version{}
+			}
+			if err := m.ProposedVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdatePaused = bool(v != 0)
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CanaryWeight = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerviousVersionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerviousVersionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Cpus = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Mem = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Disk = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Labels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Labels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthChecks = append(m.HealthChecks, &HealthCheck{})
+			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Env[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Env[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KillPolicy == nil {
+				m.KillPolicy = &KillPolicy{}
+			}
+			if err := m.KillPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatePolicy == nil {
+				m.UpdatePolicy = &UpdatePolicy{}
+			}
+			if err := m.UpdatePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uris = append(m.Uris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = append(m.Ip, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.BackoffSeconds = float64(math.Float64frombits(v))
+		case 22:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffFactor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.BackoffFactor = float64(math.Float64frombits(v))
+		case 23:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLaunchDelaySeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MaxLaunchDelaySeconds = float64(math.Float64frombits(v))
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestarts", wireType)
+			}
+			m.MaxRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestarts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveUnhealthy", wireType)
+			}
+			m.MaxConsecutiveUnhealthy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveUnhealthy |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnhealthySeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MaxUnhealthySeconds = float64(math.Float64frombits(v))
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessCheck == nil {
+				m.ReadinessCheck = &ReadinessCheck{}
+			}
+			if err := m.ReadinessCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Container) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Docker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Docker == nil {
+				m.Docker = &Docker{}
+			}
+			if err := m.Docker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, &Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entrypoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Docker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Docker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Docker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcePullImage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForcePullImage = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, &Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortMappings = append(m.PortMappings, &PortMapping{})
+			if err := m.PortMappings[len(m.PortMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Parameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPort", wireType)
+			}
+			m.ContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Volume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Persistent == nil {
+				m.Persistent = &PersistentVolume{}
+			}
+			if err := m.Persistent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersistentVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistentVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistentVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Size_ = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KillPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KillPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KillPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainDuration", wireType)
+			}
+			m.DrainDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainDuration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			m.UpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateDelay |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailovers", wireType)
+			}
+			m.MaxFailovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailovers |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Application) Unmarshal(dAtA []byte) error {
+func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3718,10 +9304,10 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3755,7 +9341,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3780,11 +9366,107 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortIndex", wireType)
+			}
+			m.PortIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PortIndex |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3808,18 +9490,170 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
-			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Command == nil {
+				m.Command = &Command{}
+			}
+			if err := m.Command.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.GracePeriodSeconds = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.IntervalSeconds = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.TimeoutSeconds = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadinessCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3829,28 +9663,24 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProposedVersion == nil {
-				m.ProposedVersion = &Version{}
-			}
-			if err := m.ProposedVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3875,11 +9705,11 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.PortName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3904,32 +9734,49 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.IntervalSeconds = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.TimeoutSeconds = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			m.UpdatedAt = 0
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3939,7 +9786,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= (int64(b) & 0x7F) << shift
+				m.Port |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3965,7 +9812,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,15 +9835,15 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4021,11 +9868,80 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Slot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerviousVersionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4050,11 +9966,11 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerviousVersionID = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4079,67 +9995,13 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Cpus = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Mem = float64(math.Float64frombits(v))
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Disk = float64(math.Float64frombits(v))
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 			}
-			m.Instances = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4149,14 +10011,24 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Instances |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4181,11 +10053,71 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunAs = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkForDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MarkForDeletion = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkForRollingUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MarkForRollingUpdate = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4209,16 +10141,16 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
+			if m.CurrentTask == nil {
+				m.CurrentTask = &Task{}
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4242,7 +10174,18 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFailures", wireType)
+			}
+			m.UpdateFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4252,12 +10195,16 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.UpdateFailures |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4267,76 +10214,127 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			m.Ready = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Volumes = append(m.Volumes, &SlotVolume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthApplication
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Labels[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Labels[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4346,28 +10344,44 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthChecks = append(m.HealthChecks, &HealthCheck{})
-			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Size_ = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4377,19 +10391,26 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4399,12 +10420,67 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppVolumes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppVolumes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppVolumes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4414,74 +10490,43 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthApplication
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Env[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Env[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KillPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4505,18 +10550,66 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KillPolicy == nil {
-				m.KillPolicy = &KillPolicy{}
-			}
-			if err := m.KillPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, &SlotVolume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumesDestroy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumesDestroy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumesDestroy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4526,28 +10619,24 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UpdatePolicy == nil {
-				m.UpdatePolicy = &UpdatePolicy{}
-			}
-			if err := m.UpdatePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4572,11 +10661,11 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Constraints = append(m.Constraints, string(dAtA[iNdEx:postIndex]))
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uris", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4601,11 +10690,11 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uris = append(m.Uris, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4630,13 +10719,13 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = append(m.Ip, string(dAtA[iNdEx:postIndex]))
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4646,26 +10735,28 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode = string(dAtA[iNdEx:postIndex])
+			m.Volumes = append(m.Volumes, &SlotVolume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4675,21 +10766,80 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4711,7 +10861,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4734,15 +10884,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4767,13 +10917,13 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Docker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfoId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4783,30 +10933,26 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Docker == nil {
-				m.Docker = &Docker{}
-			}
-			if err := m.Docker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskInfoId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4816,78 +10962,84 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, &Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Docker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Docker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Docker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForcePullImage", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4897,15 +11049,24 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForcePullImage = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4930,11 +11091,11 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Stdout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4959,13 +11120,75 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Network = string(dAtA[iNdEx:postIndex])
+			m.Stderr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HostPorts = append(m.HostPorts, v)
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HostPorts = append(m.HostPorts, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPorts", wireType)
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4975,28 +11198,26 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, &Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OfferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortMappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5006,28 +11227,26 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortMappings = append(m.PortMappings, &PortMapping{})
-			if err := m.PortMappings[len(m.PortMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5037,65 +11256,24 @@ func (m *Docker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Privileged = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Parameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentHostName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5120,11 +11298,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.AgentHostName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5149,63 +11327,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PortMapping) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PortMapping: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortMapping: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.ContainerPort = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5215,16 +11343,16 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContainerPort |= (int32(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentAttributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5234,26 +11362,34 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5263,20 +11399,70 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.AgentAttributes == nil {
+				m.AgentAttributes = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.AgentAttributes[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.AgentAttributes[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5299,7 +11485,7 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Volume) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5322,15 +11508,15 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5355,13 +11541,13 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
 			}
-			var stringLen uint64
+			m.Completions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5371,24 +11557,71 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Completions |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.HostPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			m.BackoffLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffLimit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+			}
+			m.ActiveDeadlineSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveDeadlineSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5413,63 +11646,13 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KillPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KillPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KillPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5479,66 +11662,26 @@ func (m *KillPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			m.UpdateDelay = 0
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5548,16 +11691,16 @@ func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateDelay |= (int32(b) & 0x7F) << shift
+				m.Succeeded |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			m.MaxRetries = 0
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5567,16 +11710,16 @@ func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRetries |= (int32(b) & 0x7F) << shift
+				m.Failed |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailovers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.MaxFailovers = 0
+			m.StartedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5586,16 +11729,16 @@ func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxFailovers |= (int32(b) & 0x7F) << shift
+				m.StartedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
 			}
-			var stringLen uint64
+			m.CompletedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5605,21 +11748,11 @@ func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CompletedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5641,7 +11774,7 @@ func (m *UpdatePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheck) Unmarshal(dAtA []byte) error {
+func (m *CronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5664,10 +11797,10 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheck: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5701,7 +11834,7 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5726,11 +11859,11 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5755,49 +11888,11 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortIndex", wireType)
-			}
-			m.PortIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PortIndex |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5822,13 +11917,13 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
+			m.ConcurrencyPolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
 			}
-			var msglen int
+			m.HistoryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5838,30 +11933,16 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.HistoryLimit |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Command == nil {
-				m.Command = &Command{}
-			}
-			if err := m.Command.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
 			}
-			var stringLen uint64
+			m.StartingDeadlineSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5871,26 +11952,16 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.StartingDeadlineSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Completions", wireType)
 			}
-			m.ConsecutiveFailures = 0
+			m.Completions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -5900,120 +11971,16 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsecutiveFailures |= (uint32(b) & 0x7F) << shift
+				m.Completions |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.GracePeriodSeconds = float64(math.Float64frombits(v))
-		case 11:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.IntervalSeconds = float64(math.Float64frombits(v))
-		case 12:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.TimeoutSeconds = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Command) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					break
+				}
 			}
-			var stringLen uint64
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6023,76 +11990,35 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Parallelism |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Slot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BackoffLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffLimit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Slot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Slot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
 			}
-			m.Index = 0
+			m.ActiveDeadlineSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6102,16 +12028,16 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (int32(b) & 0x7F) << shift
+				m.ActiveDeadlineSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6121,26 +12047,30 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Template == nil {
+				m.Template = &Version{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6150,24 +12080,33 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledAt", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LastScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastScheduledAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6192,11 +12131,61 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.Runs = append(m.Runs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6221,73 +12210,67 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.RunAs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkForDeletion", wireType)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MarkForDeletion = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkForRollingUpdate", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Cpus = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MarkForRollingUpdate = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Mem = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Healthy = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTask", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Disk = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
 			}
-			var msglen int
+			m.Instances = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6297,30 +12280,16 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Instances |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CurrentTask == nil {
-				m.CurrentTask = &Task{}
-			}
-			if err := m.CurrentTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ips", wireType)
 			}
-			var msglen int
+			m.Ips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6330,25 +12299,11 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Ips |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RestartPolicy == nil {
-				m.RestartPolicy = &RestartPolicy{}
-			}
-			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6370,7 +12325,7 @@ func (m *Slot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+func (m *Autoscaler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6393,65 +12348,82 @@ func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Autoscaler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Autoscaler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Task) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInstances", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.MinInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinInstances |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstances", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInstances |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6476,11 +12448,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Target = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfoId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6505,13 +12495,70 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfoId = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldownSeconds", wireType)
+			}
+			m.ScaleUpCooldownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleUpCooldownSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldownSeconds", wireType)
+			}
+			m.ScaleDownCooldownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleDownCooldownSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6521,26 +12568,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaledAt", wireType)
 			}
-			var stringLen uint64
+			m.LastScaledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6550,24 +12587,64 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LastScaledAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6592,11 +12669,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlotId = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6621,11 +12698,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6650,11 +12727,11 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdout = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6679,75 +12756,32 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stderr = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApplication
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.HostPorts = append(m.HostPorts, v)
-				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.HostPorts = append(m.HostPorts, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostPorts", wireType)
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInstances", wireType)
 			}
-			var stringLen uint64
+			m.MinInstances = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6757,26 +12791,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MinInstances |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OfferId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstances", wireType)
 			}
-			var stringLen uint64
+			m.MaxInstances = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6786,26 +12810,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxInstances |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6815,26 +12829,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentHostName", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledAt", wireType)
 			}
-			var stringLen uint64
+			m.LastScheduledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -6844,24 +12848,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LastScheduledAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AgentHostName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6886,27 +12880,8 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7036,88 +13011,151 @@ var (
 func init() { proto.RegisterFile("application.proto", fileDescriptorApplication) }
 
 var fileDescriptorApplication = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x8e, 0x1b, 0xc5,
-	0x13, 0x8f, 0x3d, 0x6b, 0xaf, 0xdd, 0xb6, 0x77, 0xb3, 0x9d, 0xd5, 0xff, 0x3f, 0x5a, 0xa1, 0x4d,
-	0x34, 0x0a, 0xb0, 0x48, 0xb0, 0x40, 0x22, 0x85, 0x90, 0x5b, 0xb2, 0x9b, 0x28, 0x16, 0x1f, 0x5a,
-	0x75, 0x48, 0xc4, 0x09, 0x69, 0xe2, 0xe9, 0xf5, 0x8e, 0x3c, 0x9e, 0x1e, 0xf5, 0xf4, 0x98, 0xec,
-	0x0b, 0x70, 0x82, 0x27, 0xe0, 0x05, 0x78, 0x04, 0x1e, 0x21, 0x47, 0xae, 0x5c, 0x50, 0x92, 0x23,
-	0x12, 0x12, 0x47, 0x8e, 0x54, 0x55, 0xf7, 0x7c, 0x99, 0x0d, 0x02, 0x0e, 0x96, 0xbb, 0x7e, 0x55,
-	0x35, 0xdd, 0xfd, 0xab, 0xaf, 0x66, 0x3b, 0x61, 0x96, 0x25, 0xf1, 0x2c, 0x34, 0xb1, 0x4a, 0x0f,
-	0x33, 0xad, 0x8c, 0xe2, 0x3d, 0x73, 0x9e, 0xc9, 0x7c, 0x6f, 0x77, 0xae, 0xe6, 0x8a, 0x90, 0xf7,
-	0x71, 0x65, 0x95, 0xc1, 0x77, 0x5d, 0x36, 0xba, 0x5b, 0xbb, 0xf0, 0xff, 0xb1, 0x6e, 0x1c, 0xf9,
-	0x9d, 0x6b, 0x9d, 0x83, 0xe1, 0xbd, 0xfe, 0xab, 0x5f, 0xae, 0x76, 0xa7, 0xc7, 0x02, 0x10, 0xce,
-	0xd9, 0x46, 0x1a, 0x2e, 0xa5, 0xdf, 0x45, 0x8d, 0xa0, 0x35, 0x3f, 0x60, 0x9b, 0x2b, 0xa9, 0x73,
-	0x70, 0xf3, 0x3d, 0x80, 0x47, 0x37, 0xb6, 0x0e, 0x69, 0xab, 0xc3, 0x27, 0x16, 0x15, 0xa5, 0x9a,
-	0xdf, 0x66, 0xdb, 0xb0, 0x5d, 0xa6, 0x72, 0x19, 0x39, 0x9d, 0xbf, 0x71, 0xa1, 0xc7, 0xba, 0x19,
-	0x7f, 0x83, 0x0d, 0x67, 0x49, 0x91, 0x1b, 0xa9, 0xa7, 0x91, 0xdf, 0xa3, 0xcd, 0x6b, 0x80, 0xef,
-	0xb2, 0x5e, 0x6e, 0x42, 0x23, 0xfd, 0x3e, 0x69, 0xac, 0x40, 0x3e, 0x5a, 0xc2, 0x2a, 0xba, 0x6b,
-	0xfc, 0x4d, 0xd0, 0x78, 0xa2, 0x06, 0x50, 0x5b, 0x64, 0x91, 0xd3, 0x0e, 0xac, 0xb6, 0x02, 0x82,
-	0xdf, 0x7a, 0x6c, 0xb3, 0xdc, 0xfb, 0x75, 0x5c, 0xbc, 0xcb, 0x76, 0x32, 0xa9, 0x57, 0xb1, 0x2a,
-	0x72, 0x67, 0x3a, 0x3d, 0x76, 0xc4, 0xfc, 0x55, 0xc1, 0x7d, 0xb6, 0x39, 0x53, 0xcb, 0x65, 0x98,
-	0x46, 0xc4, 0xd2, 0x50, 0x94, 0x22, 0x72, 0x3a, 0xcb, 0x8a, 0x9c, 0xa8, 0xe8, 0x08, 0x5a, 0xf3,
-	0xcb, 0xcc, 0x5b, 0xca, 0x25, 0xdd, 0xb4, 0x23, 0x70, 0x89, 0x56, 0x51, 0x9c, 0x2f, 0xe8, 0x8a,
-	0x60, 0x85, 0x6b, 0xbc, 0x43, 0x9c, 0xc2, 0x65, 0xd3, 0x99, 0xcc, 0xe9, 0x86, 0x3d, 0x51, 0x03,
-	0xc8, 0x8a, 0x2e, 0xd2, 0xbb, 0x39, 0xdd, 0x0e, 0x58, 0x21, 0x81, 0x1f, 0x02, 0x2b, 0x2a, 0x35,
-	0x61, 0x9c, 0x4a, 0xed, 0x0f, 0x89, 0xfd, 0xcb, 0x8e, 0xfd, 0xa3, 0x12, 0x17, 0xb5, 0x09, 0xbf,
-	0xc1, 0xfa, 0x49, 0xf8, 0x54, 0x26, 0xb9, 0xcf, 0xae, 0x79, 0x60, 0xbc, 0xd7, 0x0e, 0xd5, 0xe1,
-	0xa7, 0xa4, 0xbc, 0x9f, 0x1a, 0x7d, 0x2e, 0x9c, 0x25, 0xbf, 0xc5, 0xc6, 0x67, 0x32, 0x4c, 0xcc,
-	0xd9, 0xd1, 0x99, 0x9c, 0x2d, 0x72, 0x7f, 0x44, 0x9e, 0xdc, 0x79, 0x3e, 0xac, 0x55, 0xa2, 0x65,
-	0xc7, 0xdf, 0x61, 0x9e, 0x4c, 0x57, 0xfe, 0x98, 0xcc, 0xff, 0xbf, 0xb6, 0xd1, 0xfd, 0x74, 0x65,
-	0x77, 0x41, 0x1b, 0xfe, 0x21, 0x63, 0x8b, 0x38, 0x49, 0x4e, 0x14, 0xa4, 0xec, 0xb9, 0x3f, 0xa1,
-	0x7b, 0xec, 0x38, 0x8f, 0x4f, 0x2a, 0x85, 0x68, 0x18, 0xf1, 0x8f, 0xd8, 0xd8, 0x06, 0xd8, 0x39,
-	0x6d, 0x91, 0xd3, 0x15, 0xe7, 0xf4, 0xb8, 0xa1, 0x12, 0x2d, 0x43, 0x7e, 0x8d, 0x8d, 0x80, 0x8f,
-	0xdc, 0x68, 0x60, 0xc4, 0xe4, 0xfe, 0x36, 0x1c, 0x6f, 0x28, 0x9a, 0x10, 0x06, 0xa7, 0xd0, 0x71,
-	0xee, 0x5f, 0x26, 0x15, 0xad, 0xf9, 0x16, 0xa4, 0x4d, 0xe6, 0xef, 0x10, 0x02, 0x2b, 0xb4, 0x59,
-	0xaa, 0x48, 0xfa, 0xdc, 0x96, 0x0e, 0xae, 0x31, 0x44, 0x50, 0xa8, 0x90, 0xd2, 0x57, 0x6c, 0x88,
-	0x48, 0xd8, 0xfb, 0x98, 0x8d, 0x1a, 0xac, 0x62, 0x2e, 0x2c, 0xe4, 0xb9, 0x4d, 0x40, 0x81, 0x4b,
-	0x74, 0x5b, 0x85, 0x49, 0x51, 0x96, 0xa1, 0x15, 0xee, 0x74, 0x6f, 0x77, 0xf6, 0x6e, 0xb1, 0x41,
-	0xc9, 0xd3, 0xbf, 0xf1, 0x0b, 0x14, 0x1b, 0x56, 0xd1, 0xc7, 0x93, 0x22, 0x27, 0xce, 0x93, 0xd6,
-	0xfc, 0x4d, 0xd6, 0x8f, 0xd4, 0x6c, 0x01, 0x39, 0xd3, 0x25, 0xda, 0x26, 0x8e, 0xb6, 0x63, 0x02,
-	0x85, 0x53, 0xf2, 0xb7, 0xa1, 0x17, 0xa8, 0xa4, 0x58, 0x42, 0x3e, 0x7a, 0x14, 0xc5, 0xd2, 0xee,
-	0x09, 0xa1, 0xa2, 0xd4, 0x06, 0xbf, 0x76, 0x58, 0xdf, 0xfa, 0xf2, 0xb7, 0xd8, 0xd6, 0xa9, 0xd2,
-	0x33, 0x79, 0x52, 0x24, 0xc9, 0x74, 0x19, 0xce, 0xed, 0xc6, 0x03, 0xb1, 0x86, 0xe2, 0xe9, 0x63,
-	0x52, 0xbb, 0xd3, 0x93, 0x80, 0x75, 0x95, 0x4a, 0xf3, 0xb5, 0xd2, 0x8b, 0xb2, 0xae, 0x9c, 0xc8,
-	0x3f, 0x60, 0x2c, 0x0b, 0x35, 0x74, 0x28, 0x68, 0x12, 0x58, 0x5d, 0x5e, 0x23, 0xd5, 0x4f, 0x4a,
-	0x85, 0x68, 0xd8, 0x60, 0xde, 0x66, 0x4a, 0x9b, 0xcf, 0x20, 0x0c, 0x71, 0x3a, 0xcf, 0xa1, 0xfc,
-	0x9a, 0x79, 0x7b, 0x52, 0xab, 0x44, 0xcb, 0x8e, 0xef, 0xc3, 0x4e, 0x3a, 0x5e, 0xc5, 0x89, 0x9c,
-	0xcb, 0x88, 0x2a, 0x74, 0x20, 0x1a, 0x48, 0x70, 0x93, 0x0d, 0xab, 0x0d, 0xff, 0x69, 0x58, 0x82,
-	0x19, 0x1b, 0x35, 0x76, 0xe4, 0xd7, 0xd9, 0xa4, 0x2a, 0x4a, 0xc4, 0xe9, 0x03, 0x3d, 0xd1, 0x06,
-	0x2f, 0xec, 0xcf, 0x7b, 0x6c, 0x40, 0x4d, 0x7e, 0xa6, 0x12, 0x47, 0x51, 0x25, 0x07, 0x5f, 0xb1,
-	0xbe, 0x8d, 0x4c, 0xfb, 0xfb, 0xa1, 0x39, 0x73, 0x07, 0x6c, 0x83, 0xf8, 0xad, 0x33, 0x95, 0x1b,
-	0x32, 0xb0, 0x7b, 0x54, 0x72, 0x95, 0xe0, 0x5e, 0x9d, 0xe0, 0xc1, 0x01, 0x63, 0x75, 0x35, 0xa2,
-	0x77, 0x54, 0x68, 0x9a, 0x30, 0xf4, 0x79, 0x4f, 0x54, 0x72, 0xf0, 0x6d, 0x87, 0x8d, 0x1f, 0xaf,
-	0x55, 0x9d, 0xad, 0xc2, 0x63, 0x99, 0x84, 0xe7, 0xee, 0xba, 0x4d, 0x08, 0x69, 0x5f, 0x86, 0xcf,
-	0x84, 0x34, 0x3a, 0x86, 0x7c, 0xeb, 0x92, 0x41, 0x03, 0xe1, 0x01, 0x1b, 0x83, 0xf4, 0x20, 0x8c,
-	0x13, 0x85, 0x13, 0x88, 0x0e, 0xd6, 0x13, 0x2d, 0x0c, 0x9a, 0x7b, 0x3f, 0x9c, 0x99, 0x72, 0x12,
-	0x0d, 0x85, 0x93, 0x82, 0xef, 0x3d, 0x36, 0x6a, 0x34, 0xaa, 0xd7, 0x0e, 0x01, 0x48, 0xbf, 0x30,
-	0x8a, 0xb4, 0xcc, 0x73, 0xc7, 0x47, 0x29, 0xfe, 0x1d, 0xed, 0x48, 0x15, 0x26, 0x10, 0xed, 0xd9,
-	0x13, 0xb4, 0xc6, 0x66, 0x8e, 0xff, 0xd3, 0x34, 0x92, 0xcf, 0xa8, 0xf1, 0x43, 0x33, 0xaf, 0x00,
-	0xfa, 0x1a, 0x08, 0x9f, 0x63, 0x70, 0xfb, 0xee, 0x6b, 0x4e, 0xc6, 0x01, 0x5c, 0x8e, 0x96, 0xcd,
-	0xd6, 0x38, 0x3d, 0xb2, 0x68, 0x6b, 0xd4, 0x64, 0x18, 0x3a, 0x3b, 0x11, 0x68, 0x0d, 0x65, 0x72,
-	0x05, 0x5b, 0x99, 0x9c, 0x15, 0x26, 0x5e, 0x49, 0x64, 0xa6, 0x80, 0xf3, 0xd3, 0x68, 0x98, 0x88,
-	0x8b, 0x54, 0x30, 0x42, 0xf8, 0x5c, 0x87, 0x50, 0x9a, 0x52, 0xc7, 0x2a, 0x7a, 0x24, 0xc1, 0x26,
-	0xc2, 0xf1, 0x80, 0x83, 0xe9, 0x02, 0x0d, 0x9c, 0x6f, 0x1b, 0xba, 0x24, 0x4c, 0xc4, 0x30, 0x29,
-	0x8d, 0x47, 0x64, 0xbc, 0x0e, 0x63, 0x2b, 0x30, 0xf1, 0x52, 0xaa, 0xc2, 0x94, 0x86, 0x63, 0x32,
-	0x5c, 0x43, 0x83, 0xab, 0x6c, 0xd3, 0xdd, 0xad, 0x2e, 0x9e, 0x4e, 0xb3, 0x78, 0x7e, 0xee, 0xb2,
-	0x8d, 0x47, 0x89, 0x32, 0xd4, 0x34, 0x88, 0x51, 0x9b, 0x3f, 0x56, 0xa0, 0xde, 0x1c, 0xb9, 0x80,
-	0x61, 0x14, 0xab, 0x3e, 0xec, 0x35, 0xfa, 0x30, 0x46, 0xc4, 0xbd, 0x5c, 0x40, 0x63, 0xd3, 0xa3,
-	0x06, 0xea, 0x47, 0x47, 0xaf, 0xf9, 0xe8, 0x80, 0xbb, 0x2e, 0x43, 0xbd, 0x78, 0xa0, 0x34, 0xe4,
-	0xa8, 0xa4, 0xc4, 0xb2, 0xfd, 0x60, 0x1d, 0x86, 0xc1, 0xba, 0xeb, 0x20, 0xa1, 0x92, 0x04, 0x4a,
-	0xdc, 0x66, 0x3f, 0x85, 0x70, 0x20, 0x2e, 0xd4, 0x61, 0xb6, 0xd9, 0x81, 0x79, 0x4e, 0x21, 0x1c,
-	0x88, 0x52, 0xe4, 0xef, 0xb1, 0xd1, 0x51, 0xa1, 0xb5, 0x4c, 0xcd, 0x17, 0x21, 0xbc, 0x12, 0xec,
-	0x60, 0x1f, 0xb9, 0x3c, 0x40, 0x48, 0x34, 0xf5, 0xfc, 0x0e, 0x9b, 0x40, 0x24, 0x4d, 0xa8, 0x8d,
-	0x1b, 0x86, 0x8c, 0x1c, 0x76, 0x9d, 0x83, 0x68, 0xea, 0x44, 0xdb, 0x34, 0xd8, 0x66, 0x93, 0x96,
-	0x3e, 0xf8, 0xc6, 0x63, 0x1b, 0xf4, 0xd5, 0xad, 0xba, 0x48, 0x88, 0x56, 0x28, 0x50, 0x03, 0xf8,
-	0x34, 0x3d, 0x55, 0xd3, 0x92, 0xee, 0x06, 0xf2, 0x9f, 0x68, 0x87, 0x82, 0xcd, 0x21, 0xb0, 0xd5,
-	0x33, 0xd0, 0x49, 0xaf, 0x79, 0x03, 0xa2, 0xb5, 0x89, 0x20, 0x73, 0x88, 0x56, 0xb4, 0x26, 0xc9,
-	0xe1, 0x52, 0x6b, 0x57, 0x0a, 0x4e, 0xc2, 0xbd, 0xa9, 0x9f, 0x41, 0x69, 0x61, 0x09, 0x78, 0x07,
-	0x1b, 0xa2, 0x06, 0x90, 0x7e, 0x75, 0x7a, 0x4a, 0x6f, 0x50, 0x66, 0x8b, 0xdd, 0x89, 0xd4, 0x06,
-	0xe6, 0x40, 0x2e, 0x68, 0x46, 0xae, 0x0d, 0x58, 0xd1, 0x3d, 0x03, 0xc6, 0x8e, 0x93, 0x0c, 0xfb,
-	0x2c, 0xa9, 0x1e, 0xc2, 0x57, 0xa9, 0x9a, 0x27, 0xb6, 0xcf, 0xb6, 0x40, 0x3c, 0x1f, 0xbc, 0x54,
-	0x73, 0xc8, 0x9e, 0x2d, 0x7b, 0x3e, 0x2b, 0xb5, 0xdf, 0xb4, 0xdb, 0x6b, 0x6f, 0xda, 0x7b, 0xd7,
-	0x9f, 0xbf, 0xdc, 0xbf, 0xf4, 0xe2, 0xe5, 0x7e, 0xe7, 0x77, 0xf8, 0xfd, 0x01, 0xbf, 0x1f, 0x5e,
-	0xed, 0x77, 0x7e, 0x84, 0xdf, 0x73, 0xf8, 0xfd, 0x04, 0xbf, 0x17, 0xf0, 0xfb, 0xf2, 0xd2, 0xd3,
-	0x3e, 0x35, 0xa2, 0x9b, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xfd, 0x76, 0xcc, 0x26, 0x0c,
-	0x00, 0x00,
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x72, 0x1c, 0x49,
+	0x11, 0xde, 0x9e, 0xd6, 0xfc, 0xe5, 0xe8, 0xc7, 0xee, 0xd5, 0x6a, 0x1b, 0x61, 0xb4, 0x13, 0x13,
+	0xcb, 0x32, 0xb0, 0x20, 0x16, 0x2d, 0xe1, 0x35, 0x8e, 0xe0, 0x20, 0x4b, 0x76, 0xac, 0x16, 0xef,
+	0x86, 0x28, 0x63, 0x43, 0x70, 0x2b, 0x75, 0x97, 0x46, 0x1d, 0xea, 0xee, 0x6a, 0xaa, 0xaa, 0x65,
+	0x0d, 0x2f, 0x40, 0x04, 0x1c, 0xe0, 0x04, 0x07, 0x78, 0x00, 0x6e, 0x5c, 0x39, 0x71, 0x00, 0x0e,
+	0x7b, 0xe4, 0x09, 0x88, 0xb5, 0x8e, 0x9c, 0x38, 0xc2, 0x8d, 0xa8, 0xac, 0xea, 0x5f, 0xcd, 0xc8,
+	0x36, 0xa7, 0xa9, 0xfc, 0x32, 0x6b, 0xba, 0x2a, 0xf3, 0xab, 0xac, 0xcc, 0x82, 0xdb, 0x34, 0xcb,
+	0xe2, 0x28, 0xa0, 0x2a, 0xe2, 0xe9, 0x6e, 0x26, 0xb8, 0xe2, 0x5e, 0x57, 0xcd, 0x33, 0x26, 0xb7,
+	0x37, 0x67, 0x7c, 0xc6, 0x11, 0xf9, 0xb6, 0x1e, 0x19, 0xe5, 0xe4, 0xbf, 0x1d, 0x18, 0xed, 0x57,
+	0x53, 0xbc, 0x2d, 0xe8, 0x44, 0xa1, 0xef, 0x8c, 0x9d, 0xe9, 0xf0, 0x41, 0xef, 0xea, 0x9f, 0xef,
+	0x74, 0x8e, 0x0e, 0x49, 0x27, 0x0a, 0x3d, 0x0f, 0x56, 0x52, 0x9a, 0x30, 0xbf, 0xa3, 0x35, 0x04,
+	0xc7, 0xde, 0x14, 0xfa, 0x17, 0x4c, 0xc8, 0x88, 0xa7, 0xbe, 0x3b, 0x76, 0xa6, 0xa3, 0xbd, 0xf5,
+	0x5d, 0xfc, 0xd4, 0xee, 0x33, 0x83, 0x92, 0x42, 0xed, 0xdd, 0x83, 0x8d, 0x4c, 0xf0, 0x8c, 0x4b,
+	0x16, 0x5a, 0x9d, 0xbf, 0xb2, 0x70, 0x46, 0xdb, 0xcc, 0xbb, 0x03, 0xc3, 0x20, 0xce, 0xa5, 0x62,
+	0xe2, 0x28, 0xf4, 0xbb, 0xf8, 0xf1, 0x0a, 0xf0, 0x36, 0xa1, 0x2b, 0x15, 0x55, 0xcc, 0xef, 0xa1,
+	0xc6, 0x08, 0x38, 0x47, 0x30, 0xaa, 0x58, 0xb8, 0xaf, 0xfc, 0xfe, 0xd8, 0x99, 0xba, 0xa4, 0x02,
+	0xb4, 0x36, 0xcf, 0x42, 0xab, 0x1d, 0x18, 0x6d, 0x09, 0x78, 0x13, 0x58, 0xc5, 0x3f, 0xf9, 0x94,
+	0x49, 0x49, 0x67, 0xcc, 0x1f, 0xe2, 0x1f, 0x37, 0x30, 0x6d, 0x63, 0x26, 0x1c, 0xd3, 0x5c, 0xb2,
+	0xd0, 0x87, 0xb1, 0x33, 0x1d, 0x90, 0x06, 0xa6, 0x6d, 0x02, 0x9a, 0x52, 0x31, 0xff, 0x31, 0x8b,
+	0x66, 0x67, 0xca, 0x1f, 0x8d, 0x9d, 0xa9, 0x43, 0x1a, 0xd8, 0xe4, 0x0f, 0x43, 0xe8, 0x17, 0xfb,
+	0x5c, 0xe6, 0xf7, 0x6f, 0xc2, 0xed, 0x8c, 0x89, 0x8b, 0x88, 0xe7, 0xd2, 0x9a, 0x1e, 0x1d, 0xda,
+	0x20, 0x5c, 0x57, 0x78, 0x3e, 0xf4, 0x03, 0x9e, 0x24, 0x34, 0x0d, 0x31, 0x22, 0x43, 0x52, 0x88,
+	0x3a, 0x7e, 0x41, 0x96, 0x4b, 0x74, 0xbb, 0x43, 0x70, 0xec, 0xdd, 0x02, 0x37, 0x61, 0x09, 0x7a,
+	0xd5, 0x21, 0x7a, 0xa8, 0xad, 0xc2, 0x48, 0x9e, 0xa3, 0x3b, 0x1d, 0x82, 0x63, 0xed, 0xaf, 0x28,
+	0x95, 0x8a, 0xa6, 0x01, 0x93, 0xe8, 0xcd, 0x2e, 0xa9, 0x00, 0x1d, 0x01, 0x91, 0xa7, 0xfb, 0x12,
+	0x3d, 0x39, 0x24, 0x46, 0xf0, 0x76, 0x61, 0x18, 0xf0, 0x54, 0xd1, 0x28, 0x65, 0x02, 0x5d, 0x38,
+	0xda, 0xbb, 0x65, 0x23, 0x7d, 0x50, 0xe0, 0xa4, 0x32, 0xf1, 0xf6, 0xa0, 0x17, 0xd3, 0x13, 0x16,
+	0x4b, 0x1f, 0xc6, 0xee, 0x74, 0xb4, 0xb7, 0xdd, 0xa4, 0xc5, 0xee, 0x63, 0x54, 0x3e, 0x4c, 0x95,
+	0x98, 0x13, 0x6b, 0xe9, 0xdd, 0x85, 0xd5, 0x33, 0x46, 0x63, 0x75, 0x76, 0x70, 0xc6, 0x82, 0x73,
+	0xe9, 0x8f, 0x70, 0xa6, 0x67, 0x67, 0x7e, 0x5c, 0xa9, 0x48, 0xc3, 0xce, 0xfb, 0x3a, 0xb8, 0x2c,
+	0xbd, 0xf0, 0x57, 0xd1, 0xfc, 0xed, 0xd6, 0x87, 0x1e, 0xa6, 0x17, 0xe6, 0x2b, 0xda, 0xc6, 0xfb,
+	0x0e, 0xc0, 0x79, 0x14, 0xc7, 0xc7, 0x3c, 0x8e, 0x82, 0xb9, 0xbf, 0x86, 0xfb, 0xb8, 0x6d, 0x67,
+	0xfc, 0xa0, 0x54, 0x90, 0x9a, 0x91, 0xf7, 0x51, 0xc9, 0x0d, 0x33, 0x69, 0x1d, 0x27, 0xbd, 0x69,
+	0x27, 0x3d, 0xad, 0xa9, 0x48, 0xc3, 0xd0, 0x1b, 0xc3, 0x28, 0xe0, 0xa9, 0x54, 0x82, 0x46, 0xa9,
+	0x92, 0xfe, 0xc6, 0xd8, 0x9d, 0x0e, 0x49, 0x1d, 0xd2, 0xc1, 0xc9, 0x45, 0x24, 0xfd, 0x5b, 0xa8,
+	0xc2, 0xb1, 0xb7, 0x0e, 0x9d, 0x28, 0xf3, 0x6f, 0x23, 0xd2, 0x89, 0x32, 0x6d, 0x93, 0xf0, 0x90,
+	0xf9, 0x9e, 0x39, 0xa6, 0x7a, 0xac, 0x43, 0x44, 0xb3, 0xec, 0x28, 0xf4, 0xdf, 0x34, 0x21, 0x42,
+	0x01, 0x89, 0x15, 0xd3, 0x80, 0x25, 0x2c, 0x55, 0x4f, 0x94, 0xa0, 0x8a, 0xcd, 0xe6, 0xfe, 0xa6,
+	0x25, 0x56, 0x5b, 0xe1, 0xbd, 0x07, 0xeb, 0x27, 0x34, 0x38, 0xe7, 0xa7, 0xa7, 0x4f, 0x58, 0xc0,
+	0xd3, 0x50, 0xfa, 0x6f, 0x21, 0x45, 0x5a, 0xa8, 0xf7, 0x2e, 0xac, 0x59, 0xe4, 0x11, 0x0d, 0x14,
+	0x17, 0xfe, 0x16, 0x9a, 0x35, 0x41, 0xef, 0xbb, 0xf0, 0x56, 0x42, 0x2f, 0x1f, 0xd3, 0x3c, 0x0d,
+	0xce, 0x0e, 0x59, 0x4c, 0xe7, 0xc5, 0x9f, 0xbe, 0x8d, 0xd6, 0x8b, 0x95, 0xda, 0x43, 0x09, 0xbd,
+	0x24, 0x4c, 0x2a, 0x2a, 0x94, 0xf4, 0x7d, 0xa4, 0x62, 0x1d, 0xf2, 0xee, 0xc1, 0xdb, 0x09, 0xbd,
+	0x3c, 0xe0, 0xa9, 0x64, 0x41, 0xae, 0xa2, 0x0b, 0xf6, 0x34, 0x35, 0xa1, 0x9f, 0xfb, 0x5f, 0x42,
+	0xeb, 0x65, 0x6a, 0xef, 0x03, 0x78, 0x33, 0xa1, 0x97, 0xa5, 0x5c, 0xac, 0x67, 0x1b, 0xd7, 0xb3,
+	0x48, 0xe5, 0x7d, 0x1f, 0xd6, 0x05, 0xa3, 0x61, 0x94, 0x32, 0x29, 0x91, 0x59, 0xfe, 0x97, 0x31,
+	0xd4, 0x6f, 0xd9, 0x50, 0x93, 0x86, 0x92, 0xb4, 0x8c, 0x75, 0xa0, 0xa8, 0x98, 0x49, 0xff, 0x8e,
+	0x09, 0xa6, 0x1e, 0x7b, 0xdb, 0x30, 0xc8, 0x44, 0xc4, 0x45, 0xa4, 0xe6, 0xfe, 0x57, 0x70, 0xbd,
+	0xa5, 0xbc, 0xfd, 0x3d, 0x18, 0xd5, 0x0e, 0x81, 0x3e, 0xba, 0xe7, 0x6c, 0x6e, 0xf2, 0x05, 0xd1,
+	0x43, 0x1d, 0xe5, 0x0b, 0x1a, 0xe7, 0x45, 0x86, 0x36, 0xc2, 0xfd, 0xce, 0x3d, 0x67, 0xfb, 0x2e,
+	0x0c, 0x0a, 0x5a, 0xbf, 0xce, 0xbc, 0xc9, 0xaf, 0x1d, 0x18, 0x96, 0xa7, 0x55, 0x2f, 0x58, 0x6f,
+	0xcc, 0x4e, 0xc5, 0xb1, 0xf7, 0x55, 0xe8, 0x85, 0x3c, 0x38, 0x67, 0x02, 0x27, 0x8f, 0xf6, 0xd6,
+	0xec, 0xde, 0x0f, 0x11, 0x24, 0x56, 0xe9, 0x7d, 0x0d, 0xfa, 0x17, 0x3c, 0xce, 0x13, 0x26, 0x7d,
+	0x77, 0xec, 0xd6, 0xec, 0x9e, 0x21, 0x4a, 0x0a, 0xad, 0xb7, 0x03, 0xc0, 0xf4, 0x32, 0x33, 0x1e,
+	0xa5, 0x0a, 0x53, 0xd5, 0x90, 0xd4, 0x90, 0xc9, 0xbf, 0x1c, 0xe8, 0x99, 0xff, 0xd6, 0x84, 0x3c,
+	0xe5, 0x22, 0x60, 0xc7, 0x79, 0x1c, 0x1f, 0x25, 0x74, 0x66, 0x16, 0x36, 0x20, 0x2d, 0x54, 0x6f,
+	0x2f, 0x42, 0xb5, 0xdd, 0x1e, 0x0a, 0x3a, 0x4f, 0xa6, 0x4c, 0x3d, 0xe7, 0xe2, 0xbc, 0xc8, 0x93,
+	0x56, 0xf4, 0x3e, 0x00, 0xc8, 0xa8, 0xa0, 0x09, 0x53, 0x4c, 0xe8, 0x6c, 0xe9, 0xd6, 0x52, 0xd7,
+	0x71, 0xa1, 0x20, 0x35, 0x1b, 0x9d, 0x87, 0x32, 0x2e, 0xd4, 0xa7, 0x34, 0xcb, 0xa2, 0x74, 0x26,
+	0xfd, 0x6e, 0x23, 0x0f, 0x1d, 0x57, 0x2a, 0xd2, 0xb0, 0xd3, 0x9b, 0xcd, 0x44, 0x74, 0x11, 0xc5,
+	0x6c, 0xc6, 0x42, 0xcc, 0xb8, 0x03, 0x52, 0x43, 0x26, 0x1f, 0xc2, 0xb0, 0xfc, 0xe0, 0xab, 0xc6,
+	0x6d, 0x12, 0xc0, 0xa8, 0xf6, 0x45, 0x7d, 0x1c, 0xcb, 0x24, 0xab, 0x71, 0xfc, 0x83, 0x2e, 0x69,
+	0x82, 0x0b, 0xef, 0x76, 0xe4, 0x22, 0x57, 0x3c, 0xe0, 0xb1, 0x75, 0x51, 0x29, 0x4f, 0x7e, 0xe7,
+	0x40, 0xcf, 0x84, 0xae, 0xf9, 0x01, 0xaa, 0xce, 0xec, 0x0a, 0x9b, 0xa0, 0xfe, 0xb3, 0x33, 0x2e,
+	0x15, 0x1a, 0x98, 0x8f, 0x94, 0x72, 0x99, 0xb1, 0xdc, 0x5a, 0xc6, 0xfa, 0x08, 0x20, 0xd3, 0x09,
+	0x59, 0x2a, 0x66, 0x79, 0x50, 0x65, 0xea, 0xe3, 0x52, 0x61, 0xd9, 0x53, 0x33, 0x9d, 0xbc, 0x07,
+	0xb7, 0xda, 0x7a, 0xfd, 0x01, 0x19, 0xfd, 0xdc, 0xf0, 0xc3, 0x21, 0x38, 0x9e, 0x7c, 0x06, 0x50,
+	0xe5, 0x6f, 0xbd, 0xbc, 0x30, 0x17, 0x58, 0xff, 0xa0, 0x95, 0x4b, 0x4a, 0x59, 0x6f, 0x30, 0xd4,
+	0xf9, 0xf7, 0xb0, 0x30, 0xe8, 0xa0, 0x41, 0x13, 0x9c, 0xfc, 0xc5, 0x81, 0xd5, 0xa7, 0xad, 0x6c,
+	0x6e, 0xb2, 0x3b, 0x66, 0x30, 0xeb, 0xf6, 0x3a, 0xa4, 0xc3, 0x8f, 0xa9, 0x4b, 0x89, 0x88, 0x49,
+	0xfc, 0xd7, 0x2e, 0xa9, 0x21, 0xba, 0x80, 0x48, 0xe8, 0xe5, 0x23, 0x1a, 0xc5, 0x5c, 0x57, 0x51,
+	0xe8, 0x9f, 0x2e, 0x69, 0x60, 0xde, 0x16, 0xf4, 0x68, 0xa0, 0x8a, 0x6a, 0x6a, 0x48, 0xac, 0x54,
+	0xfa, 0xb4, 0x5b, 0xf3, 0xe9, 0x1d, 0x18, 0x9e, 0x50, 0x15, 0x9c, 0x3d, 0xd1, 0xbe, 0x30, 0xe5,
+	0x52, 0x05, 0x4c, 0x7e, 0xef, 0xc2, 0xa8, 0x76, 0x65, 0x2e, 0x2d, 0x47, 0x7c, 0xe8, 0xd3, 0x30,
+	0x14, 0x4c, 0x4a, 0x1b, 0xc8, 0x42, 0xbc, 0x89, 0x30, 0x7a, 0x3d, 0x9a, 0xfa, 0xb8, 0xca, 0x2e,
+	0xc1, 0xb1, 0x5e, 0x8f, 0xfe, 0x3d, 0x4a, 0x43, 0x76, 0x89, 0x0b, 0xed, 0x92, 0x0a, 0xc0, 0x7f,
+	0xe3, 0x42, 0x7d, 0x46, 0x93, 0x62, 0xb1, 0xa5, 0xac, 0xcb, 0xce, 0xa2, 0xc8, 0xe9, 0x37, 0x8a,
+	0xc8, 0x03, 0x83, 0x36, 0x8a, 0x9e, 0x4c, 0x73, 0xce, 0xd4, 0x26, 0x38, 0xd6, 0x99, 0x3e, 0xa8,
+	0x6e, 0x00, 0xed, 0xcb, 0x5c, 0x30, 0x89, 0x45, 0xca, 0x1a, 0x59, 0xa4, 0xf2, 0x76, 0xc1, 0x9b,
+	0x09, 0x1a, 0xb0, 0x63, 0x26, 0x22, 0x1e, 0x16, 0x57, 0x03, 0x20, 0x9d, 0x16, 0x68, 0xbc, 0x29,
+	0x6c, 0x44, 0xa9, 0x62, 0xe2, 0x82, 0xc6, 0x85, 0xb1, 0xa9, 0xfe, 0xda, 0xb0, 0x4e, 0x62, 0x2a,
+	0x4a, 0x18, 0xcf, 0x55, 0x61, 0xb8, 0x6a, 0x6e, 0xd5, 0x26, 0x3a, 0xf9, 0xab, 0x03, 0xeb, 0xcd,
+	0xfb, 0xa4, 0xe1, 0x6e, 0xa7, 0xe5, 0xee, 0xba, 0xf3, 0x3a, 0x2d, 0xe7, 0x15, 0x2e, 0x71, 0x6b,
+	0x2e, 0x59, 0xb0, 0xe0, 0x95, 0x57, 0x5d, 0x70, 0x77, 0xd1, 0x82, 0xcb, 0x80, 0xf7, 0xaa, 0x80,
+	0x4f, 0xde, 0x81, 0xbe, 0x0d, 0x50, 0x95, 0xbb, 0x9c, 0x7a, 0xee, 0xfa, 0xbb, 0x0b, 0x2b, 0x4f,
+	0x62, 0xae, 0xb4, 0x3a, 0x42, 0x5a, 0x98, 0x63, 0x63, 0x04, 0x2c, 0x75, 0x42, 0xbb, 0x1f, 0x4d,
+	0xc5, 0xb2, 0xac, 0x71, 0xeb, 0x65, 0xcd, 0x1d, 0x18, 0xda, 0xa6, 0xe3, 0x28, 0xb4, 0xa7, 0xa2,
+	0x02, 0xaa, 0x7e, 0xa1, 0x5b, 0xef, 0x17, 0xa6, 0xb0, 0x91, 0x50, 0x71, 0xfe, 0x88, 0x8b, 0x43,
+	0x16, 0x33, 0x3c, 0x4f, 0x26, 0x1d, 0xb7, 0x61, 0x6f, 0x0f, 0x36, 0x2d, 0x44, 0x78, 0x1c, 0x47,
+	0xe9, 0xcc, 0x1c, 0x7a, 0xe4, 0xe1, 0x80, 0x2c, 0xd4, 0xe9, 0x23, 0x53, 0x14, 0x21, 0x03, 0x34,
+	0x2b, 0x44, 0xef, 0x5b, 0x30, 0x3a, 0xc8, 0x85, 0x60, 0xa9, 0xfa, 0x11, 0x95, 0xe7, 0xb6, 0x4e,
+	0x1e, 0x59, 0x32, 0x6b, 0x88, 0xd4, 0xf5, 0xde, 0x7d, 0x58, 0x13, 0xa6, 0xd2, 0xb1, 0xb5, 0x25,
+	0xe0, 0x84, 0xcd, 0xb2, 0xe0, 0xa8, 0xe9, 0x48, 0xd3, 0x54, 0x07, 0xce, 0x24, 0x9f, 0x92, 0xf0,
+	0x23, 0xf4, 0x6d, 0x0b, 0xc5, 0x72, 0x9e, 0xd1, 0x70, 0x8e, 0x44, 0x1c, 0x10, 0x23, 0x78, 0xef,
+	0x57, 0x17, 0xf8, 0xda, 0xd8, 0xad, 0x15, 0xc1, 0x3a, 0x5c, 0xad, 0x4b, 0x7c, 0xf2, 0x4b, 0x07,
+	0xa0, 0xc2, 0x6d, 0xd8, 0x9c, 0x32, 0x6c, 0xd7, 0x6e, 0x8c, 0xce, 0xa2, 0x1b, 0xa3, 0x48, 0xda,
+	0x6e, 0x95, 0xb4, 0x31, 0xf7, 0xcc, 0x58, 0xaa, 0xca, 0xc0, 0x16, 0xa2, 0xd6, 0x64, 0x2c, 0x0d,
+	0xa3, 0x74, 0x86, 0x81, 0x1d, 0x90, 0x42, 0x9c, 0x30, 0x80, 0xfd, 0x2c, 0x7b, 0x66, 0xeb, 0x8b,
+	0x92, 0x32, 0x4e, 0x9d, 0x32, 0x25, 0xdd, 0x3a, 0x75, 0xba, 0xbd, 0xdf, 0x2e, 0x5a, 0x6e, 0xda,
+	0xf3, 0x9f, 0x1c, 0x58, 0xb7, 0x1f, 0x39, 0x64, 0x52, 0x09, 0x3e, 0xbf, 0xb6, 0xef, 0xf2, 0xdb,
+	0x9d, 0xfa, 0xb7, 0x3d, 0x58, 0x11, 0x3c, 0x2e, 0x6f, 0x3f, 0x3d, 0xbe, 0x61, 0x9f, 0xb5, 0x35,
+	0x75, 0x5f, 0xb6, 0xa6, 0x66, 0x17, 0xdc, 0x6b, 0x75, 0xc1, 0x93, 0xf7, 0x61, 0xad, 0x41, 0x18,
+	0x9d, 0x34, 0x44, 0x51, 0x5a, 0x9b, 0x73, 0x57, 0xca, 0x93, 0xdf, 0xae, 0xc0, 0x0a, 0x52, 0xb0,
+	0xbd, 0xa9, 0x1d, 0x00, 0x45, 0xe5, 0xf9, 0x51, 0x7a, 0xca, 0xcb, 0x9d, 0xd5, 0x90, 0xff, 0xeb,
+	0x8c, 0x6e, 0x41, 0x4f, 0xc6, 0x5c, 0x95, 0xed, 0xbe, 0x95, 0x96, 0xf4, 0xfa, 0xda, 0x5a, 0x85,
+	0x3c, 0x37, 0x8d, 0xfe, 0x90, 0x58, 0xc9, 0xe2, 0x4c, 0x08, 0x9b, 0xfc, 0xad, 0xa4, 0xbf, 0x8d,
+	0xa5, 0x07, 0xd7, 0xfb, 0x1c, 0x8e, 0xdd, 0xe9, 0x0a, 0xa9, 0x00, 0xed, 0x7a, 0x7e, 0x7a, 0x8a,
+	0x6f, 0x0d, 0x60, 0x5c, 0x6f, 0xc5, 0x7a, 0x50, 0x46, 0xcd, 0xa0, 0x98, 0x16, 0x6c, 0xd5, 0xfa,
+	0x24, 0xd3, 0x04, 0x47, 0xd5, 0xc7, 0x5c, 0x9a, 0x14, 0xbc, 0x66, 0x08, 0xde, 0x00, 0xf5, 0xfa,
+	0x04, 0xa3, 0x92, 0xa7, 0xd8, 0x21, 0x0e, 0x89, 0x95, 0x9a, 0x51, 0xdb, 0x68, 0xbf, 0x5d, 0x7c,
+	0x02, 0x1b, 0xf8, 0x37, 0xfb, 0x4a, 0x89, 0xe8, 0x24, 0x57, 0xcc, 0x74, 0x83, 0xa3, 0xbd, 0x71,
+	0x2d, 0x6b, 0xec, 0xee, 0x37, 0x4d, 0x4c, 0x43, 0xdb, 0x9e, 0xb8, 0xfd, 0x00, 0x36, 0x17, 0x19,
+	0xbe, 0x56, 0x8b, 0xf0, 0xa2, 0x03, 0xee, 0x27, 0xfc, 0x64, 0x69, 0xb9, 0x80, 0x4d, 0x6d, 0x92,
+	0x99, 0xec, 0x59, 0x54, 0x39, 0x75, 0x48, 0x5b, 0xe8, 0x5a, 0x3a, 0x8e, 0x59, 0x1c, 0xc9, 0xc4,
+	0x56, 0x39, 0x75, 0x48, 0x17, 0x42, 0xb6, 0x7b, 0x7c, 0x1c, 0x25, 0x51, 0x51, 0x44, 0x34, 0x30,
+	0xdd, 0x50, 0xea, 0xd2, 0xe7, 0x82, 0x1d, 0x32, 0x1a, 0xc6, 0x51, 0xca, 0xea, 0xd7, 0x93, 0x4b,
+	0x16, 0x2b, 0x97, 0x30, 0xca, 0x87, 0x7e, 0x62, 0x1f, 0x7f, 0x0c, 0xa5, 0x0a, 0x51, 0xc7, 0x46,
+	0xe6, 0x41, 0xc0, 0x58, 0xc8, 0x42, 0xa4, 0x55, 0x97, 0x54, 0x80, 0x8e, 0xe8, 0x29, 0x8d, 0x62,
+	0x16, 0x62, 0x22, 0xef, 0x12, 0x2b, 0xe1, 0x2c, 0x7d, 0x8c, 0x30, 0xa2, 0x60, 0x22, 0x5a, 0x02,
+	0x35, 0x0f, 0xa1, 0x7e, 0x84, 0xfa, 0x3a, 0x34, 0xf9, 0xd5, 0x0a, 0xf4, 0x0f, 0x04, 0x4f, 0x6f,
+	0xf2, 0xf3, 0x36, 0x0c, 0x64, 0x70, 0xc6, 0xc2, 0x3c, 0x2e, 0x6f, 0xfc, 0x42, 0xd6, 0x3a, 0x7d,
+	0x3b, 0xff, 0x94, 0xa7, 0x45, 0x9a, 0x29, 0x65, 0xfd, 0x08, 0x10, 0xf0, 0x34, 0xc0, 0x4b, 0x26,
+	0x98, 0xdb, 0x6b, 0xc5, 0x9c, 0xc8, 0xeb, 0x0a, 0x1d, 0x89, 0xb3, 0x48, 0x2a, 0x2e, 0xe6, 0x26,
+	0x12, 0xa6, 0x6a, 0x6b, 0x60, 0xba, 0x05, 0xc7, 0xcd, 0x45, 0xe9, 0xac, 0x1d, 0x0b, 0x93, 0x83,
+	0x96, 0xa9, 0xdb, 0x5c, 0xe9, 0xbf, 0x94, 0x2b, 0x83, 0x97, 0x73, 0x65, 0xf8, 0x3a, 0x5c, 0x81,
+	0x9b, 0xb8, 0xf2, 0x0d, 0x18, 0x28, 0x96, 0x64, 0xb1, 0xa6, 0xcb, 0x68, 0xe1, 0xd3, 0x65, 0xa9,
+	0x6f, 0x9e, 0xe1, 0xd5, 0xf6, 0x19, 0x9e, 0xc2, 0x46, 0x4c, 0xa5, 0x7a, 0x62, 0xe3, 0xa3, 0x6d,
+	0xd6, 0xd0, 0xa6, 0x0d, 0xe3, 0xe5, 0x90, 0xa7, 0xd2, 0x5f, 0x37, 0x6f, 0x04, 0x7a, 0x3c, 0xf9,
+	0x85, 0x03, 0xdd, 0x1f, 0xe6, 0x5c, 0xd1, 0xea, 0xe5, 0xcd, 0xa9, 0xbf, 0xbc, 0x15, 0xef, 0x7c,
+	0x9d, 0xeb, 0xef, 0x7c, 0xee, 0xf5, 0x77, 0xbe, 0x95, 0x65, 0xef, 0x7c, 0xdd, 0xf6, 0x3b, 0xdf,
+	0x2d, 0x70, 0xa3, 0x4c, 0xda, 0x82, 0x4e, 0x0f, 0x27, 0xbf, 0x71, 0x01, 0xf6, 0x73, 0xc5, 0x65,
+	0x40, 0x63, 0x26, 0x96, 0xdc, 0xad, 0xba, 0x8b, 0x89, 0xd2, 0xa3, 0xf2, 0x7f, 0x3b, 0xb6, 0x8b,
+	0xa9, 0x61, 0xb6, 0xd3, 0xa9, 0x6c, 0xaa, 0x4e, 0xa7, 0xb2, 0xd9, 0x82, 0x5e, 0xa2, 0x1b, 0xa3,
+	0xa0, 0xe8, 0x74, 0x8c, 0xa4, 0x71, 0x45, 0xc5, 0x8c, 0x29, 0x5b, 0x88, 0x5a, 0x49, 0xaf, 0xe6,
+	0x67, 0x39, 0x13, 0xf3, 0xe2, 0x68, 0xa3, 0xb0, 0xa8, 0xd0, 0x35, 0xcf, 0xc3, 0x6d, 0xd8, 0xbb,
+	0x0b, 0x5b, 0xb8, 0xaf, 0xa7, 0xd9, 0x01, 0xe7, 0x71, 0xc8, 0x9f, 0xa7, 0xc5, 0x04, 0xf3, 0x62,
+	0xbc, 0x44, 0xeb, 0xdd, 0x07, 0x1f, 0x35, 0x87, 0xfc, 0x79, 0xda, 0x9e, 0x39, 0xc4, 0x99, 0x4b,
+	0xf5, 0x4d, 0xda, 0x40, 0x9b, 0x36, 0x13, 0x58, 0x35, 0xfc, 0xa0, 0x71, 0x2d, 0x53, 0x34, 0xb0,
+	0xc9, 0xdf, 0x3a, 0xb0, 0xa1, 0x85, 0x28, 0x9d, 0x15, 0x3c, 0x5a, 0x9a, 0x32, 0x16, 0xd7, 0x23,
+	0xf5, 0x44, 0xe2, 0xde, 0x90, 0x48, 0x56, 0x5a, 0x89, 0xe4, 0x66, 0xf2, 0xb4, 0x59, 0xd0, 0x7b,
+	0x05, 0x16, 0xf4, 0x17, 0xb0, 0xa0, 0xe1, 0xa1, 0xc1, 0x2b, 0x1c, 0xac, 0xe1, 0xe2, 0x83, 0x75,
+	0x07, 0x86, 0x1a, 0x7a, 0x28, 0x04, 0x17, 0xf6, 0xa2, 0xaf, 0x80, 0x07, 0xef, 0x7e, 0xfe, 0x62,
+	0xe7, 0x8d, 0x2f, 0x5e, 0xec, 0x38, 0xff, 0x7e, 0xb1, 0xe3, 0xfc, 0xe7, 0xc5, 0x8e, 0xf3, 0xc7,
+	0xab, 0x1d, 0xe7, 0xcf, 0x57, 0x3b, 0xce, 0xe7, 0x57, 0x3b, 0xce, 0x3f, 0xae, 0x76, 0x9c, 0x2f,
+	0xae, 0x76, 0x9c, 0x9f, 0xbc, 0x71, 0xd2, 0xc3, 0x86, 0xeb, 0xc3, 0xff, 0x0d, 0x00, 0xc2, 0x1c,
+	0xaa, 0xc2, 0x73, 0x19, 0x00, 0x00,
 }
@@ -74,6 +74,13 @@ type StoreAction struct {
 	//	*StoreAction_Version
 	//	*StoreAction_Slot
 	//	*StoreAction_Task
+	//	*StoreAction_Job
+	//	*StoreAction_CronJob
+	//	*StoreAction_Quota
+	//	*StoreAction_Autoscaler
+	//	*StoreAction_ScalingSchedule
+	//	*StoreAction_AppVolumes
+	//	*StoreAction_VolumesDestroy
 	Target isStoreAction_Target `protobuf_oneof:"target"`
 }
 
@@ -105,12 +112,40 @@ type StoreAction_Slot struct {
 type StoreAction_Task struct {
 	Task *Task `protobuf:"bytes,6,opt,name=task,oneof"`
 }
+type StoreAction_Job struct {
+	Job *Job `protobuf:"bytes,7,opt,name=job,oneof"`
+}
+type StoreAction_CronJob struct {
+	CronJob *CronJob `protobuf:"bytes,8,opt,name=cronJob,oneof"`
+}
+type StoreAction_Quota struct {
+	Quota *Quota `protobuf:"bytes,9,opt,name=quota,oneof"`
+}
+type StoreAction_Autoscaler struct {
+	Autoscaler *Autoscaler `protobuf:"bytes,10,opt,name=autoscaler,oneof"`
+}
+type StoreAction_ScalingSchedule struct {
+	ScalingSchedule *ScalingSchedule `protobuf:"bytes,11,opt,name=scalingSchedule,oneof"`
+}
+type StoreAction_AppVolumes struct {
+	AppVolumes *AppVolumes `protobuf:"bytes,12,opt,name=appVolumes,oneof"`
+}
+type StoreAction_VolumesDestroy struct {
+	VolumesDestroy *VolumesDestroy `protobuf:"bytes,13,opt,name=volumesDestroy,oneof"`
+}
 
-func (*StoreAction_Application) isStoreAction_Target() {}
-func (*StoreAction_Framework) isStoreAction_Target()   {}
-func (*StoreAction_Version) isStoreAction_Target()     {}
-func (*StoreAction_Slot) isStoreAction_Target()        {}
-func (*StoreAction_Task) isStoreAction_Target()        {}
+func (*StoreAction_Application) isStoreAction_Target()     {}
+func (*StoreAction_Framework) isStoreAction_Target()       {}
+func (*StoreAction_Version) isStoreAction_Target()         {}
+func (*StoreAction_Slot) isStoreAction_Target()            {}
+func (*StoreAction_Task) isStoreAction_Target()            {}
+func (*StoreAction_Job) isStoreAction_Target()             {}
+func (*StoreAction_CronJob) isStoreAction_Target()         {}
+func (*StoreAction_Quota) isStoreAction_Target()           {}
+func (*StoreAction_Autoscaler) isStoreAction_Target()      {}
+func (*StoreAction_ScalingSchedule) isStoreAction_Target() {}
+func (*StoreAction_AppVolumes) isStoreAction_Target()      {}
+func (*StoreAction_VolumesDestroy) isStoreAction_Target()  {}
 
 func (m *StoreAction) GetTarget() isStoreAction_Target {
 	if m != nil {
@@ -154,6 +189,55 @@ func (m *StoreAction) GetTask() *Task {
 	return nil
 }
 
+func (m *StoreAction) GetJob() *Job {
+	if x, ok := m.GetTarget().(*StoreAction_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+func (m *StoreAction) GetCronJob() *CronJob {
+	if x, ok := m.GetTarget().(*StoreAction_CronJob); ok {
+		return x.CronJob
+	}
+	return nil
+}
+
+func (m *StoreAction) GetQuota() *Quota {
+	if x, ok := m.GetTarget().(*StoreAction_Quota); ok {
+		return x.Quota
+	}
+	return nil
+}
+
+func (m *StoreAction) GetAutoscaler() *Autoscaler {
+	if x, ok := m.GetTarget().(*StoreAction_Autoscaler); ok {
+		return x.Autoscaler
+	}
+	return nil
+}
+
+func (m *StoreAction) GetScalingSchedule() *ScalingSchedule {
+	if x, ok := m.GetTarget().(*StoreAction_ScalingSchedule); ok {
+		return x.ScalingSchedule
+	}
+	return nil
+}
+
+func (m *StoreAction) GetAppVolumes() *AppVolumes {
+	if x, ok := m.GetTarget().(*StoreAction_AppVolumes); ok {
+		return x.AppVolumes
+	}
+	return nil
+}
+
+func (m *StoreAction) GetVolumesDestroy() *VolumesDestroy {
+	if x, ok := m.GetTarget().(*StoreAction_VolumesDestroy); ok {
+		return x.VolumesDestroy
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _StoreAction_OneofMarshaler, _StoreAction_OneofUnmarshaler, _StoreAction_OneofSizer, []interface{}{
@@ -162,6 +246,13 @@ func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*StoreAction_Version)(nil),
 		(*StoreAction_Slot)(nil),
 		(*StoreAction_Task)(nil),
+		(*StoreAction_Job)(nil),
+		(*StoreAction_CronJob)(nil),
+		(*StoreAction_Quota)(nil),
+		(*StoreAction_Autoscaler)(nil),
+		(*StoreAction_ScalingSchedule)(nil),
+		(*StoreAction_AppVolumes)(nil),
+		(*StoreAction_VolumesDestroy)(nil),
 	}
 }
 
@@ -194,6 +285,41 @@ func _StoreAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Task); err != nil {
 			return err
 		}
+	case *StoreAction_Job:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Job); err != nil {
+			return err
+		}
+	case *StoreAction_CronJob:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronJob); err != nil {
+			return err
+		}
+	case *StoreAction_Quota:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Quota); err != nil {
+			return err
+		}
+	case *StoreAction_Autoscaler:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Autoscaler); err != nil {
+			return err
+		}
+	case *StoreAction_ScalingSchedule:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ScalingSchedule); err != nil {
+			return err
+		}
+	case *StoreAction_AppVolumes:
+		_ = b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AppVolumes); err != nil {
+			return err
+		}
+	case *StoreAction_VolumesDestroy:
+		_ = b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.VolumesDestroy); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("StoreAction.Target has unexpected type %T", x)
@@ -244,6 +370,62 @@ func _StoreAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Target = &StoreAction_Task{msg}
 		return true, err
+	case 7: // target.job
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Job)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_Job{msg}
+		return true, err
+	case 8: // target.cronJob
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CronJob)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_CronJob{msg}
+		return true, err
+	case 9: // target.quota
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Quota)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_Quota{msg}
+		return true, err
+	case 10: // target.autoscaler
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Autoscaler)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_Autoscaler{msg}
+		return true, err
+	case 11: // target.scalingSchedule
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ScalingSchedule)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_ScalingSchedule{msg}
+		return true, err
+	case 12: // target.appVolumes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(AppVolumes)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_AppVolumes{msg}
+		return true, err
+	case 13: // target.volumesDestroy
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(VolumesDestroy)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_VolumesDestroy{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -278,6 +460,41 @@ func _StoreAction_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(6<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *StoreAction_Job:
+		s := proto.Size(x.Job)
+		n += proto.SizeVarint(7<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_CronJob:
+		s := proto.Size(x.CronJob)
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_Quota:
+		s := proto.Size(x.Quota)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_Autoscaler:
+		s := proto.Size(x.Autoscaler)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_ScalingSchedule:
+		s := proto.Size(x.ScalingSchedule)
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_AppVolumes:
+		s := proto.Size(x.AppVolumes)
+		n += proto.SizeVarint(12<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_VolumesDestroy:
+		s := proto.Size(x.VolumesDestroy)
+		n += proto.SizeVarint(13<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -565,6 +782,216 @@ func (this *StoreAction_Task) VerboseEqual(that interface{}) error {
 	}
 	return nil
 }
+func (this *StoreAction_Job) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_Job)
+	if !ok {
+		that2, ok := that.(StoreAction_Job)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_Job")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_Job but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_Job but is not nil && this == nil")
+	}
+	if !this.Job.Equal(that1.Job) {
+		return fmt.Errorf("Job this(%v) Not Equal that(%v)", this.Job, that1.Job)
+	}
+	return nil
+}
+func (this *StoreAction_CronJob) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_CronJob)
+	if !ok {
+		that2, ok := that.(StoreAction_CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_CronJob")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_CronJob but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_CronJob but is not nil && this == nil")
+	}
+	if !this.CronJob.Equal(that1.CronJob) {
+		return fmt.Errorf("CronJob this(%v) Not Equal that(%v)", this.CronJob, that1.CronJob)
+	}
+	return nil
+}
+func (this *StoreAction_Quota) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_Quota)
+	if !ok {
+		that2, ok := that.(StoreAction_Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_Quota")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_Quota but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_Quota but is not nil && this == nil")
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return fmt.Errorf("Quota this(%v) Not Equal that(%v)", this.Quota, that1.Quota)
+	}
+	return nil
+}
+func (this *StoreAction_Autoscaler) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_Autoscaler)
+	if !ok {
+		that2, ok := that.(StoreAction_Autoscaler)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_Autoscaler")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_Autoscaler but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_Autoscaler but is not nil && this == nil")
+	}
+	if !this.Autoscaler.Equal(that1.Autoscaler) {
+		return fmt.Errorf("Autoscaler this(%v) Not Equal that(%v)", this.Autoscaler, that1.Autoscaler)
+	}
+	return nil
+}
+func (this *StoreAction_ScalingSchedule) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_ScalingSchedule)
+	if !ok {
+		that2, ok := that.(StoreAction_ScalingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_ScalingSchedule")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_ScalingSchedule but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_ScalingSchedule but is not nil && this == nil")
+	}
+	if !this.ScalingSchedule.Equal(that1.ScalingSchedule) {
+		return fmt.Errorf("ScalingSchedule this(%v) Not Equal that(%v)", this.ScalingSchedule, that1.ScalingSchedule)
+	}
+	return nil
+}
+func (this *StoreAction_AppVolumes) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_AppVolumes)
+	if !ok {
+		that2, ok := that.(StoreAction_AppVolumes)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_AppVolumes")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_AppVolumes but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_AppVolumes but is not nil && this == nil")
+	}
+	if !this.AppVolumes.Equal(that1.AppVolumes) {
+		return fmt.Errorf("AppVolumes this(%v) Not Equal that(%v)", this.AppVolumes, that1.AppVolumes)
+	}
+	return nil
+}
+func (this *StoreAction_VolumesDestroy) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*StoreAction_VolumesDestroy)
+	if !ok {
+		that2, ok := that.(StoreAction_VolumesDestroy)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StoreAction_VolumesDestroy")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StoreAction_VolumesDestroy but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StoreAction_VolumesDestroy but is not nil && this == nil")
+	}
+	if !this.VolumesDestroy.Equal(that1.VolumesDestroy) {
+		return fmt.Errorf("VolumesDestroy this(%v) Not Equal that(%v)", this.VolumesDestroy, that1.VolumesDestroy)
+	}
+	return nil
+}
 func (this *StoreAction) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -754,12 +1181,222 @@ func (this *StoreAction_Task) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Framework) VerboseEqual(that interface{}) error {
+func (this *StoreAction_Job) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
-			return nil
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_Job)
+	if !ok {
+		that2, ok := that.(StoreAction_Job)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Job.Equal(that1.Job) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_CronJob) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_CronJob)
+	if !ok {
+		that2, ok := that.(StoreAction_CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		return fmt.Errorf("that == nil && this != nil")
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.CronJob.Equal(that1.CronJob) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_Quota) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_Quota)
+	if !ok {
+		that2, ok := that.(StoreAction_Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_Autoscaler) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_Autoscaler)
+	if !ok {
+		that2, ok := that.(StoreAction_Autoscaler)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Autoscaler.Equal(that1.Autoscaler) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_ScalingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_ScalingSchedule)
+	if !ok {
+		that2, ok := that.(StoreAction_ScalingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.ScalingSchedule.Equal(that1.ScalingSchedule) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_AppVolumes) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_AppVolumes)
+	if !ok {
+		that2, ok := that.(StoreAction_AppVolumes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.AppVolumes.Equal(that1.AppVolumes) {
+		return false
+	}
+	return true
+}
+func (this *StoreAction_VolumesDestroy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StoreAction_VolumesDestroy)
+	if !ok {
+		that2, ok := that.(StoreAction_VolumesDestroy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.VolumesDestroy.Equal(that1.VolumesDestroy) {
+		return false
+	}
+	return true
+}
+func (this *Framework) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
 
 	that1, ok := that.(*Framework)
@@ -831,7 +1468,7 @@ func (this *StoreAction) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 17)
 	s = append(s, "&types.StoreAction{")
 	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
 	if this.Target != nil {
@@ -880,6 +1517,62 @@ func (this *StoreAction_Task) GoString() string {
 		`Task:` + fmt.Sprintf("%#v", this.Task) + `}`}, ", ")
 	return s
 }
+func (this *StoreAction_Job) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_Job{` +
+		`Job:` + fmt.Sprintf("%#v", this.Job) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_CronJob) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_CronJob{` +
+		`CronJob:` + fmt.Sprintf("%#v", this.CronJob) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_Quota) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_Quota{` +
+		`Quota:` + fmt.Sprintf("%#v", this.Quota) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_Autoscaler) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_Autoscaler{` +
+		`Autoscaler:` + fmt.Sprintf("%#v", this.Autoscaler) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_ScalingSchedule) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_ScalingSchedule{` +
+		`ScalingSchedule:` + fmt.Sprintf("%#v", this.ScalingSchedule) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_AppVolumes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_AppVolumes{` +
+		`AppVolumes:` + fmt.Sprintf("%#v", this.AppVolumes) + `}`}, ", ")
+	return s
+}
+func (this *StoreAction_VolumesDestroy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&types.StoreAction_VolumesDestroy{` +
+		`VolumesDestroy:` + fmt.Sprintf("%#v", this.VolumesDestroy) + `}`}, ", ")
+	return s
+}
 func (this *Framework) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1042,12 +1735,110 @@ func (m *StoreAction_Task) MarshalTo(dAtA []byte) (int, error) {
 	if m.Task != nil {
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintRaft(dAtA, i, uint64(m.Task.Size()))
-		n6, err := m.Task.MarshalTo(dAtA[i:])
+		i = encodeVarintRaft(dAtA, i, uint64(m.Task.Size()))
+		n6, err := m.Task.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+func (m *StoreAction_Job) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Job != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Job.Size()))
+		n7, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *StoreAction_CronJob) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronJob != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.CronJob.Size()))
+		n8, err := m.CronJob.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *StoreAction_Quota) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Quota != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Quota.Size()))
+		n9, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *StoreAction_Autoscaler) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Autoscaler != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Autoscaler.Size()))
+		n10, err := m.Autoscaler.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *StoreAction_ScalingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ScalingSchedule != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.ScalingSchedule.Size()))
+		n11, err := m.ScalingSchedule.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *StoreAction_AppVolumes) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AppVolumes != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.AppVolumes.Size()))
+		n12, err := m.AppVolumes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *StoreAction_VolumesDestroy) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.VolumesDestroy != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.VolumesDestroy.Size()))
+		n13, err := m.VolumesDestroy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n13
 	}
 	return i, nil
 }
@@ -1120,7 +1911,7 @@ func NewPopulatedInternalRaftRequest(r randyRaft, easy bool) *InternalRaftReques
 func NewPopulatedStoreAction(r randyRaft, easy bool) *StoreAction {
 	this := &StoreAction{}
 	this.Action = StoreActionKind([]int32{0, 1, 2, 3}[r.Intn(4)])
-	oneofNumber_Target := []int32{2, 3, 4, 5, 6}[r.Intn(5)]
+	oneofNumber_Target := []int32{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}[r.Intn(12)]
 	switch oneofNumber_Target {
 	case 2:
 		this.Target = NewPopulatedStoreAction_Application(r, easy)
@@ -1132,6 +1923,20 @@ func NewPopulatedStoreAction(r randyRaft, easy bool) *StoreAction {
 		this.Target = NewPopulatedStoreAction_Slot(r, easy)
 	case 6:
 		this.Target = NewPopulatedStoreAction_Task(r, easy)
+	case 7:
+		this.Target = NewPopulatedStoreAction_Job(r, easy)
+	case 8:
+		this.Target = NewPopulatedStoreAction_CronJob(r, easy)
+	case 9:
+		this.Target = NewPopulatedStoreAction_Quota(r, easy)
+	case 10:
+		this.Target = NewPopulatedStoreAction_Autoscaler(r, easy)
+	case 11:
+		this.Target = NewPopulatedStoreAction_ScalingSchedule(r, easy)
+	case 12:
+		this.Target = NewPopulatedStoreAction_AppVolumes(r, easy)
+	case 13:
+		this.Target = NewPopulatedStoreAction_VolumesDestroy(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1163,6 +1968,41 @@ func NewPopulatedStoreAction_Task(r randyRaft, easy bool) *StoreAction_Task {
 	this.Task = NewPopulatedTask(r, easy)
 	return this
 }
+func NewPopulatedStoreAction_Job(r randyRaft, easy bool) *StoreAction_Job {
+	this := &StoreAction_Job{}
+	this.Job = NewPopulatedJob(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_CronJob(r randyRaft, easy bool) *StoreAction_CronJob {
+	this := &StoreAction_CronJob{}
+	this.CronJob = NewPopulatedCronJob(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_Quota(r randyRaft, easy bool) *StoreAction_Quota {
+	this := &StoreAction_Quota{}
+	this.Quota = NewPopulatedQuota(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_Autoscaler(r randyRaft, easy bool) *StoreAction_Autoscaler {
+	this := &StoreAction_Autoscaler{}
+	this.Autoscaler = NewPopulatedAutoscaler(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_ScalingSchedule(r randyRaft, easy bool) *StoreAction_ScalingSchedule {
+	this := &StoreAction_ScalingSchedule{}
+	this.ScalingSchedule = NewPopulatedScalingSchedule(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_AppVolumes(r randyRaft, easy bool) *StoreAction_AppVolumes {
+	this := &StoreAction_AppVolumes{}
+	this.AppVolumes = NewPopulatedAppVolumes(r, easy)
+	return this
+}
+func NewPopulatedStoreAction_VolumesDestroy(r randyRaft, easy bool) *StoreAction_VolumesDestroy {
+	this := &StoreAction_VolumesDestroy{}
+	this.VolumesDestroy = NewPopulatedVolumesDestroy(r, easy)
+	return this
+}
 func NewPopulatedFramework(r randyRaft, easy bool) *Framework {
 	this := &Framework{}
 	this.ID = string(randStringRaft(r))
@@ -1315,6 +2155,69 @@ func (m *StoreAction_Task) Size() (n int) {
 	}
 	return n
 }
+func (m *StoreAction_Job) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_CronJob) Size() (n int) {
+	var l int
+	_ = l
+	if m.CronJob != nil {
+		l = m.CronJob.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_Quota) Size() (n int) {
+	var l int
+	_ = l
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_Autoscaler) Size() (n int) {
+	var l int
+	_ = l
+	if m.Autoscaler != nil {
+		l = m.Autoscaler.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_ScalingSchedule) Size() (n int) {
+	var l int
+	_ = l
+	if m.ScalingSchedule != nil {
+		l = m.ScalingSchedule.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_AppVolumes) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppVolumes != nil {
+		l = m.AppVolumes.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_VolumesDestroy) Size() (n int) {
+	var l int
+	_ = l
+	if m.VolumesDestroy != nil {
+		l = m.VolumesDestroy.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
 func (m *Framework) Size() (n int) {
 	var l int
 	_ = l
@@ -1646,6 +2549,230 @@ func (m *StoreAction) Unmarshal(dAtA []byte) error {
 			}
 			m.Target = &StoreAction_Task{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Job{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_Job{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CronJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_CronJob{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Quota{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_Quota{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Autoscaler{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_Autoscaler{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ScalingSchedule{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_ScalingSchedule{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AppVolumes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_AppVolumes{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumesDestroy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VolumesDestroy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_VolumesDestroy{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
@@ -1854,34 +2981,44 @@ var (
 func init() { proto.RegisterFile("raft.proto", fileDescriptorRaft) }
 
 var fileDescriptorRaft = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x27, 0x75, 0xc9, 0x44, 0x2a, 0x61, 0x0a, 0xc1, 0x78, 0x61, 0x42, 0x40, 0xa2,
-	0xea, 0x22, 0xa0, 0x20, 0xb1, 0x4f, 0x52, 0x23, 0xa2, 0x8a, 0x04, 0x4d, 0xed, 0x02, 0xab, 0x6a,
-	0x68, 0xa6, 0x91, 0x95, 0xd4, 0x63, 0xc6, 0x43, 0x2b, 0x6e, 0x80, 0xb8, 0x03, 0xab, 0xb2, 0xe0,
-	0x08, 0x88, 0x13, 0x74, 0xc9, 0x09, 0x50, 0xdb, 0x0b, 0xc0, 0x92, 0x25, 0x6f, 0x26, 0x71, 0xeb,
-	0x3a, 0x59, 0x3c, 0xc9, 0xfa, 0xdf, 0xf7, 0xcd, 0x9b, 0x79, 0x32, 0x42, 0x82, 0x1e, 0xc8, 0x56,
-	0x22, 0xb8, 0xe4, 0x78, 0x45, 0x7e, 0x4a, 0x58, 0xea, 0xde, 0x1e, 0xf3, 0x31, 0xd7, 0xc9, 0x13,
-	0xf5, 0x35, 0x6b, 0xba, 0xb7, 0x68, 0x92, 0x4c, 0xa3, 0x7d, 0x2a, 0x23, 0x1e, 0xcf, 0xa2, 0xe6,
-	0x3b, 0xb4, 0xde, 0x8f, 0x25, 0x13, 0x31, 0x9d, 0x12, 0x38, 0x85, 0xb0, 0x0f, 0x1f, 0x59, 0x2a,
-	0x71, 0x1d, 0x59, 0xd1, 0xc8, 0x31, 0x1b, 0xe6, 0x46, 0xb9, 0x6b, 0x5f, 0xfc, 0xbe, 0x6f, 0xf5,
-	0xb7, 0x08, 0x24, 0x78, 0x13, 0xd9, 0x74, 0x5f, 0xe9, 0x8e, 0xd5, 0x28, 0x6d, 0x54, 0xdb, 0xb8,
-	0xa5, 0xe7, 0xb5, 0x76, 0x24, 0x17, 0xac, 0xa3, 0x3b, 0x64, 0x4e, 0x34, 0x4f, 0x2c, 0x54, 0xcd,
-	0xe5, 0xb8, 0x75, 0xe9, 0xaa, 0x73, 0xd7, 0xda, 0xf5, 0x45, 0x77, 0x3b, 0x8a, 0x47, 0x99, 0x8f,
-	0x9f, 0xa3, 0x6a, 0xee, 0xbe, 0x30, 0xd0, 0xcc, 0x0d, 0xec, 0x5c, 0x75, 0x5e, 0x1a, 0x24, 0x0f,
-	0xe2, 0xa7, 0xa8, 0x72, 0x20, 0xe8, 0x21, 0x3b, 0xe6, 0x62, 0xe2, 0x94, 0xb4, 0x55, 0x9b, 0x5b,
-	0x2f, 0xb2, 0x1c, 0x9c, 0x2b, 0x08, 0x5e, 0xb5, 0x7a, 0xc4, 0x44, 0xaa, 0xa6, 0x94, 0x35, 0xbf,
-	0x36, 0xe7, 0x77, 0x67, 0x29, 0xd0, 0x19, 0x80, 0x1f, 0xa0, 0x72, 0x3a, 0xe5, 0xd2, 0x59, 0xd1,
-	0x60, 0x35, 0x7b, 0x03, 0x44, 0x40, 0xe9, 0x96, 0x42, 0x24, 0x4d, 0x27, 0x8e, 0x7d, 0x0d, 0x09,
-	0x20, 0x52, 0x88, 0x6a, 0x75, 0x6f, 0x20, 0x5b, 0x52, 0x31, 0x66, 0xb2, 0xf9, 0x10, 0x55, 0x2e,
-	0x6f, 0x95, 0x5b, 0x7b, 0x25, 0xbf, 0xf6, 0xcd, 0x3f, 0x26, 0xba, 0x59, 0x58, 0x13, 0x7e, 0x8c,
-	0x56, 0xc3, 0xc1, 0xf6, 0x60, 0xf8, 0x66, 0x50, 0x33, 0x5c, 0xf7, 0xcb, 0xd7, 0x46, 0xbd, 0x40,
-	0x84, 0xf1, 0x24, 0xe6, 0xc7, 0x31, 0x6e, 0xa3, 0xf5, 0x9d, 0x60, 0x48, 0xfc, 0xbd, 0x4e, 0x2f,
-	0xe8, 0x0f, 0x07, 0x7b, 0x3d, 0xe2, 0x77, 0x02, 0xbf, 0x66, 0xba, 0xf7, 0x40, 0xba, 0x53, 0x90,
-	0x7a, 0x82, 0x51, 0xc9, 0x16, 0x9c, 0xf0, 0xf5, 0x96, 0x72, 0xac, 0xa5, 0x4e, 0x98, 0x8c, 0x96,
-	0x39, 0xc4, 0x7f, 0x35, 0xdc, 0xf5, 0x6b, 0xa5, 0xa5, 0x0e, 0x61, 0x87, 0xfc, 0x88, 0xb9, 0x77,
-	0x3f, 0x9f, 0x78, 0xc6, 0xcf, 0x6f, 0x5e, 0xf1, 0x75, 0xdd, 0x47, 0xa7, 0xe7, 0x9e, 0x71, 0x76,
-	0xee, 0x99, 0x7f, 0xa1, 0xfe, 0x41, 0x7d, 0xbf, 0xf0, 0xcc, 0x1f, 0x50, 0xa7, 0x50, 0xbf, 0xa0,
-	0xce, 0xa0, 0xde, 0x1a, 0xef, 0x6d, 0xfd, 0x1b, 0x3f, 0xfb, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xfd,
-	0x90, 0x22, 0x19, 0x04, 0x03, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x52, 0x13, 0x31,
+	0x1c, 0xc7, 0x93, 0x96, 0x16, 0x9a, 0x22, 0x94, 0x20, 0x18, 0x7b, 0x88, 0x15, 0x99, 0x91, 0xe1,
+	0x50, 0x1d, 0x98, 0xf1, 0xea, 0xb4, 0xa5, 0xce, 0x02, 0x63, 0xab, 0xa1, 0x45, 0x3d, 0x31, 0x69,
+	0x1b, 0x6a, 0x65, 0xd9, 0x2c, 0xd9, 0x14, 0x86, 0xbb, 0x07, 0x87, 0x77, 0xe0, 0xa4, 0x07, 0x1f,
+	0xc1, 0xf1, 0x09, 0x38, 0xfa, 0x04, 0x0e, 0xed, 0x0b, 0xe8, 0xd1, 0xa3, 0x93, 0xf4, 0x0f, 0xdb,
+	0x85, 0x5b, 0xf6, 0xf7, 0xfd, 0x7c, 0xf6, 0xf7, 0xcb, 0x26, 0xb3, 0x08, 0x29, 0x7e, 0xa8, 0xf3,
+	0xbe, 0x92, 0x5a, 0xe2, 0x84, 0x3e, 0xf7, 0x45, 0x90, 0xbd, 0xdf, 0x96, 0x6d, 0x69, 0x2b, 0xcf,
+	0xcc, 0x6a, 0x10, 0x66, 0x17, 0xb8, 0xef, 0xbb, 0x9d, 0x26, 0xd7, 0x1d, 0xe9, 0x0d, 0x4a, 0x2b,
+	0x1f, 0xd0, 0xe2, 0xb6, 0xa7, 0x85, 0xf2, 0xb8, 0xcb, 0xf8, 0xa1, 0x66, 0xe2, 0xa4, 0x2b, 0x02,
+	0x8d, 0x97, 0x51, 0xac, 0xd3, 0x22, 0x30, 0x07, 0xd7, 0xa6, 0x8a, 0xc9, 0xfe, 0xef, 0x47, 0xb1,
+	0xed, 0x2d, 0x16, 0xeb, 0xb4, 0xf0, 0x3a, 0x4a, 0xf2, 0xa6, 0xd1, 0x49, 0x2c, 0x17, 0x5f, 0x4b,
+	0x6f, 0xe0, 0xbc, 0xed, 0x97, 0xdf, 0xd3, 0x52, 0x89, 0x82, 0x4d, 0xd8, 0x90, 0x58, 0xf9, 0x9c,
+	0x40, 0xe9, 0x50, 0x1d, 0xe7, 0xc7, 0xae, 0x79, 0xef, 0xdc, 0xc6, 0xf2, 0x6d, 0x77, 0xb7, 0xe3,
+	0xb5, 0x46, 0x3e, 0x7e, 0x81, 0xd2, 0xa1, 0x79, 0x49, 0x2c, 0x07, 0x43, 0x0d, 0x0b, 0x37, 0x89,
+	0x03, 0x58, 0x18, 0xc4, 0xcf, 0x51, 0xea, 0x50, 0xf1, 0x63, 0x71, 0x26, 0xd5, 0x11, 0x89, 0x5b,
+	0x2b, 0x33, 0xb4, 0x5e, 0x8d, 0xea, 0x0e, 0x60, 0x37, 0x10, 0x5e, 0x47, 0xd3, 0xa7, 0x42, 0x05,
+	0xa6, 0xcb, 0x94, 0xe5, 0xe7, 0x86, 0xfc, 0xfe, 0xa0, 0xea, 0x00, 0x36, 0x02, 0xf0, 0x63, 0x34,
+	0x15, 0xb8, 0x52, 0x93, 0x84, 0x05, 0xd3, 0xa3, 0x3d, 0xb8, 0x52, 0x3b, 0x80, 0xd9, 0xc8, 0x20,
+	0x9a, 0x07, 0x47, 0x24, 0x39, 0x81, 0xd4, 0x78, 0x60, 0xda, 0xda, 0x08, 0x53, 0x14, 0xff, 0x24,
+	0x1b, 0x64, 0xda, 0x12, 0x68, 0x48, 0xec, 0xc8, 0x86, 0x03, 0x98, 0x09, 0xcc, 0x44, 0x4d, 0x25,
+	0xbd, 0x1d, 0xd9, 0x20, 0x33, 0x13, 0x13, 0x95, 0x06, 0x55, 0x33, 0xd1, 0x10, 0xc0, 0xab, 0x28,
+	0x71, 0xd2, 0x95, 0x9a, 0x93, 0x94, 0x25, 0x67, 0x87, 0xe4, 0x5b, 0x53, 0x73, 0x00, 0x1b, 0x84,
+	0x78, 0x13, 0x21, 0xde, 0xd5, 0x32, 0x68, 0x72, 0x57, 0x28, 0x82, 0x2c, 0xba, 0x30, 0xfa, 0x98,
+	0xe3, 0xc0, 0x01, 0x2c, 0x84, 0xe1, 0x22, 0x9a, 0x37, 0xab, 0x8e, 0xd7, 0xde, 0x6b, 0x7e, 0x14,
+	0xad, 0xae, 0x2b, 0x48, 0xda, 0x9a, 0xe3, 0xb3, 0x9b, 0x4c, 0x1d, 0xc0, 0xa2, 0x82, 0x6d, 0xec,
+	0xfb, 0xfb, 0xd2, 0xed, 0x1e, 0x8b, 0x80, 0xcc, 0x4e, 0x36, 0x1e, 0x07, 0xb6, 0xf1, 0xf8, 0x09,
+	0xbf, 0x44, 0x73, 0xa7, 0x83, 0xe5, 0x96, 0x08, 0xb4, 0x92, 0xe7, 0xe4, 0x9e, 0x15, 0x97, 0x46,
+	0x07, 0x33, 0x11, 0x3a, 0x80, 0x45, 0xf0, 0xe2, 0x0c, 0x4a, 0x6a, 0xae, 0xda, 0x42, 0xaf, 0x3c,
+	0x41, 0xa9, 0xf1, 0xb1, 0x87, 0xee, 0x75, 0x2a, 0x7c, 0xaf, 0xd7, 0xff, 0x40, 0x34, 0x1f, 0xb9,
+	0x87, 0xf8, 0x29, 0x9a, 0xae, 0x57, 0x76, 0x2b, 0xd5, 0x77, 0x95, 0x0c, 0xc8, 0x66, 0x2f, 0x2e,
+	0x73, 0xcb, 0x11, 0xa2, 0xee, 0x1d, 0x79, 0xf2, 0xcc, 0xc3, 0x1b, 0x68, 0x71, 0xaf, 0x56, 0x65,
+	0xe5, 0x83, 0x42, 0xa9, 0xb6, 0x5d, 0xad, 0x1c, 0x94, 0x58, 0xb9, 0x50, 0x2b, 0x67, 0x60, 0xf6,
+	0xe1, 0xc5, 0x65, 0x6e, 0x29, 0x22, 0x95, 0x94, 0xe0, 0x5a, 0xdc, 0x72, 0xea, 0x6f, 0xb6, 0x8c,
+	0x13, 0xbb, 0xd3, 0xa9, 0xfb, 0xad, 0xbb, 0x1c, 0x56, 0x7e, 0x5d, 0xdd, 0x2f, 0x67, 0xe2, 0x77,
+	0x3a, 0x4c, 0x1c, 0xcb, 0x53, 0x91, 0x7d, 0xf0, 0xe5, 0x2b, 0x05, 0x3f, 0xbf, 0xd1, 0xe8, 0xee,
+	0x8a, 0xab, 0x57, 0x3d, 0x0a, 0xae, 0x7b, 0x14, 0xfe, 0xed, 0x51, 0xf8, 0xaf, 0x47, 0xe1, 0xf7,
+	0x3e, 0x85, 0x3f, 0xfa, 0x14, 0x5e, 0xf5, 0x29, 0xfc, 0xd5, 0xa7, 0xf0, 0xba, 0x4f, 0xe1, 0x7b,
+	0xd0, 0x48, 0xda, 0xff, 0xc4, 0xe6, 0xff, 0x01, 0x00, 0xc8, 0x2c, 0xfc, 0x11, 0x65, 0x04, 0x00,
+	0x00,
 }
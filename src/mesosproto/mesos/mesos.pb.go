@@ -1,12 +1,12 @@
 // Code generated by protoc-gen-go.
-// source: mesos.proto.new
+// source: mesos.proto
 // DO NOT EDIT!
 
 /*
 Package mesos_v1 is a generated protocol buffer package.
 
 It is generated from these files:
-	mesos.proto.new
+	mesos.proto
 
 It has these top-level messages:
 	FrameworkID
@@ -339,6 +339,14 @@ const (
 	// Mesos when the agent reregisters (unless the master has
 	// failed over).
 	FrameworkInfo_Capability_PARTITION_AWARE FrameworkInfo_Capability_Type = 5
+	// This expresses the ability for the framework to be
+	// "multi-tenant" via using the newly introduced `roles`
+	// field, and examining `Offer.allocation_info` to determine
+	// which role the offers are being made to. We also
+	// expect that "single-tenant" schedulers eventually
+	// provide this and move away from the deprecated
+	// `role` field.
+	FrameworkInfo_Capability_MULTI_ROLE FrameworkInfo_Capability_Type = 6
 )
 
 var FrameworkInfo_Capability_Type_name = map[int32]string{
@@ -348,6 +356,7 @@ var FrameworkInfo_Capability_Type_name = map[int32]string{
 	3: "GPU_RESOURCES",
 	4: "SHARED_RESOURCES",
 	5: "PARTITION_AWARE",
+	6: "MULTI_ROLE",
 }
 var FrameworkInfo_Capability_Type_value = map[string]int32{
 	"UNKNOWN":             0,
@@ -356,6 +365,7 @@ var FrameworkInfo_Capability_Type_value = map[string]int32{
 	"GPU_RESOURCES":       3,
 	"SHARED_RESOURCES":    4,
 	"PARTITION_AWARE":     5,
+	"MULTI_ROLE":          6,
 }
 
 func (x FrameworkInfo_Capability_Type) Enum() *FrameworkInfo_Capability_Type {
@@ -540,7 +550,7 @@ func (x *Resource_DiskInfo_Source_Type) UnmarshalJSON(data []byte) error {
 	return nil
 }
 func (Resource_DiskInfo_Source_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{22, 1, 1, 0}
+	return fileDescriptor0, []int{22, 2, 1, 0}
 }
 
 type Offer_Operation_Type int32
@@ -1275,7 +1285,9 @@ func (x *DiscoveryInfo_Visibility) UnmarshalJSON(data []byte) error {
 	*x = DiscoveryInfo_Visibility(value)
 	return nil
 }
-func (DiscoveryInfo_Visibility) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{61, 0} }
+func (DiscoveryInfo_Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{61, 0}
+}
 
 // *
 // A unique ID assigned to a framework. A framework can reuse this ID
@@ -1701,8 +1713,17 @@ type FrameworkInfo struct {
 	// scheduler (e.g., to describe additional functionality offered by
 	// the framework). These labels are not interpreted by Mesos itself.
 	// Labels should not contain duplicate key-value pairs.
-	Labels           *Labels `protobuf:"bytes,11,opt,name=labels" json:"labels,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Labels *Labels `protobuf:"bytes,11,opt,name=labels" json:"labels,omitempty"`
+	// Roles are the entities to which allocations are made.
+	// The framework must have at least one role in order to
+	// be offered resources. Note that `role` is deprecated
+	// in favor of `roles` and only one of these fields must
+	// be used. Since we cannot distinguish between empty
+	// `roles` and the default unset `role`, we require that
+	// frameworks set the `MULTI_ROLE` capability if
+	// setting the `roles` field.
+	Roles            []string `protobuf:"bytes,12,rep,name=roles" json:"roles,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
 }
 
 func (m *FrameworkInfo) Reset()                    { *m = FrameworkInfo{} }
@@ -1791,6 +1812,13 @@ func (m *FrameworkInfo) GetLabels() *Labels {
 	return nil
 }
 
+func (m *FrameworkInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type FrameworkInfo_Capability struct {
 	// Enum fields should be optional, see: MESOS-4997.
 	Type             *FrameworkInfo_Capability_Type `protobuf:"varint,1,opt,name=type,enum=mesos.v1.FrameworkInfo_Capability_Type" json:"type,omitempty"`
@@ -2702,6 +2730,10 @@ type Resource struct {
 	// that the resource is unreserved. Otherwise, the resource will only
 	// be offered to frameworks that belong to this role.
 	Role *string `protobuf:"bytes,6,opt,name=role,def=*" json:"role,omitempty"`
+	// The role to which the resource is allocated, set in the
+	// offers made to a MULTI_ROLE framework, and required to be
+	// set in the resources the framework uses.
+	AllocationInfo *Resource_AllocationInfo `protobuf:"bytes,11,opt,name=allocation_info,json=allocationInfo" json:"allocation_info,omitempty"`
 	// If this is set, this resource was dynamically reserved by an
 	// operator or a framework. Otherwise, this resource is either unreserved
 	// or statically reserved by an operator via the --resources flag.
@@ -2771,6 +2803,13 @@ func (m *Resource) GetRole() string {
 	return Default_Resource_Role
 }
 
+func (m *Resource) GetAllocationInfo() *Resource_AllocationInfo {
+	if m != nil {
+		return m.AllocationInfo
+	}
+	return nil
+}
+
 func (m *Resource) GetReservation() *Resource_ReservationInfo {
 	if m != nil {
 		return m.Reservation
@@ -2799,6 +2838,29 @@ func (m *Resource) GetShared() *Resource_SharedInfo {
 	return nil
 }
 
+// This was initially introduced to support MULTI_ROLE capable
+// frameworks. Frameworks must set this field when using
+// resources allocated to one of their roles.
+type Resource_AllocationInfo struct {
+	// If set, this resource is allocated to a role. Note that in the
+	// future, this may be unset and the scheduler may be responsible
+	// for allocating to one of its roles.
+	Role             *string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_AllocationInfo) Reset()                    { *m = Resource_AllocationInfo{} }
+func (m *Resource_AllocationInfo) String() string            { return proto.CompactTextString(m) }
+func (*Resource_AllocationInfo) ProtoMessage()               {}
+func (*Resource_AllocationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 0} }
+
+func (m *Resource_AllocationInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
 type Resource_ReservationInfo struct {
 	// Indicates the principal, if any, of the framework or operator
 	// that reserved this resource. If reserved by a framework, the
@@ -2820,7 +2882,7 @@ type Resource_ReservationInfo struct {
 func (m *Resource_ReservationInfo) Reset()                    { *m = Resource_ReservationInfo{} }
 func (m *Resource_ReservationInfo) String() string            { return proto.CompactTextString(m) }
 func (*Resource_ReservationInfo) ProtoMessage()               {}
-func (*Resource_ReservationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 0} }
+func (*Resource_ReservationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 1} }
 
 func (m *Resource_ReservationInfo) GetPrincipal() string {
 	if m != nil && m.Principal != nil {
@@ -2855,7 +2917,7 @@ type Resource_DiskInfo struct {
 func (m *Resource_DiskInfo) Reset()                    { *m = Resource_DiskInfo{} }
 func (m *Resource_DiskInfo) String() string            { return proto.CompactTextString(m) }
 func (*Resource_DiskInfo) ProtoMessage()               {}
-func (*Resource_DiskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 1} }
+func (*Resource_DiskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 2} }
 
 func (m *Resource_DiskInfo) GetPersistence() *Resource_DiskInfo_Persistence {
 	if m != nil {
@@ -2913,7 +2975,7 @@ func (m *Resource_DiskInfo_Persistence) Reset()         { *m = Resource_DiskInfo
 func (m *Resource_DiskInfo_Persistence) String() string { return proto.CompactTextString(m) }
 func (*Resource_DiskInfo_Persistence) ProtoMessage()    {}
 func (*Resource_DiskInfo_Persistence) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{22, 1, 0}
+	return fileDescriptor0, []int{22, 2, 0}
 }
 
 func (m *Resource_DiskInfo_Persistence) GetId() string {
@@ -2939,10 +3001,12 @@ type Resource_DiskInfo_Source struct {
 	XXX_unrecognized []byte                          `json:"-"`
 }
 
-func (m *Resource_DiskInfo_Source) Reset()                    { *m = Resource_DiskInfo_Source{} }
-func (m *Resource_DiskInfo_Source) String() string            { return proto.CompactTextString(m) }
-func (*Resource_DiskInfo_Source) ProtoMessage()               {}
-func (*Resource_DiskInfo_Source) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 1, 1} }
+func (m *Resource_DiskInfo_Source) Reset()         { *m = Resource_DiskInfo_Source{} }
+func (m *Resource_DiskInfo_Source) String() string { return proto.CompactTextString(m) }
+func (*Resource_DiskInfo_Source) ProtoMessage()    {}
+func (*Resource_DiskInfo_Source) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{22, 2, 1}
+}
 
 func (m *Resource_DiskInfo_Source) GetType() Resource_DiskInfo_Source_Type {
 	if m != nil && m.Type != nil {
@@ -2977,7 +3041,7 @@ func (m *Resource_DiskInfo_Source_Path) Reset()         { *m = Resource_DiskInfo
 func (m *Resource_DiskInfo_Source_Path) String() string { return proto.CompactTextString(m) }
 func (*Resource_DiskInfo_Source_Path) ProtoMessage()    {}
 func (*Resource_DiskInfo_Source_Path) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{22, 1, 1, 0}
+	return fileDescriptor0, []int{22, 2, 1, 0}
 }
 
 func (m *Resource_DiskInfo_Source_Path) GetRoot() string {
@@ -3000,7 +3064,7 @@ func (m *Resource_DiskInfo_Source_Mount) Reset()         { *m = Resource_DiskInf
 func (m *Resource_DiskInfo_Source_Mount) String() string { return proto.CompactTextString(m) }
 func (*Resource_DiskInfo_Source_Mount) ProtoMessage()    {}
 func (*Resource_DiskInfo_Source_Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{22, 1, 1, 1}
+	return fileDescriptor0, []int{22, 2, 1, 1}
 }
 
 func (m *Resource_DiskInfo_Source_Mount) GetRoot() string {
@@ -3017,7 +3081,7 @@ type Resource_RevocableInfo struct {
 func (m *Resource_RevocableInfo) Reset()                    { *m = Resource_RevocableInfo{} }
 func (m *Resource_RevocableInfo) String() string            { return proto.CompactTextString(m) }
 func (*Resource_RevocableInfo) ProtoMessage()               {}
-func (*Resource_RevocableInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 2} }
+func (*Resource_RevocableInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 3} }
 
 // Allow the resource to be shared across tasks.
 type Resource_SharedInfo struct {
@@ -3027,7 +3091,7 @@ type Resource_SharedInfo struct {
 func (m *Resource_SharedInfo) Reset()                    { *m = Resource_SharedInfo{} }
 func (m *Resource_SharedInfo) String() string            { return proto.CompactTextString(m) }
 func (*Resource_SharedInfo) ProtoMessage()               {}
-func (*Resource_SharedInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 3} }
+func (*Resource_SharedInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22, 4} }
 
 // *
 // When the network bandwidth caps are enabled and the container
@@ -7253,6 +7317,7 @@ func init() {
 	proto.RegisterType((*Value_Text)(nil), "mesos.v1.Value.Text")
 	proto.RegisterType((*Attribute)(nil), "mesos.v1.Attribute")
 	proto.RegisterType((*Resource)(nil), "mesos.v1.Resource")
+	proto.RegisterType((*Resource_AllocationInfo)(nil), "mesos.v1.Resource.AllocationInfo")
 	proto.RegisterType((*Resource_ReservationInfo)(nil), "mesos.v1.Resource.ReservationInfo")
 	proto.RegisterType((*Resource_DiskInfo)(nil), "mesos.v1.Resource.DiskInfo")
 	proto.RegisterType((*Resource_DiskInfo_Persistence)(nil), "mesos.v1.Resource.DiskInfo.Persistence")
@@ -7352,547 +7417,552 @@ func init() {
 	proto.RegisterEnum("mesos.v1.DiscoveryInfo_Visibility", DiscoveryInfo_Visibility_name, DiscoveryInfo_Visibility_value)
 }
 
-func init() { proto.RegisterFile("mesos.proto.new", fileDescriptor0) }
+func init() { proto.RegisterFile("mesos.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 8613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x7c, 0xeb, 0x8f, 0x1b, 0xc9,
-	0x76, 0x9f, 0xf9, 0x26, 0x8b, 0xe4, 0x0c, 0xd5, 0x23, 0x69, 0x47, 0xd4, 0xae, 0x56, 0xdb, 0xfb,
-	0xd2, 0xae, 0xf7, 0xce, 0x4a, 0xda, 0xd5, 0xdd, 0xbb, 0xda, 0x7b, 0x1d, 0x53, 0x24, 0x67, 0x44,
-	0x6b, 0xf8, 0xb8, 0x45, 0x52, 0xda, 0x0d, 0x02, 0x10, 0x14, 0xd9, 0x33, 0xd3, 0x11, 0x87, 0xe4,
-	0x6d, 0x36, 0xf5, 0xc8, 0xa7, 0xd8, 0xbe, 0xce, 0xc3, 0xb1, 0xf3, 0xc1, 0x09, 0x0c, 0x03, 0xfe,
-	0x9c, 0x2f, 0x31, 0x90, 0x7f, 0x20, 0x41, 0xf2, 0xc9, 0xc8, 0x13, 0x01, 0xf2, 0x21, 0xf9, 0x14,
-	0x20, 0x08, 0x70, 0x11, 0xd8, 0x79, 0x38, 0x71, 0x5e, 0x4e, 0xec, 0x24, 0xce, 0x39, 0x75, 0xaa,
-	0xaa, 0xab, 0x9b, 0xe4, 0xcc, 0x68, 0x13, 0x04, 0xc8, 0x27, 0xb2, 0x4e, 0xfd, 0x4e, 0xf5, 0xa9,
-	0x3a, 0xa7, 0xaa, 0xce, 0xa9, 0x17, 0xdb, 0x3e, 0x75, 0x16, 0xb3, 0xc5, 0xde, 0xdc, 0x9b, 0xf9,
-	0xb3, 0xbd, 0xa9, 0xf3, 0xc2, 0xca, 0x12, 0xe1, 0xf9, 0x1d, 0xfb, 0x5d, 0x96, 0xdf, 0xf7, 0x86,
-	0xa7, 0xce, 0x8b, 0x99, 0xf7, 0xac, 0x51, 0xb3, 0x2e, 0xb3, 0xd4, 0xf3, 0xe1, 0x64, 0xe9, 0xec,
-	0xc6, 0x6e, 0xc6, 0x6f, 0xe5, 0x38, 0x25, 0xec, 0xb7, 0x59, 0xa6, 0x7d, 0x74, 0xe4, 0x78, 0x67,
-	0x01, 0x2a, 0xc7, 0xce, 0xd4, 0xdf, 0x08, 0xb8, 0xc1, 0xd2, 0xbd, 0xe1, 0x62, 0xf3, 0x17, 0x6c,
-	0xc6, 0xea, 0x2f, 0x9d, 0xd1, 0xd2, 0x9f, 0x6d, 0xfe, 0x08, 0x67, 0xf9, 0xea, 0x6c, 0xea, 0x0f,
-	0xdd, 0xe9, 0x66, 0x49, 0xac, 0xef, 0xb0, 0xf4, 0x7c, 0xe8, 0x81, 0x28, 0xbb, 0xf1, 0x9b, 0xb1,
-	0x5b, 0xf9, 0xbb, 0x57, 0xf6, 0x54, 0x55, 0xf7, 0x0c, 0x66, 0x2e, 0x41, 0xf6, 0x27, 0x2c, 0xdb,
-	0x73, 0x4f, 0x9d, 0xc6, 0xf4, 0x68, 0x66, 0xdd, 0x64, 0xf9, 0xe9, 0x70, 0x3a, 0x5b, 0x38, 0xa3,
-	0xd9, 0x74, 0xbc, 0x10, 0xc5, 0x26, 0xb8, 0x49, 0xb2, 0x6f, 0xb3, 0x42, 0x6d, 0xe9, 0x0d, 0x7d,
-	0x77, 0x36, 0xbd, 0x20, 0x47, 0x03, 0x1a, 0x66, 0x3c, 0xf6, 0x9c, 0xc5, 0xc2, 0x2a, 0xb3, 0xec,
-	0xc9, 0x6c, 0xe1, 0x4f, 0xa1, 0xb1, 0x01, 0x19, 0x03, 0x91, 0x75, 0xda, 0xda, 0x62, 0x71, 0x77,
-	0x2e, 0x24, 0xce, 0x71, 0xf8, 0x67, 0x59, 0x2c, 0x39, 0x9f, 0x79, 0xfe, 0x6e, 0x02, 0x4a, 0x4c,
-	0x71, 0xf1, 0xdf, 0xfe, 0x6b, 0x31, 0x96, 0xe8, 0xf3, 0x43, 0xeb, 0x2a, 0x4b, 0x2f, 0x46, 0x27,
-	0xce, 0xa9, 0xaa, 0xb8, 0x4c, 0x59, 0x3f, 0xcd, 0x32, 0x43, 0xfa, 0x14, 0x14, 0x14, 0x87, 0xaa,
-	0x5f, 0x0a, 0xaa, 0x2e, 0x65, 0xe0, 0x0a, 0x21, 0x3e, 0x30, 0xf4, 0x4f, 0xe0, 0x03, 0xf8, 0x49,
-	0xf1, 0xdf, 0xfa, 0x88, 0xa5, 0x7e, 0xb4, 0x74, 0xbc, 0x57, 0xbb, 0xc9, 0x9b, 0x09, 0x60, 0xdf,
-	0x09, 0xd8, 0x3b, 0x43, 0x34, 0x11, 0xdf, 0xf1, 0x38, 0x21, 0xb0, 0x2e, 0x47, 0xde, 0xf0, 0xf8,
-	0x14, 0xdb, 0x39, 0x45, 0x75, 0x51, 0x69, 0x7b, 0xca, 0xb6, 0xfa, 0xd3, 0xe1, 0xf3, 0xa1, 0x3b,
-	0x19, 0x3e, 0x75, 0x27, 0xae, 0xff, 0xca, 0xba, 0xc5, 0x52, 0x0b, 0x7f, 0x08, 0xd5, 0x89, 0x09,
-	0xb9, 0xac, 0xa0, 0x60, 0xd5, 0xf6, 0x9c, 0x00, 0xd6, 0x5d, 0x96, 0x1d, 0xcb, 0x06, 0x96, 0xfa,
-	0xbb, 0x1a, 0x80, 0xcd, 0xa6, 0xe7, 0x1a, 0x67, 0x7f, 0xc1, 0x72, 0xcd, 0xe1, 0xe8, 0x04, 0x14,
-	0x0b, 0x46, 0xf1, 0x1a, 0x8d, 0x6c, 0xff, 0xa3, 0x18, 0xcb, 0x2b, 0x4e, 0xd4, 0xe6, 0xbb, 0x90,
-	0x3f, 0x96, 0x32, 0x1a, 0x95, 0xd7, 0x85, 0x03, 0xd3, 0xd8, 0xda, 0x63, 0xc9, 0xd3, 0xd9, 0xd8,
-	0x11, 0xc5, 0x6c, 0xdd, 0x2d, 0xaf, 0xc2, 0xa0, 0xa4, 0xbd, 0x26, 0x20, 0xb8, 0xc0, 0x59, 0x3f,
-	0xcb, 0xb6, 0x96, 0xa1, 0xd6, 0x10, 0x4d, 0x9e, 0xbf, 0xbb, 0x1b, 0x70, 0x86, 0x5b, 0x8b, 0x47,
-	0xf0, 0xf6, 0x07, 0x2c, 0x89, 0xe5, 0x59, 0x69, 0x16, 0xef, 0x77, 0x4a, 0x31, 0xab, 0xc0, 0xb2,
-	0x35, 0x5e, 0x69, 0xb4, 0x1a, 0xad, 0x83, 0x52, 0xdc, 0xca, 0xb2, 0x64, 0xad, 0xfd, 0xa4, 0x55,
-	0x4a, 0xd8, 0x3f, 0x49, 0xb2, 0x62, 0xd0, 0x95, 0xb1, 0x42, 0xa0, 0xe4, 0xe5, 0xc2, 0xf1, 0xa4,
-	0x9d, 0x88, 0xff, 0x48, 0x13, 0x8d, 0x13, 0x27, 0x9a, 0x68, 0x98, 0xf7, 0x45, 0xc5, 0x13, 0xd1,
-	0xfe, 0x62, 0x8c, 0x0b, 0xa2, 0xea, 0x9f, 0xb0, 0xd2, 0x11, 0xc8, 0x35, 0x7b, 0xee, 0x78, 0x03,
-	0x1f, 0x14, 0x37, 0x5b, 0xfa, 0x60, 0x2a, 0xb1, 0x5b, 0xb1, 0xfb, 0xb1, 0xdb, 0x7c, 0x5b, 0x65,
-	0xf5, 0x28, 0x07, 0x0a, 0x65, 0x60, 0x97, 0xa3, 0x67, 0xf3, 0x99, 0x2b, 0x8d, 0x24, 0x7b, 0x3f,
-	0x75, 0x34, 0x9c, 0x2c, 0x1c, 0x6e, 0x64, 0x58, 0x57, 0x58, 0xd2, 0x9b, 0x4d, 0x9c, 0xdd, 0x34,
-	0xaa, 0xe5, 0x7e, 0xec, 0x63, 0x2e, 0x92, 0x21, 0x3d, 0x66, 0x22, 0x7a, 0x7c, 0x93, 0xe5, 0xe6,
-	0x9e, 0x3b, 0x1d, 0xb9, 0xf3, 0xe1, 0x64, 0x37, 0x2b, 0x32, 0x03, 0x82, 0x75, 0x9d, 0xe5, 0x5e,
-	0x38, 0x4f, 0x97, 0xee, 0x60, 0xe9, 0x4d, 0x76, 0x73, 0xc4, 0x2a, 0x08, 0x7d, 0x6f, 0x62, 0xed,
-	0xb3, 0xc2, 0x68, 0x38, 0xa7, 0x96, 0x75, 0x9d, 0xc5, 0x2e, 0x13, 0x96, 0x6e, 0xaf, 0xab, 0x33,
-	0xea, 0xb1, 0xaa, 0xb0, 0xaf, 0x78, 0x88, 0x0f, 0x2c, 0x3a, 0x0d, 0xfa, 0x71, 0x26, 0x8b, 0xdd,
-	0xbc, 0x68, 0xb5, 0x52, 0x50, 0xc2, 0xa1, 0xa0, 0x73, 0x99, 0x5f, 0xfe, 0xc7, 0x31, 0xc6, 0x82,
-	0x62, 0xac, 0xaf, 0x58, 0xd2, 0x7f, 0x35, 0x27, 0xdb, 0xdc, 0xba, 0xfb, 0xe1, 0xf9, 0x1f, 0xde,
-	0xeb, 0x01, 0x9c, 0x0b, 0x26, 0xfb, 0x17, 0x62, 0x2c, 0x89, 0x49, 0x2b, 0xcf, 0x32, 0xfd, 0xd6,
-	0xa3, 0x16, 0xea, 0xfd, 0xa7, 0xac, 0x37, 0xd8, 0x0e, 0xaf, 0x3f, 0x6e, 0x57, 0x2b, 0x0f, 0x0e,
-	0xeb, 0x03, 0x5e, 0xef, 0xb6, 0xfb, 0xbc, 0x5a, 0xef, 0x82, 0xa1, 0x5c, 0x65, 0x56, 0xaf, 0xd2,
-	0x7d, 0x34, 0x78, 0xd4, 0x38, 0x3c, 0x04, 0x63, 0x19, 0x74, 0x7b, 0x95, 0x5e, 0x1d, 0x4c, 0xe6,
-	0x12, 0x2b, 0x1e, 0x74, 0xfa, 0x06, 0x34, 0x01, 0x63, 0x69, 0xa9, 0xfb, 0xb0, 0xc2, 0xeb, 0x35,
-	0x83, 0x9a, 0xb4, 0x76, 0xd8, 0x76, 0xa7, 0xc2, 0x7b, 0x8d, 0x5e, 0xa3, 0xdd, 0x1a, 0x54, 0x9e,
-	0x40, 0x7e, 0x29, 0x65, 0xff, 0xd5, 0x14, 0xcb, 0x3f, 0x74, 0x86, 0x13, 0xff, 0xa4, 0x8a, 0x5a,
-	0xb4, 0x3e, 0x64, 0xc5, 0xb1, 0x33, 0x19, 0xbe, 0x1a, 0xa8, 0x51, 0x30, 0x2e, 0x4c, 0x22, 0x7e,
-	0xe7, 0x1e, 0x2f, 0x88, 0x8c, 0x2e, 0xd1, 0x61, 0x64, 0x2e, 0x81, 0xc2, 0x1d, 0x0f, 0xc6, 0x69,
-	0x8d, 0x4d, 0x48, 0x2c, 0xd8, 0x8f, 0xca, 0x53, 0xf0, 0x9f, 0x66, 0xdb, 0xd2, 0xc8, 0x34, 0x9a,
-	0x8c, 0x2d, 0x7e, 0xf7, 0x36, 0xdf, 0x92, 0x59, 0x0a, 0xfc, 0x39, 0xbb, 0x0c, 0x7f, 0x16, 0x38,
-	0x81, 0xb8, 0xcf, 0x9d, 0x01, 0xda, 0xe2, 0x12, 0xc6, 0x39, 0x61, 0x76, 0xc5, 0xfb, 0xb1, 0xcf,
-	0xf8, 0x8e, 0x91, 0xbd, 0x2f, 0x73, 0x91, 0xeb, 0xd8, 0x1b, 0x8e, 0x9c, 0xc1, 0xdc, 0xf1, 0xdc,
-	0xd9, 0x58, 0x7f, 0x27, 0xad, 0xa5, 0xb2, 0x44, 0x7e, 0x47, 0x64, 0xab, 0x6f, 0xed, 0x49, 0x15,
-	0x66, 0xa3, 0x23, 0x80, 0xd1, 0x2a, 0x86, 0xd6, 0xac, 0x4f, 0x59, 0x66, 0x34, 0x3b, 0x3d, 0x1d,
-	0x4e, 0xc7, 0xc2, 0x92, 0x23, 0x53, 0x92, 0xc8, 0x10, 0x23, 0x9a, 0x42, 0x59, 0x5f, 0xb0, 0xe4,
-	0x89, 0xef, 0xcf, 0x85, 0x8d, 0xe4, 0xef, 0xbe, 0xbb, 0xfe, 0x03, 0x0f, 0x7b, 0xbd, 0x8e, 0xf8,
-	0x27, 0x78, 0x05, 0x03, 0xd4, 0x27, 0xe1, 0x8f, 0xe6, 0xc2, 0xe8, 0x43, 0x46, 0x1d, 0x12, 0xac,
-	0x6a, 0xb0, 0x21, 0xbc, 0xfc, 0x8c, 0x15, 0x43, 0x85, 0x19, 0x13, 0x0c, 0xcd, 0x0e, 0x6a, 0x82,
-	0x51, 0x93, 0x12, 0x0e, 0x27, 0x45, 0x9a, 0x94, 0xf4, 0x3c, 0x12, 0x37, 0xe6, 0x11, 0xe8, 0xbb,
-	0x30, 0x9a, 0xfb, 0x30, 0xdc, 0x2c, 0xc4, 0x54, 0x52, 0xe4, 0x3a, 0x5d, 0xb6, 0x59, 0xc1, 0x94,
-	0x60, 0x5d, 0x99, 0xf6, 0x67, 0xeb, 0xac, 0x1c, 0x12, 0xd5, 0x76, 0xb3, 0x59, 0x69, 0xd5, 0xc0,
-	0xb2, 0x61, 0xd0, 0x43, 0x91, 0xc1, 0x96, 0x33, 0x2c, 0x01, 0xe5, 0xc1, 0xe8, 0x77, 0xc0, 0xd8,
-	0x23, 0x77, 0x32, 0xe9, 0xcc, 0x26, 0xee, 0xe8, 0x95, 0xf5, 0x25, 0x2b, 0x98, 0x9a, 0x95, 0x4d,
-	0xb9, 0x69, 0x2e, 0xc9, 0x1b, 0x5a, 0xb6, 0x7f, 0x2f, 0x8e, 0x6e, 0x86, 0x56, 0x0b, 0x98, 0x6d,
-	0x72, 0xe9, 0xb9, 0x38, 0xb9, 0xe3, 0x50, 0x71, 0x6d, 0xad, 0xee, 0xf6, 0xfa, 0xbc, 0xc1, 0x05,
-	0x0c, 0x94, 0x97, 0x77, 0xa6, 0xcf, 0x5d, 0x6f, 0x36, 0x3d, 0x5d, 0xeb, 0x84, 0xd4, 0x83, 0x4c,
-	0x6e, 0x22, 0xa1, 0xd5, 0x52, 0x8b, 0x13, 0x67, 0x32, 0x11, 0xd6, 0x97, 0xbd, 0x9f, 0xf4, 0xbd,
-	0xa5, 0xc3, 0x89, 0x14, 0xb8, 0x3a, 0xa4, 0x10, 0xe9, 0xea, 0xc0, 0x38, 0x38, 0xf4, 0x8e, 0x97,
-	0xc8, 0xbd, 0x00, 0xd3, 0x4a, 0xe0, 0x38, 0xa8, 0x09, 0x7a, 0xf0, 0xa7, 0xe9, 0x59, 0xfc, 0x2f,
-	0xff, 0x9a, 0x70, 0x21, 0x1a, 0x1b, 0x5c, 0xa7, 0x1b, 0x8c, 0x39, 0xc2, 0x07, 0x1b, 0x3e, 0x9d,
-	0xd0, 0x04, 0x97, 0xe5, 0x06, 0x05, 0xf2, 0x33, 0xce, 0x4b, 0x1f, 0x9a, 0xca, 0x17, 0x72, 0x28,
-	0x19, 0x15, 0x11, 0x4b, 0x1d, 0xc1, 0x24, 0xe8, 0x88, 0x7e, 0x9a, 0xe5, 0x94, 0xb0, 0xde, 0x66,
-	0x79, 0xe8, 0xa8, 0x73, 0xe8, 0xc6, 0x47, 0x2e, 0x14, 0x4b, 0xe2, 0x30, 0x22, 0xed, 0x03, 0xc5,
-	0xfe, 0xdd, 0x24, 0x2b, 0x68, 0xdf, 0x0f, 0x5b, 0xfc, 0x53, 0xd9, 0xc1, 0xb6, 0x45, 0x07, 0xbb,
-	0x6e, 0xb4, 0x9d, 0x81, 0x32, 0x7b, 0xd8, 0x3d, 0x68, 0x73, 0x99, 0x35, 0xd0, 0x33, 0xf8, 0xe5,
-	0x35, 0x7c, 0x35, 0x55, 0x1f, 0xf8, 0x3f, 0xb6, 0xbe, 0xc7, 0x0a, 0x47, 0x6a, 0xd4, 0x45, 0xbe,
-	0xec, 0x59, 0x13, 0x60, 0x5e, 0x43, 0x81, 0xf3, 0xb5, 0xbb, 0xf4, 0x3d, 0x96, 0x1b, 0x29, 0xef,
-	0x53, 0x4e, 0x19, 0x6f, 0xac, 0x73, 0x4c, 0x91, 0x29, 0x40, 0x5a, 0xb7, 0x59, 0x0e, 0xc6, 0xa9,
-	0xd9, 0xd2, 0x1b, 0x89, 0xb1, 0x2c, 0x11, 0x76, 0x9e, 0xb8, 0xcc, 0xe2, 0x01, 0x48, 0x4f, 0xef,
-	0x34, 0xf1, 0xd1, 0xf4, 0x5e, 0x86, 0xfe, 0x2c, 0xb2, 0x61, 0xba, 0x03, 0xea, 0x83, 0xf8, 0x6e,
-	0x8c, 0x4b, 0x0a, 0xe2, 0xc7, 0x43, 0x7f, 0x28, 0x54, 0x56, 0xe0, 0xe2, 0x3f, 0x0a, 0x3b, 0x76,
-	0x17, 0x23, 0x9c, 0xcc, 0x5f, 0xed, 0x16, 0xa2, 0xc2, 0xd6, 0x54, 0x16, 0x09, 0xab, 0x91, 0xd6,
-	0xcf, 0xb1, 0x2b, 0x8b, 0x93, 0xa5, 0x3f, 0x9e, 0xbd, 0x98, 0x0e, 0x42, 0x9d, 0xaf, 0x78, 0x66,
-	0xe7, 0xdb, 0x51, 0x4c, 0x07, 0x41, 0x27, 0x34, 0xe6, 0xd7, 0xad, 0xb3, 0xe7, 0x57, 0x70, 0xe0,
-	0xd7, 0x0f, 0x16, 0xb5, 0xfa, 0x7e, 0xa5, 0x7f, 0xd8, 0x83, 0xc1, 0x82, 0xb1, 0x74, 0xb5, 0xdf,
-	0xed, 0xb5, 0x9b, 0xa5, 0xb8, 0xfd, 0x37, 0x61, 0x36, 0x6e, 0x0e, 0x17, 0x3e, 0x35, 0xb5, 0xf0,
-	0x08, 0xc7, 0xb2, 0x13, 0xa0, 0x87, 0xa3, 0x3c, 0x44, 0x1c, 0x8b, 0xd0, 0x0d, 0xdf, 0x35, 0xdc,
-	0xf0, 0xe2, 0xfd, 0xe4, 0xbd, 0xdb, 0xf7, 0x6e, 0xcb, 0x71, 0xaf, 0xc4, 0x12, 0x73, 0x60, 0x4d,
-	0x8a, 0x66, 0xc6, 0xbf, 0x21, 0x8f, 0x25, 0x15, 0xf1, 0x58, 0x76, 0x59, 0x06, 0x9a, 0x68, 0x81,
-	0x5e, 0xad, 0xf0, 0x73, 0xb8, 0x4a, 0x9a, 0x4e, 0x3b, 0x59, 0xd2, 0x19, 0x4e, 0xbb, 0xfd, 0x0f,
-	0x63, 0x2c, 0x47, 0x61, 0x16, 0x0a, 0x1f, 0x76, 0x75, 0xe3, 0x91, 0x0f, 0x92, 0xe0, 0x68, 0xd2,
-	0x29, 0x21, 0xf8, 0x1d, 0x29, 0x78, 0xc8, 0xa4, 0x12, 0x17, 0x31, 0xa9, 0xcf, 0x18, 0x1b, 0xfa,
-	0xbe, 0xe7, 0x3e, 0x5d, 0xfa, 0xda, 0x0a, 0x0d, 0xf7, 0xb8, 0xa2, 0xf2, 0xb8, 0x01, 0xb3, 0xde,
-	0x11, 0x2d, 0x9b, 0x5e, 0xa9, 0x12, 0x05, 0x89, 0xd8, 0xd8, 0xf6, 0x3f, 0x4b, 0xb0, 0xd4, 0x63,
-	0x31, 0xf0, 0xdc, 0xd2, 0x4e, 0x51, 0x1c, 0x3a, 0xbc, 0xd1, 0x71, 0x45, 0xb6, 0xd9, 0xd3, 0xf7,
-	0x70, 0x6a, 0x1a, 0x4e, 0x86, 0xde, 0x6a, 0x74, 0x40, 0xd8, 0xae, 0xc8, 0xe5, 0x12, 0x85, 0x78,
-	0x6f, 0x38, 0x3d, 0x76, 0x16, 0xd2, 0xbb, 0x5d, 0xc1, 0x73, 0x91, 0xcb, 0x25, 0x0a, 0x9c, 0xd6,
-	0xc4, 0xc2, 0x21, 0xaf, 0x36, 0x54, 0x49, 0x59, 0xb8, 0xe3, 0x73, 0xcc, 0x17, 0x02, 0xc3, 0xa8,
-	0x27, 0xf4, 0x9c, 0x5f, 0x23, 0x30, 0xe4, 0x71, 0x81, 0x28, 0x43, 0xdc, 0x4b, 0x22, 0x85, 0xc7,
-	0xdc, 0x98, 0x1c, 0x73, 0xcb, 0x9f, 0xb2, 0x94, 0x10, 0x01, 0xb3, 0x9f, 0x3a, 0xc7, 0xee, 0x54,
-	0x64, 0x27, 0x39, 0x25, 0xd0, 0xcc, 0x1c, 0x18, 0x64, 0xe2, 0x82, 0x86, 0x7f, 0xcb, 0xf7, 0x58,
-	0x9a, 0x64, 0x06, 0xd3, 0x49, 0x09, 0xa9, 0xe5, 0xcc, 0x74, 0x65, 0x6d, 0xd5, 0x38, 0x61, 0xca,
-	0xd7, 0x58, 0x02, 0xa4, 0xc7, 0xee, 0xee, 0xfa, 0xce, 0xa9, 0x60, 0x81, 0xe1, 0x01, 0xff, 0x97,
-	0xdf, 0x84, 0x1e, 0x04, 0xa2, 0x6e, 0x08, 0xba, 0xef, 0xc8, 0xfe, 0x05, 0xbd, 0xa8, 0x5b, 0xad,
-	0x1c, 0x56, 0x38, 0x74, 0x2f, 0xf8, 0xcf, 0x2b, 0xad, 0x03, 0xe1, 0x64, 0xc2, 0x04, 0xdc, 0xad,
-	0xf7, 0x28, 0x10, 0xe9, 0xd5, 0xbf, 0xee, 0xc1, 0x54, 0xfc, 0x87, 0x68, 0xa6, 0xca, 0x14, 0xf4,
-	0x88, 0x14, 0x33, 0x02, 0x0e, 0xa5, 0xf0, 0xf8, 0x6b, 0x28, 0x3c, 0xf1, 0x9a, 0x0a, 0x4f, 0xbe,
-	0x8e, 0xc2, 0xd3, 0xff, 0xb7, 0x14, 0x6e, 0xff, 0x24, 0xcb, 0xb2, 0xaa, 0x17, 0xfd, 0xff, 0x51,
-	0xf7, 0xd4, 0x39, 0x75, 0xdf, 0x10, 0xa1, 0xd5, 0x58, 0x1e, 0xc6, 0x08, 0x74, 0xd9, 0x45, 0xb4,
-	0x9e, 0x8d, 0x3a, 0x9d, 0xaa, 0x11, 0xf0, 0x8f, 0x42, 0x91, 0xb7, 0x65, 0xb0, 0xe1, 0x5c, 0x0f,
-	0x33, 0xc8, 0x33, 0x39, 0xf8, 0x5d, 0x5f, 0xc3, 0x0e, 0xf3, 0x8d, 0xf4, 0x71, 0x11, 0x68, 0xfd,
-	0x0c, 0x8e, 0x5f, 0xcf, 0x67, 0x23, 0xe1, 0xa3, 0x90, 0xa7, 0x7b, 0x73, 0xed, 0x47, 0x25, 0x86,
-	0x66, 0x29, 0xcd, 0x02, 0x93, 0x5b, 0x7a, 0x71, 0x32, 0xf4, 0x9c, 0xb1, 0x98, 0x0c, 0xf3, 0x77,
-	0xdf, 0x5a, 0xc3, 0xdc, 0x15, 0x00, 0xc1, 0x29, 0xc1, 0xe5, 0x6f, 0xd8, 0x76, 0xa4, 0x1e, 0xe1,
-	0x30, 0x34, 0x16, 0x0d, 0x43, 0x83, 0x19, 0x2c, 0x7e, 0x4e, 0x84, 0xf8, 0x57, 0x92, 0x10, 0xd0,
-	0xcb, 0x4a, 0x5a, 0x0d, 0x96, 0x9f, 0xe3, 0xd4, 0x00, 0x53, 0xd4, 0x74, 0xe4, 0x48, 0xbf, 0xf5,
-	0xc3, 0x33, 0x9a, 0x65, 0xaf, 0x13, 0xc0, 0xb9, 0xc9, 0x8b, 0x12, 0x3c, 0x9f, 0x4d, 0x96, 0xa7,
-	0xce, 0xaa, 0x04, 0x8f, 0x05, 0x9d, 0xcb, 0x7c, 0xeb, 0xbe, 0x76, 0x10, 0x12, 0x1b, 0xb5, 0xa8,
-	0xbf, 0xd7, 0xa5, 0x09, 0x42, 0x72, 0x94, 0xbf, 0x62, 0x79, 0x43, 0x82, 0x95, 0x19, 0x35, 0xd4,
-	0x48, 0xf1, 0x48, 0x23, 0x95, 0x7f, 0x23, 0x0e, 0xa3, 0x0a, 0x75, 0x95, 0xaf, 0x42, 0x73, 0xc0,
-	0x87, 0xe7, 0x4b, 0x60, 0xf6, 0x94, 0xaf, 0x8c, 0x28, 0x24, 0x7f, 0x21, 0xe6, 0x0e, 0xc0, 0x65,
-	0xb8, 0xf2, 0x33, 0x2c, 0x75, 0x3a, 0x5b, 0x4e, 0x7d, 0x59, 0xf9, 0x5b, 0x17, 0xe0, 0x6e, 0x22,
-	0x9e, 0x13, 0x5b, 0xb9, 0xcc, 0x92, 0x58, 0x1a, 0x76, 0x76, 0x6f, 0x36, 0xf3, 0x55, 0x67, 0xc7,
-	0xff, 0xe5, 0xeb, 0x2c, 0x25, 0xb0, 0xeb, 0x32, 0xed, 0xeb, 0x72, 0x68, 0x85, 0x91, 0xb3, 0x53,
-	0xe9, 0x3d, 0x84, 0xc1, 0x34, 0x07, 0xf0, 0x76, 0xbf, 0x05, 0xc3, 0x69, 0x79, 0x9b, 0x15, 0x43,
-	0x36, 0x5c, 0x2e, 0x30, 0x16, 0xd8, 0xa5, 0xfd, 0x97, 0xe3, 0x6c, 0xb7, 0xe7, 0x0d, 0x8f, 0x8e,
-	0xdc, 0x11, 0x7a, 0x8f, 0xd0, 0x23, 0xbb, 0x10, 0x62, 0x81, 0x16, 0xdc, 0xd1, 0x62, 0x45, 0x09,
-	0xe0, 0x7e, 0x3c, 0x1d, 0x8e, 0x9e, 0x4d, 0x66, 0xc7, 0xa2, 0x85, 0x92, 0x5c, 0x25, 0xc5, 0xac,
-	0xf3, 0xca, 0x97, 0xd3, 0x23, 0xce, 0x3a, 0x98, 0x40, 0xea, 0xd8, 0x9b, 0xcd, 0x69, 0x1c, 0x01,
-	0xaa, 0x48, 0x60, 0x78, 0x80, 0x8e, 0xde, 0xc4, 0x3d, 0x75, 0x7d, 0x8a, 0xac, 0x93, 0xdc, 0xa0,
-	0xe0, 0x57, 0xe6, 0x50, 0xac, 0xe3, 0x53, 0x00, 0x0d, 0x5f, 0x91, 0x49, 0xac, 0xfc, 0x8f, 0x26,
-	0xce, 0x54, 0x74, 0xf2, 0x24, 0x17, 0xff, 0x11, 0x0d, 0x4e, 0xa0, 0xf3, 0x14, 0xbe, 0x92, 0x25,
-	0xb4, 0x4c, 0xaa, 0x9c, 0x39, 0xe4, 0xe4, 0x82, 0x1c, 0x48, 0xa2, 0xc7, 0xe3, 0x39, 0x3f, 0x5a,
-	0x3a, 0x4b, 0xb1, 0x72, 0x83, 0x59, 0x3a, 0x6d, 0xff, 0x38, 0xc5, 0x0a, 0x8d, 0xb9, 0xd1, 0x08,
-	0x20, 0xee, 0xfe, 0xcc, 0x7b, 0x31, 0xf4, 0xc6, 0xee, 0xf4, 0x58, 0x74, 0xa4, 0x04, 0x67, 0x47,
-	0x9a, 0x82, 0xf9, 0x35, 0xe7, 0x68, 0xb8, 0x9c, 0xf8, 0xbd, 0xde, 0xa1, 0x68, 0x17, 0xc8, 0x1f,
-	0x6b, 0x0a, 0xe6, 0x37, 0xa6, 0xdc, 0x19, 0x39, 0xee, 0x73, 0xd9, 0x3e, 0x90, 0xef, 0x6a, 0x0a,
-	0xae, 0xfd, 0x36, 0xa6, 0x0f, 0xc7, 0x5e, 0xdd, 0xf3, 0x66, 0x1e, 0x35, 0x55, 0x82, 0xe7, 0xdd,
-	0x80, 0x64, 0x41, 0xac, 0xdb, 0x98, 0xa2, 0x13, 0x27, 0x21, 0x29, 0x01, 0x29, 0xb8, 0x06, 0xcd,
-	0x7a, 0x8f, 0x15, 0x51, 0xca, 0x1a, 0xf8, 0xdd, 0xe0, 0x33, 0x9f, 0x52, 0xd3, 0x25, 0x78, 0xf1,
-	0xc8, 0x24, 0x42, 0x57, 0xde, 0x6e, 0x4c, 0xfb, 0xd3, 0x67, 0x53, 0x70, 0x93, 0x3b, 0xb8, 0x88,
-	0x4f, 0xde, 0x62, 0x02, 0x57, 0x4d, 0x42, 0x64, 0x92, 0x1a, 0x5d, 0x74, 0xa8, 0x25, 0xb5, 0xac,
-	0x90, 0x5a, 0x51, 0x64, 0xbe, 0x33, 0x71, 0xd1, 0x01, 0x15, 0xed, 0x4b, 0xf9, 0x92, 0x82, 0xb5,
-	0x6a, 0x2f, 0x7d, 0x8e, 0xad, 0xba, 0xf0, 0xa9, 0x95, 0xa1, 0x56, 0xb3, 0x80, 0x24, 0x11, 0xfa,
-	0x13, 0x79, 0x8d, 0xd0, 0xdf, 0x20, 0x44, 0x6b, 0xc6, 0x67, 0xc2, 0x63, 0x2c, 0x68, 0x84, 0x22,
-	0x61, 0xcb, 0x70, 0x67, 0xb8, 0x38, 0x95, 0x6b, 0x85, 0x22, 0x42, 0x80, 0x96, 0xf1, 0x0c, 0x1a,
-	0x4a, 0x2a, 0x30, 0xf0, 0xe1, 0x31, 0x85, 0x01, 0x20, 0xa9, 0xa7, 0x29, 0x68, 0x0c, 0x22, 0xbf,
-	0xfd, 0x68, 0x21, 0x22, 0xc5, 0x04, 0x1a, 0x03, 0xa5, 0x35, 0x2f, 0xae, 0xf4, 0x2c, 0x76, 0x4b,
-	0x06, 0xaf, 0xa0, 0xa0, 0x89, 0x41, 0x6c, 0x77, 0x8c, 0xac, 0x97, 0x44, 0x66, 0xe6, 0x88, 0x92,
-	0x38, 0x5e, 0x61, 0x0e, 0x31, 0x5a, 0x22, 0x2f, 0x77, 0xa4, 0x08, 0x58, 0x33, 0xcc, 0xad, 0x42,
-	0x51, 0x58, 0xb3, 0x1d, 0xaa, 0xd9, 0x51, 0x40, 0xb2, 0xff, 0x76, 0x86, 0x6d, 0x35, 0x46, 0xa7,
-	0xa6, 0x21, 0x5e, 0x65, 0xe9, 0xc6, 0xb4, 0xb9, 0x38, 0x5e, 0x48, 0x23, 0x4c, 0xbb, 0x22, 0x85,
-	0x15, 0x68, 0x4c, 0xa5, 0x69, 0x90, 0xf9, 0x65, 0xdd, 0xa9, 0x69, 0x3a, 0xd5, 0xc5, 0xf2, 0x54,
-	0xe6, 0x27, 0x94, 0xe9, 0x04, 0x34, 0xeb, 0x03, 0xf8, 0x12, 0x28, 0x6e, 0xe1, 0xf7, 0xa7, 0xf0,
-	0xf1, 0xd1, 0x89, 0xb2, 0xc1, 0x2d, 0x37, 0x44, 0x25, 0x43, 0xc5, 0x56, 0xad, 0xbf, 0x1c, 0x8d,
-	0x95, 0x15, 0x82, 0xa1, 0x6a, 0x12, 0x21, 0x3a, 0x43, 0xef, 0x14, 0x8c, 0xe8, 0xa9, 0x32, 0x41,
-	0x40, 0x68, 0x12, 0xc9, 0xd3, 0xf5, 0x46, 0x3f, 0x5c, 0xc2, 0x28, 0x7f, 0xa2, 0xac, 0x0f, 0xe4,
-	0x09, 0x68, 0x54, 0x0a, 0x77, 0xc6, 0xae, 0xe7, 0x8c, 0x7c, 0x65, 0x7b, 0x79, 0x37, 0x20, 0x61,
-	0xb3, 0x43, 0x8d, 0x47, 0x10, 0xa5, 0x48, 0xcb, 0xcb, 0xb8, 0x94, 0x24, 0xb3, 0xc4, 0xbf, 0xdc,
-	0x99, 0x2b, 0xab, 0x63, 0xae, 0xa6, 0xd0, 0xf7, 0x51, 0xe0, 0x85, 0x3f, 0x3c, 0x9d, 0x2b, 0xab,
-	0x2b, 0xb8, 0x06, 0x8d, 0x3a, 0x89, 0x4e, 0x8b, 0x82, 0x0a, 0xaa, 0x93, 0x84, 0xc8, 0x24, 0x29,
-	0x76, 0x42, 0x08, 0x05, 0x9f, 0x2d, 0xa4, 0xf5, 0xe5, 0xdd, 0x80, 0x44, 0x6d, 0xab, 0x92, 0xa2,
-	0xa8, 0x2d, 0xd5, 0xb6, 0x26, 0x15, 0x6b, 0x04, 0xa6, 0x2e, 0x94, 0x4b, 0x36, 0x98, 0x99, 0x51,
-	0x12, 0x0d, 0x09, 0x72, 0xa4, 0xfa, 0xc8, 0x02, 0x73, 0x33, 0x45, 0x40, 0x59, 0xb1, 0x13, 0x99,
-	0xca, 0x23, 0x43, 0xdc, 0x9e, 0x85, 0xc9, 0x58, 0x73, 0x40, 0x06, 0xea, 0x23, 0x9b, 0x2c, 0xcc,
-	0x0c, 0x9a, 0xc4, 0x04, 0x0a, 0xdc, 0xd1, 0x98, 0x40, 0x83, 0x30, 0xd0, 0x00, 0xc6, 0x50, 0xe1,
-	0x65, 0x1a, 0x68, 0x66, 0x26, 0x51, 0x96, 0x14, 0x28, 0xf1, 0x8a, 0x2e, 0x29, 0xd0, 0x22, 0xd8,
-	0x2d, 0xd6, 0x4c, 0xa8, 0xf1, 0x2a, 0xd9, 0xed, 0x4c, 0xa6, 0x65, 0xd7, 0xd7, 0x8a, 0x7c, 0x43,
-	0x77, 0x7d, 0xad, 0x49, 0x92, 0xc3, 0x50, 0xe5, 0xae, 0x96, 0xc3, 0xd0, 0xe5, 0xc7, 0xac, 0x64,
-	0xa2, 0x44, 0x61, 0xd7, 0x04, 0xb0, 0x34, 0x8b, 0xd0, 0xa5, 0xcc, 0x81, 0x3a, 0xcb, 0x5a, 0xe6,
-	0x40, 0x9f, 0xd4, 0xde, 0x21, 0x85, 0x5e, 0xd7, 0xed, 0x6d, 0x92, 0xed, 0x7f, 0x92, 0x60, 0xc5,
-	0xde, 0xc8, 0xec, 0xbf, 0x38, 0x58, 0xf9, 0xb3, 0xca, 0xe4, 0x78, 0xe6, 0xb9, 0xfe, 0xc9, 0xa9,
-	0xec, 0xc5, 0x05, 0xcf, 0xa0, 0x61, 0x1f, 0x07, 0x4c, 0xd3, 0x9d, 0xca, 0x9e, 0x9c, 0xf6, 0x44,
-	0x4a, 0xd1, 0x87, 0x2f, 0x65, 0x0f, 0x16, 0xf4, 0xe1, 0x4b, 0xb4, 0x1b, 0xf8, 0x81, 0x99, 0x7b,
-	0x2a, 0x3b, 0x6d, 0xe6, 0x94, 0x92, 0xd8, 0x82, 0x95, 0x11, 0xae, 0x52, 0xb7, 0xe7, 0xce, 0x54,
-	0xf7, 0xd6, 0x61, 0x40, 0x42, 0x79, 0x3a, 0xc3, 0xc5, 0x42, 0x43, 0xa8, 0xbb, 0x16, 0xe6, 0x06,
-	0x0d, 0x31, 0x10, 0x81, 0x39, 0xa7, 0x73, 0x9f, 0x46, 0x32, 0xd9, 0x5f, 0x87, 0x06, 0x0d, 0xbf,
-	0x54, 0x87, 0x56, 0x7c, 0x8a, 0x7e, 0x6d, 0xd0, 0x5f, 0x9d, 0x80, 0x84, 0x36, 0x5c, 0x5d, 0x7a,
-	0x9e, 0x40, 0xc9, 0x1e, 0x9b, 0x1b, 0x29, 0x02, 0x8d, 0x6b, 0x5d, 0xe7, 0x58, 0xf5, 0x57, 0x18,
-	0xd7, 0x30, 0x25, 0xfb, 0x84, 0xc8, 0xc8, 0xeb, 0x3e, 0x21, 0x72, 0xe0, 0x8b, 0xdc, 0xf1, 0x21,
-	0xfa, 0x58, 0x88, 0x5c, 0x39, 0x31, 0x78, 0x01, 0x89, 0xca, 0x84, 0x3e, 0xa2, 0x3a, 0x65, 0x5a,
-	0x8c, 0x88, 0xaa, 0x4c, 0x8e, 0x53, 0xd2, 0x96, 0x2e, 0x13, 0x93, 0x2b, 0x23, 0xe5, 0xf6, 0xea,
-	0x48, 0x69, 0xff, 0x66, 0x9c, 0x15, 0xfb, 0x63, 0x53, 0xa7, 0x62, 0x04, 0x08, 0x26, 0xdd, 0x98,
-	0x1a, 0x01, 0x82, 0x29, 0x17, 0xbe, 0xd8, 0x9a, 0x75, 0x66, 0x9e, 0xaf, 0x06, 0xe7, 0xcc, 0x94,
-	0x92, 0xa1, 0x71, 0x3b, 0xb1, 0x3a, 0x6e, 0x63, 0xbf, 0xd6, 0x05, 0x27, 0xb5, 0x2d, 0x06, 0x25,
-	0xa3, 0x3d, 0x8d, 0x9e, 0x3f, 0x5d, 0x1e, 0x85, 0xdd, 0x02, 0xcf, 0xa0, 0x21, 0xa6, 0x3b, 0x1d,
-	0x07, 0x18, 0xa9, 0xe3, 0x85, 0x41, 0x5b, 0xa9, 0x79, 0x66, 0xcd, 0x1c, 0x81, 0x98, 0xe3, 0xe9,
-	0x0c, 0xbc, 0xc6, 0x26, 0xb8, 0x35, 0xae, 0x54, 0x72, 0xc1, 0x35, 0x68, 0xf6, 0xef, 0xc4, 0xd8,
-	0x56, 0xb7, 0xd5, 0xec, 0x18, 0xcd, 0x73, 0x87, 0x65, 0xdd, 0xf9, 0x00, 0x17, 0xed, 0x17, 0xab,
-	0x4b, 0xe7, 0xa6, 0x97, 0x05, 0x23, 0xb8, 0x48, 0xe1, 0xba, 0x37, 0x73, 0x61, 0xde, 0x93, 0x4c,
-	0xf1, 0xe8, 0x1e, 0x67, 0x78, 0x4e, 0xe4, 0x39, 0x57, 0xa6, 0x71, 0x13, 0x26, 0xe7, 0x8f, 0x14,
-	0x5f, 0x22, 0xba, 0xda, 0x18, 0xea, 0x8a, 0x3c, 0xeb, 0x8f, 0x02, 0xae, 0xe5, 0x58, 0x71, 0x25,
-	0xa3, 0x5c, 0x21, 0x65, 0xf3, 0xec, 0x92, 0x92, 0x0b, 0xfb, 0x1f, 0x6c, 0x33, 0x4b, 0x39, 0xf5,
-	0x46, 0x75, 0xc1, 0xce, 0x7d, 0x35, 0xa4, 0xc8, 0xe5, 0x99, 0x80, 0x40, 0x21, 0xcc, 0x6c, 0xe4,
-	0x2c, 0x70, 0x3f, 0xe3, 0x06, 0x6e, 0x28, 0xf1, 0x80, 0x80, 0x76, 0xe2, 0x9f, 0xc0, 0x58, 0x0d,
-	0x43, 0xf3, 0xdb, 0x22, 0x4f, 0x25, 0xad, 0xef, 0xb0, 0x9d, 0xd1, 0x7c, 0xb9, 0x18, 0xe0, 0xca,
-	0xbb, 0xd8, 0x2f, 0xc5, 0xfd, 0x25, 0xb9, 0x3d, 0xc6, 0x4b, 0x98, 0xd5, 0x5f, 0xd0, 0x76, 0x69,
-	0xd7, 0x11, 0x6d, 0x7e, 0x45, 0xc0, 0x17, 0xaf, 0x20, 0x94, 0x3a, 0x35, 0x18, 0xc4, 0x1e, 0x19,
-	0xb7, 0x30, 0xb3, 0x2b, 0xf2, 0x34, 0xcb, 0x5b, 0x8c, 0x09, 0x16, 0xe1, 0x80, 0xd3, 0xee, 0x18,
-	0x74, 0x50, 0xa0, 0x1c, 0x22, 0x01, 0x26, 0xb1, 0x6d, 0x91, 0x3d, 0xf5, 0xe4, 0x4a, 0x2c, 0xd9,
-	0x48, 0x91, 0x17, 0x91, 0xdc, 0xf2, 0x68, 0xad, 0x15, 0x07, 0xdb, 0x4b, 0x0a, 0x07, 0xb2, 0xcf,
-	0x7c, 0x7f, 0xe2, 0xd0, 0x62, 0x78, 0x91, 0x6f, 0x13, 0xb2, 0xa7, 0xc8, 0xa0, 0xe6, 0x5d, 0x81,
-	0xd5, 0x40, 0x43, 0xd0, 0x9c, 0x10, 0x40, 0xd4, 0x42, 0x33, 0x68, 0x59, 0x3f, 0xc0, 0x43, 0x28,
-	0x50, 0xad, 0x99, 0x3f, 0x9c, 0x0c, 0x28, 0xe6, 0x78, 0x4f, 0xb8, 0xf0, 0x45, 0x20, 0xf7, 0x90,
-	0xfa, 0x40, 0xc4, 0x1e, 0xd0, 0x0c, 0x01, 0x0e, 0xfe, 0x2d, 0x5e, 0x48, 0xf4, 0xfb, 0x02, 0x6d,
-	0x29, 0x74, 0x13, 0xb3, 0x88, 0x45, 0x16, 0x2d, 0x5a, 0x41, 0x82, 0xd3, 0xba, 0x68, 0xd1, 0x14,
-	0x84, 0xfb, 0x94, 0x5d, 0x46, 0xdc, 0x62, 0x76, 0xe4, 0x87, 0xc0, 0x1f, 0x08, 0xf0, 0x25, 0xc8,
-	0xeb, 0x42, 0x96, 0xc1, 0xf0, 0x1e, 0xdb, 0x42, 0x06, 0xdc, 0xb7, 0x90, 0x50, 0x8a, 0x3a, 0x0a,
-	0x40, 0xc5, 0xad, 0x8b, 0x10, 0x6a, 0x38, 0x9d, 0x4d, 0x25, 0x2a, 0xaf, 0x51, 0x15, 0x20, 0x86,
-	0x84, 0x14, 0x7b, 0x22, 0x12, 0xf6, 0xa1, 0x16, 0xb2, 0x8a, 0x54, 0xc2, 0xd9, 0x0c, 0x09, 0x03,
-	0x6f, 0xb1, 0x90, 0x28, 0x0a, 0xb4, 0xf2, 0x40, 0xe4, 0x8b, 0x45, 0xa8, 0x8d, 0x4e, 0x87, 0xf3,
-	0x39, 0x28, 0xc0, 0x10, 0xaf, 0xa0, 0xdb, 0xa8, 0x29, 0xf2, 0x56, 0x84, 0x5c, 0xbc, 0x18, 0xce,
-	0x25, 0xf6, 0x96, 0x16, 0xb2, 0x0b, 0x44, 0x42, 0xdd, 0xa5, 0x82, 0x97, 0x53, 0xe7, 0xb9, 0x3b,
-	0x12, 0x9b, 0x3e, 0x12, 0xfc, 0x91, 0x00, 0xef, 0x40, 0x66, 0x3f, 0xc8, 0x23, 0x1e, 0xb0, 0x08,
-	0xd1, 0xfa, 0xb3, 0x17, 0x83, 0x39, 0xae, 0x52, 0x2f, 0x3d, 0x67, 0x30, 0xc2, 0x98, 0xd7, 0xf1,
-	0x76, 0x6f, 0x0a, 0x36, 0x2c, 0xf3, 0x70, 0xf6, 0xa2, 0x23, 0x73, 0xab, 0x94, 0x69, 0xfd, 0x80,
-	0x5d, 0x17, 0xb5, 0x00, 0xc7, 0x62, 0x79, 0xba, 0xca, 0xfb, 0x8e, 0xe0, 0xc5, 0xb2, 0x9b, 0x02,
-	0x11, 0x65, 0xaf, 0xb0, 0xb7, 0x44, 0x83, 0xc2, 0x04, 0xec, 0x8e, 0xc0, 0x56, 0x56, 0x0a, 0xb0,
-	0x45, 0x01, 0x65, 0x6c, 0x5e, 0x89, 0x89, 0x16, 0x71, 0x8b, 0x95, 0x70, 0x4d, 0x29, 0x64, 0x0c,
-	0x65, 0xc1, 0xb5, 0x85, 0x74, 0xc3, 0x12, 0x40, 0x7b, 0x02, 0x09, 0x7d, 0x79, 0x2c, 0x81, 0xd7,
-	0x49, 0x7b, 0x48, 0x86, 0x7e, 0x3c, 0x26, 0xdc, 0x27, 0x2c, 0x09, 0x5d, 0xed, 0x48, 0x6e, 0x79,
-	0x18, 0xe3, 0x1f, 0xf4, 0xb5, 0x23, 0x63, 0x48, 0x12, 0x28, 0x54, 0xca, 0xd4, 0xf1, 0x07, 0xde,
-	0xcb, 0x81, 0x0a, 0x9c, 0xb7, 0x48, 0x29, 0x40, 0xe5, 0x2f, 0x3b, 0x32, 0x7a, 0xbe, 0xc9, 0x0a,
-	0x12, 0x45, 0x1f, 0xde, 0xa6, 0xc8, 0x5b, 0x60, 0xb4, 0xcd, 0x48, 0x84, 0x13, 0xf8, 0x9b, 0x60,
-	0x33, 0x02, 0xa2, 0x03, 0x4d, 0xf5, 0x2d, 0x8c, 0xe6, 0xc1, 0x36, 0x84, 0xc3, 0xa9, 0xbe, 0x55,
-	0x23, 0x9a, 0x42, 0xf9, 0x81, 0x44, 0x96, 0x46, 0xf5, 0xa2, 0x12, 0xf9, 0x4a, 0xa2, 0x1d, 0x2d,
-	0x51, 0x2f, 0x2c, 0x91, 0xaf, 0x25, 0xba, 0xac, 0x25, 0xea, 0x45, 0x24, 0xf2, 0x03, 0x89, 0xae,
-	0x18, 0xdf, 0x52, 0x12, 0x7d, 0x8f, 0x5d, 0x13, 0x28, 0x98, 0x22, 0x3c, 0xdf, 0x1f, 0x9c, 0xba,
-	0x23, 0x0f, 0x0f, 0x57, 0x2d, 0x06, 0xf3, 0x7b, 0xb7, 0x85, 0xfb, 0x09, 0x23, 0x0e, 0x32, 0x8c,
-	0xe6, 0xdc, 0xf7, 0x9b, 0x2a, 0xb7, 0x73, 0xef, 0xf6, 0x19, 0x9c, 0x5f, 0xde, 0x16, 0x9e, 0xe9,
-	0x7a, 0xce, 0x2f, 0xcf, 0xe4, 0xbc, 0x27, 0xfc, 0xd5, 0x0d, 0x9c, 0xf7, 0xce, 0xe2, 0xfc, 0x52,
-	0x38, 0xb0, 0x1b, 0x38, 0xbf, 0xb4, 0xbe, 0x62, 0x65, 0xc5, 0x49, 0xce, 0x1e, 0xd8, 0xf1, 0x74,
-	0x0a, 0x0e, 0xb7, 0x3b, 0x03, 0x1f, 0xef, 0x4d, 0xc1, 0xfa, 0x06, 0xb1, 0x92, 0x7f, 0x58, 0x0d,
-	0xb2, 0xad, 0x9f, 0x65, 0x6f, 0x29, 0x66, 0x31, 0x1c, 0xbf, 0x18, 0x82, 0x3d, 0x9b, 0xfc, 0x6f,
-	0x09, 0xfe, 0x6b, 0xc4, 0x8f, 0x63, 0xf2, 0x13, 0x40, 0x98, 0x25, 0x1c, 0xb3, 0x1b, 0xa2, 0x04,
-	0x5a, 0x52, 0x42, 0x5e, 0x5c, 0x53, 0x12, 0xf3, 0x2b, 0x99, 0xec, 0xee, 0xbb, 0xd1, 0xa3, 0x32,
-	0x9b, 0x96, 0x9f, 0xf8, 0x75, 0xfc, 0xcc, 0xa6, 0xb5, 0xa9, 0x87, 0x6c, 0x07, 0x3f, 0xb4, 0x98,
-	0x4a, 0x5f, 0x41, 0x96, 0xfe, 0x71, 0xb4, 0xc3, 0x84, 0x3d, 0x12, 0x7e, 0x09, 0x98, 0xba, 0x53,
-	0xd3, 0x87, 0xb0, 0x7f, 0x3b, 0x89, 0x4b, 0x64, 0x34, 0x99, 0xf7, 0x17, 0xc3, 0x63, 0x07, 0xd7,
-	0x86, 0xd5, 0xf6, 0xae, 0xda, 0xaf, 0x5f, 0xb3, 0x36, 0x2c, 0xb0, 0x7a, 0x4f, 0x98, 0x07, 0x2c,
-	0x78, 0x4e, 0x4d, 0xcc, 0x3b, 0x30, 0x47, 0x6f, 0xda, 0x17, 0x23, 0x40, 0xf9, 0xef, 0x27, 0x58,
-	0x56, 0x95, 0x00, 0xaa, 0x2b, 0x06, 0xdb, 0xcf, 0xd3, 0xa3, 0x99, 0xdc, 0x80, 0xbe, 0xba, 0x7e,
-	0xe3, 0x9a, 0x17, 0x1c, 0x73, 0xb3, 0xfb, 0x36, 0xcb, 0x0d, 0x27, 0x93, 0xd9, 0x68, 0xe8, 0x3b,
-	0xe3, 0x33, 0xbe, 0x1b, 0x80, 0xac, 0xef, 0x33, 0x66, 0x34, 0x1c, 0x79, 0x4c, 0x6f, 0xae, 0xb2,
-	0x18, 0x8d, 0x67, 0xe0, 0x71, 0xd3, 0x5b, 0xef, 0x2f, 0x0f, 0xc4, 0x0e, 0x66, 0x7c, 0xf3, 0x29,
-	0xc9, 0xbc, 0x86, 0x36, 0xc6, 0x50, 0xcd, 0x94, 0x2f, 0x02, 0x2c, 0xda, 0x02, 0x7c, 0xff, 0xbc,
-	0x96, 0xdd, 0xc3, 0x03, 0x9f, 0x9c, 0x78, 0xca, 0xbf, 0x81, 0x47, 0x97, 0xe0, 0xdf, 0xda, 0x2d,
-	0x91, 0x9b, 0x62, 0xbd, 0x92, 0x0e, 0x2d, 0x1a, 0xab, 0xd4, 0x74, 0x60, 0x54, 0xac, 0x60, 0xbe,
-	0xfe, 0xae, 0x65, 0xb0, 0xfe, 0x9e, 0x3c, 0x67, 0x07, 0xf9, 0x9f, 0x97, 0xd8, 0x56, 0x78, 0x78,
-	0x3e, 0xc7, 0x21, 0x2c, 0x87, 0x0e, 0x29, 0x62, 0xa6, 0x4e, 0x63, 0x00, 0x33, 0x7a, 0x35, 0x9a,
-	0xe8, 0x15, 0x55, 0x99, 0xb2, 0xbe, 0xcb, 0xde, 0x00, 0xe6, 0x09, 0x3a, 0x4c, 0x44, 0x19, 0x1c,
-	0x79, 0xd0, 0xb6, 0xb8, 0xb9, 0x47, 0x8b, 0xac, 0x57, 0x64, 0x76, 0x55, 0xe4, 0xee, 0xcb, 0x4c,
-	0xf0, 0x73, 0xaf, 0x46, 0xf8, 0x70, 0xe9, 0x16, 0xd9, 0xc8, 0x2f, 0xb8, 0x1c, 0x62, 0x7b, 0x40,
-	0x79, 0xe8, 0xf6, 0xbb, 0xd3, 0x85, 0xef, 0x2d, 0x65, 0xf7, 0x27, 0x77, 0x28, 0x44, 0xb3, 0x3e,
-	0x62, 0x25, 0x72, 0x46, 0x3c, 0xe7, 0xc8, 0xf1, 0x70, 0xf1, 0x7e, 0x21, 0x17, 0x68, 0xb7, 0x05,
-	0x9d, 0x6b, 0xb2, 0xf5, 0x0e, 0x9e, 0x9a, 0x43, 0xe8, 0xa9, 0x2b, 0x9c, 0x60, 0x5a, 0xb0, 0xcd,
-	0x0b, 0x5a, 0x53, 0x90, 0xb0, 0x4d, 0x9e, 0x42, 0x14, 0x07, 0x04, 0xb5, 0x6a, 0xab, 0xd3, 0xd6,
-	0xbb, 0xac, 0x48, 0xff, 0x15, 0xbf, 0xf4, 0xa2, 0x88, 0x28, 0x0b, 0x00, 0x5f, 0xf6, 0x29, 0xf8,
-	0x95, 0xb2, 0xf1, 0xc8, 0x83, 0xca, 0x01, 0x85, 0x2a, 0x86, 0xd9, 0x20, 0xa7, 0xca, 0x26, 0x3f,
-	0x07, 0xb4, 0x7d, 0x24, 0xb3, 0xaf, 0x33, 0xf4, 0x7b, 0x07, 0x23, 0xe8, 0x23, 0xcf, 0xc4, 0xe4,
-	0x0b, 0x3a, 0x01, 0x42, 0x15, 0xd3, 0xc8, 0x8b, 0x46, 0x28, 0x73, 0xb7, 0xc8, 0x4d, 0x46, 0x0a,
-	0x65, 0xbf, 0xcd, 0xf2, 0x73, 0xb0, 0xdb, 0x81, 0x58, 0xf9, 0xd5, 0xd3, 0x2b, 0x92, 0xf6, 0x05,
-	0x05, 0xab, 0x7f, 0xea, 0x42, 0xc0, 0xa4, 0x10, 0x72, 0x76, 0x15, 0x34, 0x03, 0x32, 0xfc, 0xd3,
-	0x01, 0xe4, 0x92, 0x84, 0x20, 0x4d, 0x42, 0xb0, 0xbd, 0x51, 0xa9, 0x2f, 0x61, 0xf0, 0x7b, 0xe1,
-	0xfa, 0xa2, 0xa5, 0x2c, 0xd9, 0xde, 0x44, 0xef, 0x4a, 0xb2, 0xf5, 0x3e, 0xdb, 0xc2, 0xda, 0x9c,
-	0xba, 0xc7, 0x64, 0x55, 0x6a, 0x86, 0x45, 0xbf, 0xbd, 0xa9, 0x89, 0x58, 0xe2, 0x70, 0x02, 0xa1,
-	0x1c, 0x9e, 0xf7, 0x51, 0x1f, 0xa6, 0x79, 0x76, 0x5b, 0xd3, 0x83, 0x8f, 0x3b, 0xa7, 0xcb, 0x89,
-	0x60, 0x54, 0x50, 0x9a, 0x6d, 0xb7, 0x35, 0x5d, 0x42, 0xc1, 0xd5, 0x99, 0xdc, 0x19, 0x8c, 0x49,
-	0xe1, 0x93, 0x19, 0x06, 0x36, 0x57, 0xe9, 0xeb, 0x93, 0x3b, 0x35, 0x41, 0x3d, 0x44, 0x22, 0x3a,
-	0xa1, 0x61, 0x9c, 0xd2, 0xee, 0x1b, 0xe4, 0x84, 0x9a, 0x68, 0xa9, 0x63, 0xf0, 0xb7, 0x02, 0x96,
-	0x05, 0x0c, 0x11, 0x0e, 0x2d, 0xff, 0x80, 0xbf, 0xa5, 0xd0, 0x5d, 0x41, 0xb5, 0x3e, 0x63, 0x57,
-	0x23, 0x48, 0x55, 0xfa, 0x35, 0xf2, 0x44, 0x43, 0x78, 0x59, 0xfc, 0x6d, 0x76, 0x39, 0x60, 0x9a,
-	0xa3, 0x59, 0x53, 0x2b, 0x97, 0xc3, 0x02, 0x75, 0x74, 0x8e, 0xf5, 0x25, 0xbb, 0xb6, 0xca, 0xa1,
-	0xbe, 0x44, 0x0e, 0xde, 0xd5, 0x28, 0x9b, 0xfc, 0x18, 0x35, 0x93, 0x6b, 0x36, 0xd3, 0x9b, 0xaa,
-	0x99, 0x1a, 0x2b, 0xcd, 0xe4, 0xae, 0x36, 0xd3, 0x5b, 0x4a, 0xaa, 0x46, 0xb4, 0x99, 0xa8, 0x1e,
-	0xee, 0x4a, 0x3d, 0x6e, 0x84, 0x39, 0x56, 0xea, 0xe1, 0xae, 0xaf, 0xc7, 0xdb, 0xaa, 0x1e, 0x8d,
-	0x75, 0xf5, 0x80, 0x9e, 0x33, 0x99, 0x8c, 0x64, 0x0d, 0xc8, 0x5f, 0xcf, 0x02, 0x81, 0x84, 0xc7,
-	0x4a, 0xca, 0x4c, 0x55, 0xda, 0x3b, 0xb2, 0x92, 0x04, 0x09, 0x3a, 0x2f, 0xe2, 0xa4, 0x4a, 0xc9,
-	0xf1, 0xc6, 0x62, 0xa5, 0x36, 0x51, 0xef, 0x2a, 0x5b, 0x95, 0xf3, 0xae, 0xd4, 0xbb, 0x04, 0xc9,
-	0x82, 0xc0, 0xf2, 0x11, 0x69, 0x54, 0xfa, 0x3d, 0xfd, 0x3d, 0xa3, 0xbe, 0x7b, 0x6c, 0xc7, 0x84,
-	0xa9, 0x32, 0x29, 0x44, 0xbc, 0x64, 0x60, 0x03, 0xf9, 0xc6, 0xfe, 0xe4, 0xa9, 0xac, 0x25, 0xc5,
-	0x7b, 0x39, 0xa4, 0x50, 0x35, 0x31, 0x0e, 0x50, 0xd9, 0xaa, 0xac, 0x0f, 0x65, 0x1c, 0x20, 0x41,
-	0xb2, 0x20, 0x18, 0x2b, 0x04, 0x52, 0xd6, 0x94, 0x62, 0x28, 0x51, 0xb6, 0xac, 0x2a, 0xc4, 0xd2,
-	0x01, 0x40, 0x95, 0x45, 0xd1, 0xd3, 0xb6, 0x86, 0xc9, 0xc2, 0x3e, 0x64, 0x82, 0x64, 0xd6, 0xf6,
-	0xe3, 0xe0, 0xab, 0x46, 0x75, 0xc1, 0x20, 0x42, 0x40, 0x55, 0xee, 0x4f, 0x93, 0x41, 0x98, 0xe8,
-	0xa0, 0xc2, 0x6e, 0x50, 0xe1, 0x4f, 0xa8, 0xc2, 0xae, 0x59, 0x61, 0x37, 0x5a, 0xe1, 0xef, 0xd0,
-	0xa7, 0xdd, 0x70, 0x85, 0x61, 0x60, 0x93, 0x63, 0x37, 0x15, 0xb5, 0x47, 0x03, 0x1b, 0xd1, 0xa8,
-	0xb0, 0x4f, 0x98, 0x65, 0x40, 0x54, 0x71, 0x9f, 0x0a, 0x60, 0x29, 0x00, 0x06, 0x92, 0x4d, 0x67,
-	0x63, 0xd5, 0x65, 0x6e, 0x93, 0x64, 0x48, 0xd1, 0x92, 0xe9, 0x6c, 0x55, 0xd4, 0x1d, 0x92, 0x4c,
-	0x81, 0x02, 0x55, 0x08, 0xa4, 0x54, 0xc5, 0x5d, 0x19, 0x83, 0x00, 0x29, 0x50, 0x45, 0x00, 0x50,
-	0x65, 0x7d, 0x46, 0xaa, 0xd0, 0xb0, 0x40, 0x15, 0x02, 0x6b, 0xa8, 0xe2, 0xf3, 0xe0, 0xab, 0x61,
-	0x55, 0x84, 0x80, 0xaa, 0xdc, 0x7b, 0xa4, 0x0a, 0x13, 0x4d, 0x45, 0xdb, 0x2e, 0xcb, 0xc8, 0xbd,
-	0x33, 0x68, 0xa9, 0xec, 0x10, 0x0f, 0x40, 0xd1, 0x21, 0xc5, 0x0d, 0x47, 0xa3, 0x32, 0x02, 0xd2,
-	0x88, 0xf8, 0x3c, 0xf1, 0x0b, 0xf8, 0x3c, 0xf6, 0x1f, 0x31, 0x96, 0x12, 0xf7, 0x74, 0xe4, 0xf1,
-	0xab, 0x58, 0xf4, 0x1a, 0x88, 0xbc, 0xc4, 0x23, 0x5c, 0xaa, 0xe8, 0xe9, 0xc7, 0x78, 0xd4, 0x11,
-	0xdc, 0x78, 0xfa, 0xd1, 0xac, 0x46, 0x62, 0xe5, 0xa6, 0x49, 0xb4, 0x1a, 0xe6, 0x31, 0xb5, 0x64,
-	0xe4, 0x98, 0xda, 0xdb, 0x2c, 0x81, 0xa7, 0xf4, 0xe9, 0xec, 0x48, 0xd1, 0x58, 0xbf, 0xe3, 0x87,
-	0x1c, 0x73, 0xbe, 0xc5, 0x01, 0xc8, 0xf0, 0x69, 0xb5, 0xcc, 0xc5, 0x4e, 0xab, 0x7d, 0xc1, 0x0a,
-	0xc6, 0x01, 0x52, 0xf4, 0x97, 0x12, 0x1b, 0x4f, 0x90, 0xe6, 0x83, 0x13, 0xa4, 0x8b, 0x35, 0xb7,
-	0x3b, 0x72, 0xaf, 0x77, 0xbb, 0xa3, 0xfc, 0x77, 0x33, 0x2c, 0xd7, 0x9e, 0x3b, 0xd2, 0x7d, 0xbc,
-	0x1b, 0xba, 0x1e, 0x70, 0x23, 0xa2, 0xb9, 0x3d, 0x0d, 0x34, 0x0f, 0x3f, 0x7c, 0x0f, 0x3d, 0xdd,
-	0x25, 0xf4, 0x32, 0xb9, 0xea, 0x7a, 0x73, 0x33, 0xd7, 0xa1, 0xc0, 0x71, 0x89, 0x87, 0x58, 0xac,
-	0x40, 0xff, 0x06, 0xc7, 0xde, 0x6c, 0x39, 0x97, 0x87, 0x70, 0xde, 0x3f, 0x8f, 0xff, 0x00, 0xc1,
-	0x3c, 0x3f, 0x09, 0x12, 0x10, 0x1b, 0x64, 0xe8, 0x54, 0x8f, 0x3a, 0x42, 0xf2, 0xce, 0xe6, 0x42,
-	0xe8, 0x1c, 0x8d, 0xc3, 0x15, 0x87, 0x55, 0x61, 0xb9, 0xe5, 0x54, 0xb1, 0x27, 0xa3, 0x87, 0xde,
-	0xa3, 0xec, 0x7d, 0x05, 0xe5, 0x01, 0x17, 0xb6, 0xc1, 0x48, 0xec, 0xc0, 0xca, 0xd3, 0x4c, 0x67,
-	0xb4, 0x01, 0xed, 0xd4, 0x72, 0x89, 0x47, 0xc9, 0xc7, 0xd0, 0x37, 0xbd, 0xd9, 0x2b, 0x79, 0x08,
-	0xec, 0x0c, 0xc9, 0x6b, 0x04, 0xe4, 0x8a, 0xa3, 0xfc, 0x15, 0x4b, 0x53, 0x93, 0xc0, 0x34, 0x4f,
-	0x3e, 0x26, 0xc6, 0x7f, 0x2a, 0xf6, 0xb4, 0x22, 0xa1, 0x8c, 0x38, 0x89, 0xe4, 0xcb, 0x7f, 0x8b,
-	0xf2, 0x2b, 0x96, 0x37, 0xda, 0x13, 0xaf, 0x3e, 0x29, 0xcb, 0x3a, 0x27, 0x80, 0xd4, 0x38, 0x88,
-	0x2a, 0xe8, 0xab, 0xa4, 0x3e, 0xea, 0xc1, 0x6f, 0x84, 0xbf, 0x2a, 0x0a, 0x0f, 0x3e, 0x2d, 0x92,
-	0x20, 0x77, 0x46, 0x6a, 0x21, 0xdc, 0xc3, 0x62, 0x17, 0xe8, 0x61, 0xe5, 0x1f, 0xb0, 0x9c, 0xd6,
-	0xc1, 0xb7, 0x60, 0xff, 0x2e, 0x4b, 0x93, 0x0a, 0x60, 0x1c, 0xc9, 0xd0, 0x01, 0xa4, 0xb3, 0x38,
-	0x15, 0xa4, 0xfc, 0x05, 0xcb, 0xc8, 0xf6, 0x7f, 0x3d, 0x46, 0xfb, 0x68, 0xdd, 0x09, 0x61, 0x06,
-	0x9a, 0xab, 0xf4, 0x5b, 0x55, 0x3c, 0x75, 0x53, 0x62, 0x05, 0xfa, 0x3f, 0x38, 0xe0, 0xed, 0x7e,
-	0xa7, 0x94, 0x46, 0x28, 0xaf, 0x77, 0xeb, 0xfc, 0x31, 0x5e, 0x97, 0x29, 0x42, 0x7d, 0x5b, 0x2a,
-	0x99, 0x10, 0xc7, 0x89, 0x79, 0x1d, 0x6f, 0xd2, 0x24, 0xe9, 0x9c, 0x71, 0xb7, 0xc7, 0xdb, 0xdf,
-	0x94, 0x52, 0xf6, 0xdf, 0x88, 0xe3, 0x86, 0x0c, 0x9e, 0xa2, 0x70, 0x2e, 0x3c, 0x08, 0xcb, 0x01,
-	0x30, 0xbe, 0x71, 0x00, 0x8c, 0x8e, 0xd2, 0x89, 0x6f, 0x35, 0x4a, 0x27, 0xcf, 0x9d, 0x6c, 0x56,
-	0x07, 0xb2, 0x94, 0xf8, 0xd2, 0x85, 0x07, 0xb2, 0xb0, 0x25, 0xa4, 0x2f, 0x32, 0x5d, 0xfd, 0x56,
-	0x92, 0x65, 0x55, 0xc7, 0x58, 0xbb, 0x2e, 0xf0, 0x11, 0xcb, 0x50, 0xa7, 0xda, 0xbc, 0x38, 0x90,
-	0x16, 0xfd, 0xe9, 0x75, 0xe7, 0xa4, 0x90, 0xac, 0xc9, 0x8b, 0x4c, 0x2b, 0x66, 0xef, 0x4c, 0x45,
-	0x77, 0xc4, 0x36, 0xf4, 0xce, 0xff, 0xb3, 0x5b, 0x02, 0xb9, 0x0b, 0xdf, 0x12, 0x00, 0x1b, 0x39,
-	0x11, 0x37, 0x7c, 0x06, 0xe2, 0x5e, 0xdd, 0xea, 0x3d, 0x06, 0xe3, 0xfe, 0x0f, 0xcf, 0x9f, 0x18,
-	0x77, 0xb7, 0xee, 0xb1, 0xfc, 0x33, 0x77, 0x32, 0x19, 0xcc, 0xc5, 0xad, 0x19, 0x79, 0xd6, 0xdf,
-	0x98, 0xf6, 0x82, 0x1b, 0x35, 0x9c, 0x3d, 0x0b, 0x6e, 0xd7, 0xa8, 0x4b, 0x03, 0x69, 0xe3, 0xd2,
-	0x40, 0xb0, 0xde, 0xc2, 0xce, 0x5e, 0x6f, 0x09, 0x5f, 0x2f, 0xc8, 0x5f, 0xf4, 0x7a, 0x81, 0xfd,
-	0x25, 0x2b, 0x86, 0xc6, 0x33, 0xb1, 0x5a, 0x27, 0xd6, 0xa3, 0x36, 0x8f, 0xb6, 0x04, 0xb0, 0x7f,
-	0x2d, 0x75, 0xc6, 0xe2, 0xd3, 0x6b, 0x18, 0xd9, 0xb7, 0xef, 0x8c, 0x91, 0x1b, 0x2a, 0xc9, 0x68,
-	0x43, 0x6f, 0xb8, 0xa1, 0x62, 0x5a, 0x75, 0xea, 0x5c, 0xab, 0xfe, 0x48, 0x5c, 0xb3, 0xf5, 0xf1,
-	0xa4, 0x2e, 0x9e, 0xa1, 0xdc, 0x09, 0xd7, 0x03, 0x97, 0xb7, 0x1c, 0x4e, 0x88, 0x70, 0x07, 0xc8,
-	0x5c, 0xa4, 0x03, 0xdc, 0x36, 0x2e, 0x75, 0x65, 0xa3, 0xee, 0x91, 0x2a, 0x7f, 0xb9, 0x08, 0xae,
-	0x7a, 0x59, 0x55, 0xb6, 0x43, 0xff, 0x07, 0xcb, 0x39, 0x98, 0x88, 0x33, 0x20, 0xe1, 0x72, 0xc2,
-	0xb5, 0x59, 0x2b, 0xdc, 0x25, 0xc2, 0xf7, 0x05, 0x5c, 0x90, 0x30, 0xb8, 0x08, 0x17, 0xb2, 0x5c,
-	0xba, 0x74, 0x74, 0xb7, 0xc0, 0x4b, 0x26, 0xbc, 0x0f, 0xf4, 0x8b, 0x5f, 0xcb, 0xfc, 0xb6, 0x77,
-	0x5c, 0x42, 0x3d, 0xb4, 0x78, 0xe1, 0x1e, 0xaa, 0xee, 0x62, 0x6d, 0x05, 0x77, 0xb1, 0xec, 0x5f,
-	0x2a, 0x30, 0x16, 0xb4, 0x9b, 0x69, 0x86, 0xb1, 0x73, 0xcc, 0x50, 0xeb, 0x39, 0x7e, 0xae, 0x9e,
-	0x77, 0x59, 0x06, 0x32, 0x71, 0x59, 0x56, 0x5e, 0x55, 0x51, 0x49, 0xf0, 0x93, 0xd5, 0x99, 0xdf,
-	0x5c, 0xf4, 0x9a, 0x55, 0x20, 0x55, 0xe4, 0xb0, 0x2f, 0x32, 0xe1, 0x29, 0xba, 0xd9, 0x54, 0x68,
-	0x60, 0x13, 0x13, 0x17, 0x10, 0x2e, 0xa1, 0x7a, 0xb4, 0x48, 0x18, 0xa3, 0x45, 0xd8, 0xb0, 0xcf,
-	0x9b, 0x9c, 0x22, 0xbd, 0x27, 0x73, 0xc1, 0xde, 0x13, 0x5a, 0xc5, 0x4d, 0xcb, 0x65, 0x3f, 0xbd,
-	0x8a, 0x8b, 0x3a, 0x41, 0x5b, 0xca, 0x93, 0x58, 0xf8, 0x1f, 0x9b, 0x8b, 0x86, 0xc7, 0x57, 0x62,
-	0x10, 0xcd, 0x72, 0x95, 0x34, 0x2c, 0xab, 0x70, 0x8e, 0x65, 0xd5, 0x68, 0x9d, 0x8f, 0x16, 0xd8,
-	0xc9, 0x42, 0xa5, 0xa5, 0x5c, 0x5b, 0x63, 0x29, 0xb2, 0xd7, 0x6c, 0x8f, 0xc2, 0x04, 0xeb, 0x07,
-	0xac, 0xb4, 0xa4, 0x23, 0x60, 0x62, 0x17, 0x16, 0xc5, 0x96, 0x57, 0xa1, 0xd6, 0xdd, 0x9e, 0xdf,
-	0x36, 0xb0, 0x48, 0xb4, 0x1f, 0xe8, 0x73, 0xd5, 0x97, 0x58, 0x91, 0x2e, 0xf4, 0x0e, 0x9a, 0x95,
-	0x6e, 0xaf, 0x8e, 0xd7, 0x37, 0xc0, 0xdf, 0x91, 0xa4, 0xca, 0x41, 0xbd, 0x85, 0x57, 0xa4, 0x76,
-	0xd8, 0xb6, 0xa4, 0xd4, 0xbf, 0xae, 0x57, 0xfb, 0xbd, 0x36, 0x2f, 0xc5, 0xed, 0x9f, 0xa4, 0x59,
-	0x9a, 0x54, 0x69, 0xd9, 0xec, 0x06, 0xb8, 0x3c, 0xdd, 0x76, 0x6b, 0x20, 0xef, 0x60, 0x6a, 0xdc,
-	0x60, 0xbf, 0xd2, 0x38, 0xac, 0xd7, 0xa0, 0x54, 0x13, 0xd3, 0xea, 0x55, 0x1a, 0xad, 0x3a, 0x1f,
-	0x48, 0xb7, 0x4a, 0x62, 0xae, 0x80, 0xbb, 0x73, 0x7d, 0x15, 0xd3, 0x68, 0x36, 0x7a, 0x15, 0xbc,
-	0x62, 0x5c, 0xda, 0xb1, 0xde, 0x63, 0x37, 0xcf, 0x00, 0x0c, 0x6a, 0x8d, 0xee, 0xa3, 0xd2, 0x65,
-	0xeb, 0x03, 0x66, 0x9f, 0x85, 0x6a, 0xd6, 0x9b, 0x6d, 0xfe, 0x4d, 0x29, 0x6b, 0xdd, 0x60, 0xe5,
-	0x15, 0x5c, 0x87, 0xd7, 0xeb, 0xcd, 0x4e, 0x0f, 0xc4, 0xb9, 0xb4, 0x56, 0xe4, 0x7e, 0xa7, 0x06,
-	0xbe, 0x9d, 0x12, 0xf9, 0x2a, 0x28, 0xfe, 0x3d, 0x89, 0xd1, 0x55, 0xe6, 0xf5, 0x83, 0x06, 0x38,
-	0x7d, 0xf4, 0xb1, 0x5e, 0xa3, 0x59, 0x6f, 0xf7, 0x7b, 0xa5, 0x37, 0xac, 0x8f, 0xd9, 0x07, 0xab,
-	0xc8, 0xb5, 0xd8, 0x5d, 0x43, 0x32, 0x8d, 0x05, 0xdd, 0x34, 0x1b, 0xad, 0x0a, 0x4a, 0x16, 0xb3,
-	0x6e, 0xb2, 0x37, 0xa3, 0xf9, 0xe8, 0x83, 0x62, 0x59, 0x50, 0x64, 0x0d, 0xbc, 0xd2, 0x37, 0xd9,
-	0xae, 0x44, 0xec, 0xf3, 0x4a, 0xb3, 0xfe, 0xa4, 0xcd, 0x1f, 0xc1, 0xe7, 0x9a, 0xed, 0xc7, 0x90,
-	0x9b, 0x40, 0x85, 0xca, 0xdc, 0x83, 0xea, 0xa0, 0xce, 0x39, 0x28, 0x34, 0x69, 0x7c, 0xb4, 0xd1,
-	0x7a, 0x5c, 0x39, 0x6c, 0xd4, 0x02, 0xd6, 0x46, 0xad, 0x94, 0xb2, 0xae, 0xb1, 0x2b, 0x91, 0xfc,
-	0xf6, 0xfe, 0x7e, 0x9d, 0x77, 0xc1, 0x21, 0x0e, 0x58, 0xc9, 0x8a, 0x50, 0x13, 0xd0, 0x66, 0xad,
-	0x7a, 0x15, 0xe5, 0xcd, 0x18, 0xac, 0xbc, 0x0e, 0xf4, 0x6a, 0xe3, 0xb0, 0x41, 0x2a, 0xcd, 0x19,
-	0x82, 0xea, 0xab, 0xe5, 0x03, 0xe5, 0x87, 0x5b, 0xd6, 0x5b, 0xec, 0x9a, 0xcc, 0x15, 0xb6, 0x18,
-	0x2e, 0x97, 0x41, 0x87, 0xbc, 0x1c, 0xca, 0x56, 0x35, 0xcc, 0x5b, 0x65, 0x76, 0x35, 0x92, 0xd3,
-	0xed, 0x55, 0x38, 0x72, 0x15, 0x56, 0xb8, 0xd4, 0xe7, 0x8a, 0xc6, 0xe7, 0xc4, 0xcd, 0x78, 0xe1,
-	0xef, 0xab, 0xda, 0x96, 0xae, 0x19, 0x06, 0x61, 0x64, 0xf7, 0x5b, 0x95, 0x7e, 0xef, 0x61, 0x9b,
-	0x37, 0xfe, 0x24, 0x14, 0x5e, 0xa6, 0xeb, 0xf6, 0x01, 0x46, 0x31, 0x6f, 0x19, 0x15, 0x15, 0x19,
-	0x21, 0xb6, 0xed, 0x28, 0x9b, 0x12, 0xa9, 0x64, 0x7f, 0xc6, 0x32, 0xfb, 0xee, 0xc4, 0x77, 0xc4,
-	0xfe, 0xe3, 0x96, 0xe7, 0x1c, 0xc1, 0xec, 0x30, 0x08, 0x5e, 0x14, 0x11, 0xcf, 0x2b, 0xdc, 0xe3,
-	0x45, 0xca, 0x90, 0x77, 0xd0, 0xed, 0x9f, 0x8f, 0xb1, 0xbc, 0x71, 0x93, 0xd8, 0xfa, 0x3e, 0xcb,
-	0x3d, 0x1f, 0x7a, 0x2e, 0xf6, 0x7f, 0xe5, 0x0f, 0xdd, 0x58, 0x7b, 0xe7, 0x78, 0xef, 0xb1, 0x84,
-	0xf1, 0x80, 0xa1, 0xfc, 0x39, 0xcb, 0x2a, 0xf2, 0x5a, 0x17, 0x49, 0xdf, 0x0c, 0x8b, 0x9b, 0x37,
-	0xc3, 0x3e, 0x63, 0x39, 0xfd, 0x2e, 0x08, 0x5e, 0x54, 0x7b, 0xe6, 0xbc, 0x92, 0x5c, 0xf8, 0x77,
-	0x03, 0xd3, 0x9f, 0x60, 0x4c, 0x33, 0xe1, 0xe2, 0x78, 0x6e, 0xae, 0x52, 0x52, 0xec, 0xb5, 0xaf,
-	0x8e, 0x04, 0x28, 0x18, 0xd9, 0x18, 0x84, 0x8f, 0x63, 0xa8, 0x8a, 0x3b, 0x9c, 0x44, 0xef, 0xe0,
-	0xc4, 0xc3, 0x77, 0x70, 0xf0, 0x22, 0xbb, 0x03, 0x71, 0xbe, 0x2f, 0x6f, 0x9e, 0xc8, 0x94, 0x5d,
-	0x67, 0xf9, 0xa0, 0x0c, 0xdc, 0x9b, 0xcb, 0x8f, 0x82, 0xa4, 0x94, 0xc3, 0x98, 0x5e, 0x02, 0x2c,
-	0x37, 0x81, 0xf6, 0x13, 0x96, 0xe3, 0x30, 0xc9, 0xd2, 0x61, 0x2b, 0x68, 0x80, 0x1f, 0xcd, 0xa5,
-	0xc2, 0x38, 0xfe, 0x8d, 0x5e, 0x7d, 0x89, 0xc8, 0x56, 0x66, 0x59, 0x7c, 0x51, 0x62, 0xa4, 0x5e,
-	0x04, 0x49, 0x72, 0x9d, 0xb6, 0x7f, 0x2b, 0xc6, 0x98, 0x2e, 0x19, 0x2f, 0xfa, 0xa5, 0xe5, 0xa5,
-	0x8b, 0x95, 0x26, 0xd2, 0x28, 0x2e, 0x21, 0x78, 0x84, 0x67, 0x78, 0x7c, 0xec, 0x39, 0xc7, 0xe8,
-	0x2c, 0xc9, 0xeb, 0x0c, 0x03, 0x94, 0x8c, 0xce, 0x9d, 0xed, 0xe8, 0x4c, 0x79, 0xf9, 0xe1, 0x87,
-	0x20, 0xe9, 0xf7, 0x59, 0x79, 0x95, 0x27, 0x22, 0xdd, 0x6e, 0x94, 0xb1, 0xaa, 0xa4, 0xfd, 0xfd,
-	0x38, 0x4b, 0x35, 0x4e, 0xd1, 0xa7, 0xd8, 0x78, 0x8f, 0x53, 0x64, 0x9b, 0x6b, 0x56, 0x80, 0x1c,
-	0xce, 0xe7, 0x23, 0x19, 0xf8, 0xae, 0x20, 0x2b, 0x90, 0xc7, 0x05, 0x02, 0x2f, 0xb5, 0x8d, 0x67,
-	0xa3, 0x67, 0xce, 0x9a, 0x4b, 0x70, 0x84, 0xad, 0x89, 0x5c, 0x2e, 0x51, 0xd0, 0xea, 0x69, 0xb1,
-	0xcd, 0x41, 0x4e, 0xb6, 0xba, 0xa3, 0x2e, 0x69, 0xe5, 0x1e, 0x4b, 0x62, 0xd9, 0x6b, 0xad, 0x7c,
-	0x4b, 0xee, 0x42, 0xc7, 0xe4, 0xad, 0x99, 0x60, 0xca, 0x4f, 0x9c, 0x73, 0x83, 0x8b, 0xb3, 0x34,
-	0x49, 0xb1, 0xb6, 0xdc, 0xcf, 0x19, 0x0b, 0xac, 0x66, 0xb5, 0xc6, 0x86, 0x75, 0x19, 0x38, 0xfb,
-	0xcd, 0xe0, 0x72, 0x50, 0xa5, 0xd3, 0xa9, 0xd2, 0x3d, 0xe6, 0x5a, 0xbb, 0xfa, 0xa8, 0x8e, 0x73,
-	0xf3, 0x1f, 0xa4, 0x58, 0x9a, 0x2e, 0x71, 0x81, 0x37, 0x48, 0x0f, 0xd2, 0x24, 0x44, 0xa3, 0x5f,
-	0x89, 0x5e, 0xf2, 0x32, 0xdf, 0xa2, 0xc1, 0x7d, 0x45, 0xed, 0x9a, 0x88, 0x0b, 0x53, 0x24, 0x67,
-	0x51, 0x53, 0xc5, 0x45, 0xa6, 0xeb, 0x2c, 0x87, 0x2b, 0xb4, 0x03, 0xe3, 0x61, 0x07, 0xb1, 0x64,
-	0x2b, 0x32, 0xdf, 0x67, 0x29, 0xf7, 0x54, 0xf9, 0x93, 0xf9, 0xbb, 0xdb, 0x11, 0x75, 0x70, 0xca,
-	0x85, 0xd8, 0x57, 0xb9, 0x97, 0xa9, 0xa8, 0x97, 0x2c, 0xe5, 0x8a, 0xdc, 0x23, 0xfb, 0xd5, 0xa4,
-	0x76, 0x59, 0x6e, 0x87, 0x16, 0x41, 0xdf, 0xdc, 0xc0, 0x69, 0x9a, 0x53, 0x83, 0x15, 0x49, 0xfd,
-	0x83, 0xd0, 0x8d, 0xb7, 0xf7, 0x36, 0xb1, 0x92, 0xb6, 0xe4, 0x2d, 0xb8, 0xc2, 0xd8, 0x48, 0xe1,
-	0x0b, 0x31, 0x0b, 0x88, 0xc5, 0x9f, 0xce, 0x5e, 0x0e, 0xf4, 0x03, 0x49, 0xa1, 0xf5, 0xc8, 0x70,
-	0x49, 0x5d, 0xc2, 0x8a, 0xeb, 0x64, 0xf9, 0x45, 0x90, 0x28, 0xbf, 0x60, 0x05, 0xf3, 0x2b, 0x38,
-	0x16, 0x8d, 0x3d, 0xbc, 0x63, 0x23, 0xaf, 0x0a, 0xca, 0xd4, 0xda, 0xf7, 0x78, 0xbe, 0x62, 0x5b,
-	0x94, 0x3b, 0x98, 0xcd, 0x69, 0xff, 0x37, 0x11, 0xb5, 0x9a, 0x60, 0x10, 0xe5, 0x45, 0xc2, 0xb6,
-	0x09, 0x5a, 0xfe, 0x65, 0x98, 0x1a, 0x0c, 0xa9, 0xa0, 0x73, 0x9b, 0xad, 0x79, 0xeb, 0x02, 0x15,
-	0x31, 0x5b, 0x36, 0x78, 0xdf, 0x23, 0xae, 0xde, 0xf7, 0xb0, 0x3f, 0x5a, 0xb7, 0xa0, 0x06, 0x76,
-	0xda, 0xad, 0x1f, 0xee, 0x93, 0x9d, 0x76, 0x2a, 0x1c, 0x1d, 0xcb, 0xb8, 0xfd, 0xbd, 0x75, 0x50,
-	0x70, 0x49, 0xc9, 0x90, 0x07, 0x8f, 0xdb, 0x87, 0xfd, 0x66, 0x9d, 0x96, 0xe0, 0xba, 0xe0, 0x56,
-	0x3e, 0x68, 0x7f, 0x3d, 0x10, 0x57, 0xe1, 0xe2, 0xf6, 0xd5, 0xe0, 0xd5, 0x23, 0xfe, 0x04, 0x10,
-	0xf8, 0xdb, 0x06, 0xfa, 0xbf, 0x4a, 0xb0, 0x7c, 0xcb, 0xf1, 0xf5, 0x1b, 0x47, 0x0f, 0x58, 0xc1,
-	0x9d, 0x0f, 0xe4, 0x0d, 0x79, 0xbd, 0x49, 0xf0, 0x76, 0x50, 0x4d, 0x03, 0xbc, 0xd7, 0xe8, 0xa8,
-	0x3b, 0xf5, 0x79, 0x77, 0x5e, 0x51, 0x3c, 0x5a, 0x07, 0x69, 0xe3, 0xd1, 0x04, 0xd0, 0x97, 0x58,
-	0x55, 0xa5, 0xe3, 0x26, 0xa0, 0x2f, 0x4a, 0x5d, 0xfc, 0x5c, 0x09, 0x58, 0x52, 0x11, 0x6f, 0xdc,
-	0x8b, 0x63, 0x9a, 0xee, 0xf4, 0x58, 0xc5, 0xd9, 0xef, 0xac, 0x17, 0x0d, 0xcf, 0x96, 0x37, 0x09,
-	0xc9, 0x0b, 0xf3, 0x20, 0xb1, 0x28, 0x1f, 0xb1, 0x9c, 0x96, 0xdb, 0xba, 0xcf, 0xb2, 0xe2, 0x15,
-	0xb7, 0xd1, 0x6c, 0xb2, 0xba, 0x49, 0x10, 0x2a, 0x4f, 0xa2, 0xb8, 0xc6, 0x8b, 0x4d, 0x41, 0xdd,
-	0x54, 0xea, 0x32, 0xa6, 0x6e, 0x87, 0xf2, 0x29, 0xcb, 0x1b, 0x42, 0x04, 0xa3, 0x40, 0xf0, 0x3c,
-	0x0b, 0x8d, 0x02, 0xf8, 0x8a, 0x40, 0x78, 0x24, 0x41, 0x04, 0x3d, 0x9a, 0x60, 0x8c, 0x24, 0x08,
-	0x2b, 0x1b, 0xd2, 0xd2, 0xd3, 0x25, 0x3a, 0x6d, 0xdf, 0x60, 0x59, 0x25, 0x23, 0x1a, 0x4f, 0xa3,
-	0xf3, 0xfc, 0x73, 0x7a, 0xd9, 0x05, 0xfe, 0x7d, 0x17, 0x14, 0xfd, 0x2f, 0x52, 0x6c, 0x2b, 0x78,
-	0x06, 0x49, 0xe8, 0xfa, 0x20, 0xf2, 0x7a, 0x13, 0x4e, 0x87, 0x5b, 0x66, 0xdf, 0x0c, 0xe3, 0x37,
-	0x3e, 0xdf, 0x64, 0xff, 0x62, 0x2a, 0xf4, 0x28, 0x53, 0x64, 0x65, 0x38, 0x55, 0x7d, 0x88, 0x7f,
-	0x7f, 0x27, 0x03, 0x96, 0x5a, 0xa8, 0x55, 0xaa, 0x03, 0x70, 0x39, 0x39, 0x6f, 0xd4, 0xea, 0xa5,
-	0xdf, 0xcd, 0x80, 0x5b, 0xb3, 0x8d, 0x24, 0xf0, 0xe5, 0x6a, 0x83, 0x6e, 0xbd, 0xc2, 0xab, 0x0f,
-	0x4b, 0xff, 0x3a, 0x03, 0x25, 0xa4, 0xf7, 0x81, 0x07, 0xc6, 0xe6, 0x7f, 0x43, 0x89, 0x6e, 0xbd,
-	0x07, 0x4e, 0xe1, 0xbf, 0xcd, 0x58, 0x39, 0x96, 0xc4, 0xf7, 0x97, 0x4a, 0xff, 0x4e, 0xd0, 0x81,
-	0x7c, 0x00, 0xf4, 0xdf, 0x53, 0x89, 0x3e, 0x24, 0xfe, 0x7d, 0xc6, 0x2a, 0xb0, 0x0c, 0x24, 0x3a,
-	0xd5, 0x4a, 0xa7, 0xf4, 0x1f, 0xc4, 0x27, 0x0e, 0x1b, 0xad, 0xfe, 0xd7, 0x83, 0x46, 0xb3, 0xd9,
-	0xef, 0xe1, 0xb3, 0x4e, 0xa5, 0xdf, 0xcf, 0x58, 0x57, 0x58, 0xa9, 0x55, 0xef, 0x0d, 0x1e, 0x34,
-	0x5a, 0xf8, 0x61, 0xfe, 0xb8, 0x51, 0xad, 0x97, 0xfe, 0x63, 0x06, 0x6c, 0xb7, 0x28, 0xc8, 0xbc,
-	0x5d, 0xa9, 0x55, 0xc1, 0x37, 0x2f, 0xfd, 0xa7, 0x0c, 0xcc, 0x64, 0x39, 0xa4, 0x55, 0x6a, 0x10,
-	0x50, 0x94, 0xfe, 0xb3, 0x28, 0x1e, 0xd3, 0xbc, 0xf2, 0xa4, 0xf4, 0x5f, 0x32, 0x56, 0x91, 0x65,
-	0x1b, 0x9d, 0xea, 0xe0, 0x10, 0xba, 0x60, 0xe9, 0xbf, 0x0a, 0x30, 0x26, 0x49, 0xfa, 0x3f, 0xc8,
-	0x58, 0xdb, 0x8c, 0x75, 0xbf, 0xe9, 0x0e, 0x9a, 0xed, 0x5a, 0x1f, 0x3e, 0xfc, 0xdf, 0x04, 0x00,
-	0x09, 0xc0, 0xdd, 0x68, 0x97, 0xfe, 0xbb, 0x06, 0x54, 0x1f, 0xf2, 0x76, 0xbb, 0x57, 0xfa, 0x43,
-	0x4d, 0xe8, 0x40, 0x98, 0x03, 0x32, 0xfd, 0x91, 0xe6, 0xe8, 0x54, 0xaa, 0xd5, 0x5e, 0xe9, 0x7f,
-	0xe8, 0x34, 0xc9, 0xf3, 0x3f, 0x85, 0x04, 0x98, 0x7e, 0x80, 0xfc, 0xff, 0x4b, 0x27, 0x5b, 0x58,
-	0xa3, 0x3f, 0x16, 0x8d, 0x2e, 0xbe, 0x27, 0xa3, 0x85, 0xd2, 0x9f, 0xcd, 0x2a, 0x04, 0xc6, 0x4f,
-	0xa5, 0x9f, 0xcf, 0x42, 0x74, 0xb3, 0x25, 0x92, 0xbd, 0x6f, 0x30, 0x70, 0xdb, 0x6f, 0x1c, 0x94,
-	0x7e, 0x21, 0x8b, 0x7a, 0x6b, 0x82, 0x0e, 0x6b, 0xa5, 0x5f, 0x14, 0xff, 0x0f, 0xc1, 0xd9, 0xae,
-	0x97, 0x7e, 0x9c, 0x85, 0xa1, 0x25, 0x5f, 0xe9, 0xd7, 0x1a, 0xbd, 0xc1, 0x13, 0xde, 0xe8, 0xd5,
-	0x4b, 0xbf, 0x94, 0xc5, 0x26, 0x23, 0x0a, 0x46, 0x7d, 0xbc, 0x7d, 0x58, 0xfa, 0x73, 0x59, 0xa9,
-	0x81, 0x7d, 0xd4, 0xc0, 0x9f, 0xcf, 0xa2, 0x08, 0x4d, 0x53, 0xef, 0x7f, 0x21, 0x8b, 0x75, 0x40,
-	0x12, 0xd5, 0xe1, 0x2f, 0x66, 0x85, 0xfe, 0xbe, 0xe9, 0x1e, 0xb6, 0x0f, 0x4a, 0xbf, 0x9c, 0xc5,
-	0x16, 0x78, 0x52, 0x79, 0x04, 0xf1, 0xf4, 0x61, 0x85, 0x37, 0x4b, 0x7f, 0x49, 0x7c, 0xe2, 0x01,
-	0x36, 0xf0, 0xa0, 0xdb, 0xef, 0x76, 0xea, 0xad, 0x5a, 0xe9, 0x57, 0x04, 0x88, 0x3e, 0x8b, 0xb6,
-	0x53, 0xfa, 0xd5, 0xac, 0xdd, 0x62, 0xb9, 0x43, 0x77, 0xba, 0x7c, 0x29, 0x6c, 0xbb, 0xc2, 0xb6,
-	0xb5, 0x89, 0xbe, 0x52, 0xc7, 0x08, 0x23, 0x5b, 0x89, 0x61, 0xf3, 0xe6, 0x5b, 0xa3, 0x50, 0xda,
-	0xfe, 0xed, 0x04, 0xb8, 0x8d, 0xc2, 0x1b, 0x14, 0x25, 0xde, 0x63, 0x19, 0x2f, 0xe4, 0x37, 0x9a,
-	0xb7, 0xeb, 0x35, 0x4c, 0xfe, 0xe5, 0x0a, 0x5b, 0xfe, 0xe3, 0x38, 0x84, 0xfd, 0xe4, 0xd3, 0x7e,
-	0x1e, 0x9a, 0x3a, 0x6e, 0x9e, 0xc1, 0x1e, 0x99, 0x32, 0x4e, 0x86, 0xde, 0x58, 0x5e, 0x35, 0x16,
-	0xff, 0x91, 0x86, 0xc7, 0xee, 0xa5, 0x2f, 0x29, 0xfe, 0xdb, 0xbf, 0x1e, 0xdf, 0xf0, 0x74, 0x0b,
-	0x3f, 0x6c, 0x82, 0xf1, 0xe2, 0xe3, 0x12, 0x45, 0xf0, 0xb0, 0x31, 0x51, 0x6d, 0x73, 0xdc, 0x89,
-	0x29, 0xb0, 0x2c, 0x25, 0x3b, 0x7d, 0x88, 0x71, 0x55, 0x26, 0xc4, 0xeb, 0x15, 0x88, 0x6e, 0xb7,
-	0xb0, 0xf2, 0x90, 0xdc, 0xef, 0x42, 0xc4, 0x05, 0xd1, 0xac, 0x4a, 0xa3, 0x1a, 0x30, 0x84, 0x85,
-	0x29, 0x46, 0xa4, 0x9b, 0xf5, 0xa6, 0x30, 0x7d, 0x34, 0xb4, 0x22, 0x51, 0xba, 0x07, 0x3f, 0xec,
-	0xd7, 0xfb, 0xf5, 0x52, 0x56, 0x97, 0x29, 0x6c, 0x31, 0x07, 0x2a, 0xcb, 0x53, 0xb2, 0xbd, 0xdf,
-	0x80, 0xce, 0xc0, 0x74, 0xa1, 0xad, 0x0e, 0x6f, 0x57, 0x21, 0x0a, 0x55, 0x12, 0xf1, 0x6e, 0x17,
-	0xe2, 0x4e, 0x05, 0xe7, 0xbd, 0x0e, 0x87, 0xbe, 0x52, 0x34, 0x08, 0xc2, 0x74, 0xb7, 0x44, 0x5c,
-	0x8e, 0x84, 0x6e, 0xe3, 0x00, 0xcd, 0x02, 0x9f, 0xf0, 0xdb, 0xd6, 0x85, 0x42, 0x00, 0x0b, 0x72,
-	0x95, 0xec, 0x1f, 0xc7, 0x58, 0x06, 0x6c, 0x5b, 0x28, 0xf1, 0x07, 0x2c, 0xff, 0xc2, 0x9d, 0x8e,
-	0x67, 0x2f, 0x06, 0x0b, 0xf7, 0xcf, 0xa8, 0xf7, 0x00, 0x0c, 0x97, 0x48, 0xe2, 0xf6, 0x9e, 0x08,
-	0x50, 0x17, 0x30, 0x9c, 0xbd, 0xd0, 0xff, 0xcb, 0xf7, 0xc1, 0x30, 0x75, 0x8a, 0x2e, 0xa1, 0xbf,
-	0x58, 0xa8, 0xc7, 0xb6, 0xf0, 0x3f, 0x2e, 0x79, 0x8d, 0xd0, 0x11, 0x98, 0x2e, 0xe4, 0x10, 0xae,
-	0x92, 0xf6, 0xdf, 0xc9, 0xb2, 0x62, 0x68, 0x45, 0xd3, 0x70, 0xcc, 0xe2, 0x61, 0xc7, 0x2c, 0x04,
-	0x33, 0x6d, 0xe1, 0xe3, 0x60, 0xa7, 0x8e, 0x4e, 0x30, 0xac, 0x3e, 0x42, 0xa0, 0x00, 0x91, 0x83,
-	0x02, 0xe1, 0x07, 0x74, 0xee, 0x47, 0xa2, 0x00, 0x7b, 0xd3, 0xb7, 0xc9, 0xe7, 0xa2, 0xa7, 0x1b,
-	0x64, 0x44, 0xf0, 0x05, 0x4b, 0x09, 0xb0, 0xf4, 0x44, 0xdf, 0xd9, 0xc4, 0xda, 0x44, 0x32, 0x6d,
-	0x1b, 0x08, 0x04, 0x7c, 0x14, 0x0f, 0xb1, 0xd3, 0x62, 0xbf, 0xd8, 0xd6, 0xcd, 0x44, 0x1f, 0x5a,
-	0x31, 0x26, 0x5c, 0x71, 0x6c, 0x5d, 0x25, 0x30, 0x0c, 0x63, 0x13, 0xec, 0xdb, 0xd4, 0x93, 0xb3,
-	0xd1, 0x27, 0x36, 0x74, 0xbf, 0xe7, 0xb9, 0x89, 0x1e, 0x02, 0xee, 0xb1, 0x3c, 0x75, 0x42, 0x62,
-	0xca, 0x45, 0x7d, 0xbe, 0xa0, 0xd7, 0x71, 0x46, 0x40, 0xc1, 0xf6, 0x09, 0xcb, 0xfa, 0x6a, 0xc8,
-	0x60, 0xd1, 0xb5, 0x54, 0x69, 0x1f, 0x3c, 0xe3, 0xd3, 0x20, 0x51, 0xfe, 0xf5, 0x24, 0x63, 0x41,
-	0x23, 0x61, 0x94, 0x4e, 0xee, 0xbc, 0x7c, 0xf4, 0x85, 0xbc, 0xf7, 0x9f, 0x63, 0x19, 0x59, 0x1b,
-	0xf9, 0xce, 0xe5, 0xc7, 0xe7, 0xb7, 0xb7, 0x6a, 0x8c, 0xfb, 0xc9, 0x87, 0xed, 0x6e, 0x8f, 0xab,
-	0x02, 0xac, 0x6e, 0xd4, 0x0d, 0xa2, 0xe3, 0xbb, 0x7b, 0x17, 0x28, 0x71, 0xa3, 0x4f, 0x84, 0x8f,
-	0x4b, 0x42, 0x28, 0xfd, 0xdc, 0x9d, 0x38, 0xc7, 0x3a, 0xd2, 0x53, 0x8f, 0x4b, 0x06, 0x19, 0x78,
-	0x18, 0x44, 0xaf, 0x1c, 0xac, 0x79, 0xba, 0x28, 0x58, 0x60, 0x30, 0x60, 0x78, 0x04, 0xe9, 0x68,
-	0xe6, 0xe1, 0xf3, 0x55, 0xcb, 0xc9, 0x64, 0x40, 0xad, 0x23, 0xde, 0x64, 0xe3, 0x5b, 0x82, 0xde,
-	0x01, 0x32, 0xc5, 0xbb, 0x1f, 0xb2, 0x22, 0x19, 0xef, 0x40, 0xba, 0xf6, 0x19, 0xfd, 0xbe, 0x56,
-	0x81, 0x32, 0x6a, 0x82, 0xfe, 0xff, 0xda, 0xb5, 0xfa, 0x0c, 0xe6, 0x7b, 0xd9, 0xfa, 0xf8, 0x52,
-	0x1e, 0xa8, 0x83, 0xdc, 0xf2, 0x07, 0x30, 0x99, 0x1d, 0xd4, 0xe9, 0xad, 0x9e, 0x56, 0xbb, 0x85,
-	0xbb, 0xd9, 0xf0, 0xaf, 0x0f, 0xde, 0x44, 0x29, 0x59, 0xbe, 0x0b, 0x13, 0x9c, 0xea, 0x01, 0x41,
-	0x94, 0x17, 0x3b, 0x2b, 0xca, 0xb3, 0xdf, 0x0a, 0x1e, 0x07, 0x92, 0xa1, 0x29, 0xbd, 0x61, 0x01,
-	0x53, 0x77, 0x17, 0x5c, 0xb8, 0x7f, 0x19, 0x63, 0xdb, 0x91, 0x95, 0xee, 0x35, 0xe7, 0xcf, 0x63,
-	0x17, 0x3c, 0x7f, 0xbe, 0xd2, 0x1d, 0x63, 0x17, 0xef, 0x8e, 0xd0, 0xb5, 0x46, 0xc2, 0x81, 0xa7,
-	0x6e, 0xb2, 0x1a, 0x84, 0x1f, 0xeb, 0x63, 0x12, 0x6c, 0xa4, 0xff, 0xe3, 0xe9, 0x37, 0xbd, 0xf1,
-	0x30, 0x97, 0x4f, 0xa4, 0x16, 0x83, 0x13, 0x40, 0x1d, 0x77, 0x6c, 0x1f, 0x81, 0x27, 0x19, 0x30,
-	0x7c, 0x2e, 0x3a, 0xce, 0x60, 0x34, 0x51, 0xf7, 0x26, 0xaf, 0xaf, 0xfb, 0x06, 0x0a, 0x5a, 0xc5,
-	0x48, 0x61, 0x2a, 0x7e, 0xcb, 0x36, 0x4b, 0x13, 0x45, 0x8c, 0xc6, 0x13, 0xbc, 0xc8, 0x4b, 0x07,
-	0xc4, 0x70, 0x34, 0xa6, 0xa4, 0x7d, 0x07, 0x8f, 0x9a, 0x88, 0xb8, 0xe2, 0x43, 0x1d, 0x81, 0x50,
-	0x03, 0x6c, 0x47, 0x22, 0x10, 0x7d, 0xb0, 0xfd, 0x53, 0xf0, 0x82, 0xf0, 0xdf, 0xd9, 0x8b, 0x73,
-	0xc1, 0x83, 0x82, 0xf6, 0xdf, 0x8a, 0xb1, 0xa4, 0x30, 0x2e, 0x08, 0x7e, 0xa6, 0xcb, 0xd3, 0xa7,
-	0xf2, 0xe9, 0xd8, 0x22, 0x97, 0x29, 0x23, 0x58, 0x35, 0x5f, 0x97, 0xdb, 0x68, 0x88, 0x10, 0x9c,
-	0xb1, 0xe7, 0xee, 0xc2, 0x95, 0x27, 0x0a, 0x92, 0x62, 0x28, 0xb1, 0x37, 0x6c, 0xb3, 0xed, 0x3d,
-	0xd6, 0x48, 0x6e, 0x70, 0x19, 0x01, 0x57, 0xea, 0x9c, 0x83, 0xfc, 0xdf, 0x61, 0x29, 0xba, 0xa1,
-	0xfb, 0x1e, 0x4b, 0xcd, 0xc5, 0xcd, 0x5d, 0x6a, 0xa0, 0x2d, 0xa3, 0xc7, 0x03, 0x99, 0x53, 0xa6,
-	0xfd, 0xf7, 0xe2, 0x10, 0x87, 0x9a, 0x12, 0x44, 0xc4, 0xa5, 0x59, 0xee, 0x75, 0xc5, 0x5d, 0xd7,
-	0x44, 0x37, 0xc3, 0x6f, 0x42, 0x52, 0x2b, 0x45, 0x1e, 0x7f, 0xcc, 0x8a, 0xeb, 0x1d, 0x78, 0xa5,
-	0x40, 0xce, 0x7d, 0x2a, 0x6d, 0x3e, 0x1e, 0x97, 0x0a, 0x3f, 0x1e, 0xf7, 0xbe, 0xaa, 0x67, 0x3a,
-	0xda, 0x4b, 0x45, 0x3b, 0xc8, 0x8a, 0x1a, 0x2d, 0x98, 0x39, 0xa7, 0x05, 0xbf, 0xcb, 0x58, 0x50,
-	0x2d, 0x74, 0x86, 0xf4, 0x06, 0x81, 0x7c, 0x81, 0xf3, 0xb0, 0x2f, 0xf6, 0x90, 0xc4, 0x23, 0xc4,
-	0xf5, 0xaf, 0xe1, 0x6f, 0xab, 0x72, 0x28, 0xc2, 0x7c, 0xf6, 0xc4, 0x71, 0x8f, 0x4f, 0x7c, 0xf5,
-	0x7e, 0xe8, 0x0b, 0x91, 0x92, 0x57, 0x27, 0x64, 0x8a, 0xdc, 0x8f, 0x89, 0x6e, 0x1a, 0xfc, 0x6f,
-	0xff, 0xd3, 0x18, 0xcb, 0x3f, 0xa6, 0xea, 0x08, 0x5e, 0xa3, 0xb2, 0x64, 0xae, 0xba, 0xb2, 0xe2,
-	0x82, 0x80, 0x3b, 0x19, 0x0f, 0xc6, 0xb4, 0xf5, 0x29, 0xa2, 0x57, 0x41, 0xa9, 0xe1, 0x4e, 0xa7,
-	0xce, 0x16, 0x5b, 0x65, 0x74, 0x67, 0x96, 0xb2, 0x71, 0x43, 0x2c, 0xc8, 0x16, 0xfb, 0xb0, 0x49,
-	0x83, 0x1b, 0x2f, 0xe1, 0x5a, 0x6f, 0xb0, 0xcc, 0x31, 0xcc, 0xbb, 0x8b, 0x93, 0xa1, 0x6c, 0xe3,
-	0x34, 0x24, 0xbb, 0x27, 0x43, 0xe4, 0xc3, 0x0c, 0x3a, 0xc6, 0x2a, 0x17, 0x08, 0x72, 0x40, 0x79,
-	0x20, 0x08, 0x8a, 0xcf, 0x1f, 0x1e, 0xcb, 0x57, 0x8a, 0x91, 0xaf, 0x37, 0x3c, 0xb6, 0xc1, 0x55,
-	0xda, 0x9f, 0x0c, 0x8f, 0xcf, 0x5b, 0x4e, 0x37, 0x3a, 0xdf, 0x6f, 0x42, 0xe7, 0xe3, 0xb3, 0x0d,
-	0x2b, 0xf0, 0x41, 0x93, 0xc6, 0x43, 0x4d, 0x7a, 0x8f, 0x31, 0x7d, 0xcc, 0x40, 0xcd, 0xac, 0x1b,
-	0xce, 0x23, 0x18, 0xc0, 0xd7, 0x3f, 0xff, 0x62, 0xdf, 0x65, 0xe9, 0xa6, 0xe3, 0x7b, 0xee, 0xe8,
-	0xfc, 0x1a, 0xa9, 0xb7, 0xed, 0xec, 0xbf, 0x1e, 0x63, 0x59, 0xbc, 0x81, 0xaa, 0x1f, 0x7a, 0x0d,
-	0x56, 0x1c, 0xe9, 0xe5, 0x25, 0x60, 0x9b, 0x82, 0xfb, 0x43, 0x4e, 0x46, 0x8a, 0x53, 0x42, 0xc4,
-	0x0a, 0xe8, 0xeb, 0xaa, 0x58, 0x01, 0x3d, 0xd7, 0x5b, 0xe0, 0xc3, 0x09, 0xc5, 0x26, 0x37, 0xee,
-	0x81, 0x12, 0x00, 0xb9, 0xc5, 0x72, 0xa8, 0x78, 0xf9, 0x57, 0xae, 0x7b, 0xc2, 0xf8, 0xb7, 0x94,
-	0xaf, 0x11, 0xc2, 0xf8, 0x87, 0x1b, 0xbd, 0x40, 0x39, 0x96, 0x3b, 0xc9, 0x40, 0x81, 0xbf, 0x1f,
-	0xff, 0x29, 0x88, 0xe7, 0x68, 0x86, 0xba, 0xca, 0xac, 0x1a, 0x6f, 0x40, 0xe8, 0x07, 0x6e, 0xbf,
-	0x70, 0xca, 0x39, 0xed, 0xc9, 0x59, 0x6c, 0x4b, 0xd2, 0x79, 0xbf, 0x25, 0x5f, 0xdf, 0x0e, 0x68,
-	0x95, 0x07, 0x6d, 0x81, 0x4b, 0x18, 0xb4, 0x6e, 0xaf, 0xdd, 0xe9, 0x00, 0x2d, 0xf9, 0xf1, 0xaf,
-	0xc4, 0x59, 0x4e, 0x6f, 0xce, 0x63, 0x28, 0x22, 0x36, 0x81, 0xa0, 0xec, 0x03, 0x2c, 0x27, 0x8d,
-	0xa1, 0x88, 0xa2, 0xf0, 0x1e, 0x92, 0x7e, 0x4a, 0x83, 0xd4, 0xc7, 0x62, 0x9a, 0x22, 0xdf, 0x73,
-	0x2e, 0x65, 0x35, 0xdb, 0x7e, 0xa3, 0xd5, 0xe8, 0x3e, 0x14, 0xfb, 0x82, 0x10, 0x72, 0x10, 0x89,
-	0x36, 0x30, 0x13, 0x9a, 0x80, 0x5c, 0x28, 0x0b, 0x86, 0x1b, 0x82, 0x40, 0xdb, 0x82, 0x18, 0x7e,
-	0xe7, 0x44, 0xfa, 0x10, 0xfd, 0x84, 0x94, 0xfe, 0x4a, 0x8d, 0x93, 0xf0, 0x39, 0x7c, 0x1c, 0x5a,
-	0xee, 0x59, 0x41, 0xe4, 0x5a, 0x7d, 0x28, 0x16, 0x24, 0x98, 0x66, 0x3b, 0x40, 0x47, 0x22, 0x8f,
-	0x3b, 0x80, 0x3a, 0x39, 0x78, 0xf0, 0xcd, 0xa0, 0xdd, 0xa9, 0xf3, 0x0a, 0x6e, 0x24, 0x17, 0x74,
-	0x89, 0x7a, 0x1b, 0xee, 0xc1, 0x5b, 0x6c, 0x67, 0xe6, 0x1d, 0xef, 0xe1, 0x0e, 0xc2, 0x89, 0xa3,
-	0x75, 0xf9, 0x20, 0x4d, 0x8f, 0x18, 0xfd, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x30, 0x6b,
-	0x59, 0xaf, 0x60, 0x00, 0x00,
+	// 8700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0xd9, 0x8f, 0x1b, 0x59,
+	0x77, 0xdf, 0xc7, 0x9d, 0x3c, 0x24, 0xbb, 0x4b, 0xa5, 0x8d, 0xa2, 0x96, 0xe9, 0xa9, 0x91, 0x66,
+	0x7a, 0xf4, 0xcd, 0xf4, 0x48, 0x9a, 0xd1, 0x2c, 0xd2, 0xf7, 0x39, 0xa6, 0xc8, 0xea, 0x16, 0xad,
+	0xe6, 0xf2, 0x5d, 0x92, 0xd2, 0x8c, 0x11, 0x80, 0x28, 0x15, 0xab, 0xbb, 0xcb, 0x22, 0xab, 0x38,
+	0x55, 0x45, 0x2d, 0x7e, 0x8a, 0xe3, 0xcf, 0x59, 0xbe, 0xd8, 0x79, 0xf8, 0x12, 0x18, 0x06, 0xfc,
+	0x9c, 0x97, 0x18, 0xc8, 0x3f, 0x90, 0x20, 0x79, 0x32, 0xb2, 0xbe, 0xe4, 0x21, 0x7e, 0x0a, 0x12,
+	0x04, 0x08, 0x02, 0x3b, 0x8b, 0x13, 0x67, 0x73, 0x62, 0x27, 0x71, 0x70, 0xee, 0x56, 0xb7, 0xd8,
+	0x64, 0x77, 0x6b, 0x12, 0x04, 0xc8, 0x13, 0x79, 0xcf, 0xf9, 0x9d, 0x5b, 0xe7, 0x9e, 0x7b, 0xee,
+	0xbd, 0xe7, 0xae, 0x50, 0x9e, 0x39, 0xa1, 0x1f, 0xee, 0xcc, 0x03, 0x3f, 0xf2, 0xf5, 0x22, 0x4b,
+	0xbc, 0xbc, 0x6b, 0xbc, 0x07, 0xe5, 0xdd, 0xc0, 0x9a, 0x39, 0xaf, 0xfc, 0xe0, 0x45, 0xbb, 0xa5,
+	0x5f, 0x80, 0xdc, 0x4b, 0x6b, 0xba, 0x70, 0x6a, 0xa9, 0xad, 0xf4, 0x76, 0x89, 0xb0, 0x84, 0xf1,
+	0x0e, 0x14, 0x7a, 0x07, 0x07, 0x4e, 0x70, 0x12, 0xa0, 0x71, 0xe8, 0x78, 0xd1, 0x5a, 0xc0, 0x0d,
+	0xc8, 0x0f, 0xad, 0x70, 0xfd, 0x17, 0x0c, 0x00, 0xf3, 0xb5, 0x63, 0x2f, 0x22, 0x7f, 0xfd, 0x47,
+	0x08, 0x94, 0x9b, 0xbe, 0x17, 0x59, 0xae, 0xb7, 0x5e, 0x13, 0xfd, 0x63, 0xc8, 0xcf, 0xad, 0xc0,
+	0xf1, 0xa2, 0x5a, 0x7a, 0x2b, 0xb5, 0x5d, 0xbe, 0x77, 0x71, 0x47, 0x14, 0x75, 0x47, 0x11, 0x26,
+	0x1c, 0x64, 0x7c, 0x04, 0xc5, 0xa1, 0x3b, 0x73, 0xda, 0xde, 0x81, 0xaf, 0x6f, 0x41, 0xd9, 0xb3,
+	0x3c, 0x3f, 0x74, 0x6c, 0xdf, 0x9b, 0x84, 0x34, 0xdb, 0x0c, 0x51, 0x49, 0xc6, 0x1d, 0xa8, 0xb4,
+	0x16, 0x81, 0x15, 0xb9, 0xbe, 0x77, 0x46, 0x89, 0x36, 0x14, 0x1a, 0x93, 0x49, 0xe0, 0x84, 0xa1,
+	0x5e, 0x87, 0xe2, 0x91, 0x1f, 0x46, 0x9e, 0x35, 0x43, 0x95, 0x53, 0xdb, 0x25, 0x22, 0xd3, 0xfa,
+	0x06, 0xa4, 0xdd, 0x39, 0xd5, 0xb8, 0x44, 0xd2, 0xee, 0x5c, 0xd7, 0x21, 0x3b, 0xf7, 0x83, 0xa8,
+	0x96, 0xd9, 0x4a, 0x6f, 0xe7, 0x08, 0xfd, 0x6f, 0xfc, 0xb5, 0x14, 0x64, 0x46, 0x64, 0x5f, 0xbf,
+	0x04, 0xf9, 0xd0, 0x3e, 0x72, 0x66, 0xa2, 0xe0, 0x3c, 0xa5, 0x7f, 0x1f, 0x0a, 0x16, 0xfb, 0x54,
+	0x2d, 0xbd, 0x95, 0xde, 0x2e, 0xdf, 0x3b, 0x17, 0x17, 0x9d, 0xeb, 0x40, 0x04, 0x82, 0x7e, 0xc0,
+	0x8a, 0x8e, 0x6a, 0x19, 0xfa, 0x49, 0xfa, 0x5f, 0xff, 0x10, 0x72, 0xdf, 0x2e, 0x9c, 0xe0, 0x4d,
+	0x2d, 0xbb, 0x95, 0xd9, 0x2e, 0xdf, 0x3b, 0x1f, 0x8b, 0xf7, 0x2d, 0x74, 0x91, 0xc8, 0x09, 0x08,
+	0x43, 0x60, 0x59, 0x0e, 0x02, 0xeb, 0x70, 0x86, 0x76, 0xce, 0xb1, 0xb2, 0x88, 0xb4, 0xe1, 0xc1,
+	0xc6, 0xc8, 0xb3, 0x5e, 0x5a, 0xee, 0xd4, 0x7a, 0xee, 0x4e, 0xdd, 0xe8, 0x8d, 0xbe, 0x0d, 0xb9,
+	0x30, 0xb2, 0x82, 0x88, 0x2a, 0x5c, 0xbe, 0xa7, 0xc7, 0x19, 0x0b, 0xdb, 0x13, 0x06, 0xd0, 0xef,
+	0x41, 0x71, 0xc2, 0x0d, 0xcc, 0xeb, 0xef, 0x52, 0x0c, 0x56, 0x4d, 0x4f, 0x24, 0xce, 0xf8, 0x02,
+	0x4a, 0x1d, 0xcb, 0x3e, 0x72, 0x3d, 0xa7, 0xdd, 0x7a, 0x1b, 0x23, 0x1b, 0xff, 0x28, 0x05, 0x65,
+	0x21, 0x89, 0xb5, 0xf9, 0x1e, 0xa4, 0xdd, 0x09, 0xd7, 0x51, 0x29, 0xbc, 0xcc, 0x9c, 0xa4, 0xdd,
+	0x89, 0xbe, 0x03, 0xd9, 0x99, 0x3f, 0x71, 0x68, 0x36, 0x1b, 0xf7, 0xea, 0xc7, 0x61, 0xde, 0x81,
+	0xbf, 0xd3, 0xf1, 0x27, 0x0e, 0xa1, 0x38, 0xfd, 0x67, 0x61, 0x63, 0x91, 0xb0, 0x06, 0x35, 0x79,
+	0xf9, 0x5e, 0x2d, 0x96, 0x4c, 0x5a, 0x8b, 0x2c, 0xe1, 0x8d, 0xf7, 0x21, 0x8b, 0xf9, 0xe9, 0x79,
+	0x48, 0x8f, 0xfa, 0x5a, 0x4a, 0xaf, 0x40, 0xb1, 0x45, 0x1a, 0xed, 0x6e, 0xbb, 0xbb, 0xa7, 0xa5,
+	0xf5, 0x22, 0x64, 0x5b, 0xbd, 0x67, 0x5d, 0x2d, 0x63, 0xfc, 0x24, 0x07, 0xd5, 0xb8, 0x29, 0x63,
+	0x81, 0x74, 0xc8, 0x2e, 0x42, 0x27, 0xe0, 0x7e, 0x42, 0xff, 0x23, 0x8d, 0x1a, 0x27, 0xcd, 0x68,
+	0xf8, 0x5f, 0xbf, 0x45, 0x0b, 0x9e, 0x59, 0x6e, 0x2f, 0x4a, 0xbf, 0x40, 0x8b, 0xfe, 0x11, 0x68,
+	0x07, 0x96, 0x3b, 0xf5, 0x5f, 0x3a, 0xc1, 0x38, 0x72, 0x67, 0x8e, 0xbf, 0x88, 0x6a, 0xd9, 0xad,
+	0xd4, 0x76, 0xea, 0x41, 0xea, 0x0e, 0xd9, 0x14, 0xac, 0x21, 0xe3, 0xe8, 0xb7, 0x00, 0xec, 0x23,
+	0xc7, 0x7e, 0x31, 0xf7, 0x5d, 0xee, 0x24, 0xc5, 0x07, 0xb9, 0x03, 0x6b, 0x1a, 0x3a, 0x44, 0x61,
+	0xe8, 0x17, 0x21, 0x1b, 0xf8, 0x53, 0xa7, 0x96, 0xc7, 0x6a, 0x79, 0x90, 0xba, 0x4d, 0x68, 0x32,
+	0x51, 0x8f, 0x85, 0xa5, 0x7a, 0xbc, 0x06, 0xa5, 0x79, 0xe0, 0x7a, 0xb6, 0x3b, 0xb7, 0xa6, 0xb5,
+	0x22, 0x65, 0xc6, 0x04, 0xfd, 0x2a, 0x94, 0x5e, 0x39, 0xcf, 0x17, 0xee, 0x78, 0x11, 0x4c, 0x6b,
+	0x25, 0x26, 0x4a, 0x09, 0xa3, 0x60, 0xaa, 0xef, 0x42, 0xc5, 0xb6, 0xe6, 0xcc, 0xb2, 0xae, 0x13,
+	0xd6, 0x80, 0x7a, 0xba, 0xb1, 0xaa, 0xcc, 0x58, 0x8f, 0x4d, 0x81, 0x7d, 0x43, 0x12, 0x72, 0xfa,
+	0x36, 0xe4, 0xa7, 0xd6, 0x73, 0x67, 0x1a, 0xd6, 0xca, 0xd4, 0x6a, 0x5a, 0x9c, 0xc3, 0x3e, 0xa5,
+	0x13, 0xce, 0xc7, 0x5e, 0x0a, 0x0b, 0x14, 0xd6, 0x2a, 0x5b, 0x19, 0xec, 0xa5, 0x68, 0xa2, 0xfe,
+	0xcf, 0x52, 0x00, 0x71, 0xe6, 0xfa, 0x43, 0xc8, 0x46, 0x6f, 0xe6, 0xcc, 0x63, 0x37, 0xee, 0x7d,
+	0x70, 0xba, 0x3a, 0x3b, 0xc3, 0x37, 0x73, 0x87, 0x50, 0x21, 0xe3, 0xa7, 0x29, 0xc8, 0x62, 0x52,
+	0x2f, 0x43, 0x61, 0xd4, 0x7d, 0xd2, 0x45, 0x6f, 0xf8, 0x9e, 0x7e, 0x19, 0xce, 0x13, 0xf3, 0x69,
+	0xaf, 0xd9, 0x78, 0xb4, 0x6f, 0x8e, 0x89, 0x39, 0xe8, 0x8d, 0x48, 0xd3, 0x1c, 0x68, 0x29, 0xfd,
+	0x12, 0xe8, 0xc3, 0xc6, 0xe0, 0xc9, 0xf8, 0x49, 0x7b, 0x7f, 0xbf, 0xdd, 0xdd, 0x1b, 0x0f, 0x86,
+	0x8d, 0xa1, 0xa9, 0xa5, 0xf5, 0x73, 0x50, 0xdd, 0xeb, 0x8f, 0x14, 0x68, 0x46, 0xbf, 0x00, 0xda,
+	0xe0, 0x71, 0x83, 0x98, 0x2d, 0x85, 0x9a, 0xd5, 0xcf, 0xc3, 0x66, 0xbf, 0x41, 0x86, 0xed, 0x61,
+	0xbb, 0xd7, 0x1d, 0x37, 0x9e, 0x35, 0x88, 0xa9, 0xe5, 0xf4, 0x0d, 0x80, 0xce, 0x68, 0x7f, 0xd8,
+	0x1e, 0x93, 0xde, 0xbe, 0xa9, 0xe5, 0x8d, 0xbf, 0x9a, 0x83, 0xf2, 0x63, 0xc7, 0x9a, 0x46, 0x47,
+	0x4d, 0xac, 0x6b, 0xfd, 0x03, 0xa8, 0x4e, 0x9c, 0xa9, 0xf5, 0x66, 0x2c, 0xfa, 0xca, 0x34, 0x75,
+	0x9c, 0xf4, 0xdd, 0xfb, 0xa4, 0x42, 0x19, 0x03, 0x46, 0xd7, 0x3f, 0x06, 0xcd, 0xf5, 0x22, 0x27,
+	0x78, 0x69, 0x4d, 0x25, 0x36, 0xc3, 0xb1, 0x77, 0xc8, 0xa6, 0xe0, 0x09, 0xf8, 0xf7, 0x61, 0x93,
+	0xbb, 0xa2, 0x44, 0x33, 0x97, 0x4c, 0xdf, 0xbb, 0x43, 0x36, 0x38, 0x4b, 0x80, 0x3f, 0x83, 0x0b,
+	0xb6, 0xef, 0x85, 0x38, 0xcc, 0xb8, 0x2f, 0x9d, 0x31, 0x7a, 0xec, 0x22, 0x70, 0x42, 0xea, 0x9c,
+	0xd5, 0x07, 0xa9, 0x4f, 0xc9, 0x79, 0x85, 0xbd, 0xcb, 0xb9, 0x28, 0x75, 0x18, 0x58, 0xb6, 0x33,
+	0x9e, 0x3b, 0x81, 0xeb, 0x4f, 0xe4, 0x77, 0xf2, 0x52, 0x2b, 0x9d, 0xf2, 0xfb, 0x94, 0x2d, 0xbe,
+	0xb5, 0xc3, 0xab, 0xb4, 0xb8, 0xdc, 0x4f, 0x28, 0x56, 0x51, 0x6a, 0x51, 0xff, 0x04, 0x0a, 0xb6,
+	0x3f, 0x9b, 0x59, 0xde, 0xa4, 0x56, 0x58, 0x6e, 0x88, 0x4d, 0xc6, 0xa0, 0xfd, 0x9e, 0x40, 0xe9,
+	0x5f, 0x40, 0xf6, 0x28, 0x8a, 0xe6, 0xd4, 0x67, 0xca, 0xf7, 0xde, 0x5b, 0xfd, 0x81, 0xc7, 0xc3,
+	0x61, 0x9f, 0xfe, 0xa3, 0xb2, 0x54, 0x40, 0xff, 0x0c, 0x32, 0x91, 0x3d, 0xa7, 0x4d, 0x23, 0xe1,
+	0xfa, 0x09, 0xc5, 0x9a, 0x8a, 0x18, 0xc2, 0xeb, 0x2f, 0xa0, 0x9a, 0xc8, 0x4c, 0x19, 0x86, 0xd8,
+	0x18, 0xc2, 0x53, 0x72, 0xe8, 0xc2, 0x4e, 0xa7, 0xca, 0x86, 0x2e, 0x39, 0xda, 0xa4, 0x95, 0xd1,
+	0xa6, 0x0e, 0xc5, 0x30, 0xb2, 0xa2, 0x45, 0xe8, 0x84, 0x74, 0xc0, 0xa9, 0x12, 0x99, 0xae, 0x1b,
+	0x50, 0x51, 0x35, 0x58, 0x95, 0xa7, 0xf1, 0xe9, 0x2a, 0xaf, 0x2f, 0x43, 0xa1, 0xd9, 0xeb, 0x74,
+	0x1a, 0xdd, 0x96, 0x96, 0xc2, 0xae, 0x11, 0x55, 0xd6, 0xd2, 0x7a, 0x01, 0x32, 0xc3, 0x66, 0x5f,
+	0xcb, 0x18, 0x7b, 0x00, 0x4f, 0xdc, 0xe9, 0xb4, 0xef, 0x4f, 0x5d, 0xfb, 0x8d, 0xfe, 0x15, 0x54,
+	0xd4, 0x9a, 0xad, 0xa5, 0x4e, 0x1c, 0x71, 0xca, 0x4a, 0x2d, 0x1b, 0xbf, 0x9f, 0xc6, 0x60, 0x44,
+	0x56, 0x8b, 0xfe, 0x31, 0x64, 0x17, 0x81, 0x8b, 0x21, 0x00, 0x76, 0x28, 0x57, 0x56, 0xd6, 0xdd,
+	0xce, 0x88, 0xb4, 0x09, 0x85, 0xe9, 0x5f, 0x40, 0xd9, 0xf1, 0x5e, 0xba, 0x81, 0xef, 0xcd, 0x56,
+	0x86, 0x2a, 0x66, 0xcc, 0x24, 0x2a, 0x52, 0xaf, 0x43, 0x2e, 0x3c, 0x72, 0xa6, 0x53, 0xea, 0x7d,
+	0xc5, 0x07, 0xd9, 0x28, 0x58, 0x38, 0x84, 0x91, 0xe2, 0x80, 0x88, 0x55, 0x08, 0x4b, 0x60, 0x6f,
+	0x69, 0x05, 0x87, 0x0b, 0x94, 0x0e, 0x6b, 0x05, 0xda, 0x09, 0xc5, 0x04, 0x39, 0x44, 0xb0, 0x41,
+	0x9c, 0xfe, 0xaf, 0xff, 0x94, 0x06, 0x1a, 0xed, 0x35, 0x01, 0xd6, 0x0d, 0x00, 0x87, 0x46, 0x6a,
+	0xd6, 0xf3, 0x29, 0x1b, 0x06, 0x8b, 0x44, 0xa1, 0xe8, 0x37, 0xa0, 0xe0, 0xbc, 0x8e, 0x02, 0xcb,
+	0x8e, 0x6a, 0x19, 0x45, 0x47, 0x41, 0xc4, 0x5c, 0x6d, 0xcb, 0x3e, 0x72, 0x68, 0x3b, 0x2d, 0x12,
+	0x96, 0xd0, 0xdf, 0x81, 0xb2, 0xbf, 0x88, 0xe6, 0x8b, 0x68, 0x7c, 0xe0, 0x4e, 0x1d, 0xae, 0x0e,
+	0x30, 0xd2, 0xae, 0x3b, 0x75, 0x8c, 0xdf, 0xcb, 0x42, 0x45, 0x46, 0x88, 0x68, 0xf1, 0x4f, 0x78,
+	0x03, 0xdb, 0xa4, 0x0d, 0xec, 0xaa, 0x62, 0x3b, 0x05, 0xa5, 0xb6, 0xb0, 0xfb, 0x50, 0x76, 0x38,
+	0x6b, 0x2c, 0xc7, 0xf9, 0x0b, 0x2b, 0xe4, 0x5a, 0xa2, 0x3c, 0x7e, 0xd0, 0x9e, 0xe8, 0x5f, 0x42,
+	0xe5, 0x40, 0xf4, 0xc2, 0x28, 0x57, 0x3c, 0x69, 0x98, 0x2c, 0x4b, 0x68, 0x7b, 0xf2, 0xf6, 0x4d,
+	0xfa, 0x3e, 0x94, 0x6c, 0x11, 0xa3, 0xf2, 0x81, 0xe5, 0xf2, 0xaa, 0xf0, 0x15, 0x85, 0x62, 0xa4,
+	0x7e, 0x07, 0x4a, 0x81, 0x13, 0xfa, 0x8b, 0xc0, 0xa6, 0x7d, 0x59, 0x26, 0x19, 0x62, 0x11, 0xce,
+	0x22, 0x31, 0x48, 0x06, 0x01, 0x6c, 0x78, 0xa4, 0xff, 0xf5, 0x3a, 0xe4, 0x19, 0xbb, 0x06, 0x48,
+	0x7d, 0x94, 0xae, 0xa5, 0x08, 0xa7, 0x20, 0x7e, 0x62, 0x45, 0x16, 0xad, 0xb2, 0x0a, 0xa1, 0xff,
+	0x51, 0xd9, 0x89, 0x1b, 0xda, 0x38, 0xe4, 0xbf, 0xa9, 0x55, 0x96, 0x95, 0x6d, 0x09, 0x16, 0x53,
+	0x56, 0x22, 0xf5, 0x9f, 0x83, 0x8b, 0xe1, 0xd1, 0x22, 0x9a, 0xf8, 0xaf, 0xbc, 0x71, 0xa2, 0xf1,
+	0x55, 0x4f, 0x6c, 0x7c, 0xe7, 0x85, 0xd0, 0x5e, 0xdc, 0x08, 0x95, 0x51, 0x78, 0xe3, 0xe4, 0x51,
+	0xd8, 0xf8, 0x68, 0x4d, 0x67, 0xd1, 0x32, 0x77, 0x1b, 0xa3, 0xfd, 0xa1, 0x96, 0xd2, 0x01, 0xf2,
+	0xcd, 0xd1, 0x60, 0xd8, 0xeb, 0x68, 0x69, 0xe3, 0x6f, 0xa6, 0x00, 0x3a, 0x56, 0x18, 0x31, 0x53,
+	0xd3, 0xb8, 0x71, 0xc2, 0x1b, 0x01, 0xc6, 0x41, 0x22, 0x8e, 0xc4, 0xbe, 0x08, 0x83, 0xf5, 0x9a,
+	0x12, 0xac, 0x57, 0x1f, 0x64, 0xef, 0xdf, 0xb9, 0x7f, 0x87, 0xf7, 0x7b, 0x1a, 0x64, 0xe6, 0xee,
+	0x84, 0x9a, 0xad, 0x44, 0xf0, 0x6f, 0x22, 0xae, 0xc9, 0x2d, 0xc5, 0x35, 0x35, 0x28, 0xbc, 0x74,
+	0x82, 0x10, 0x63, 0x5f, 0x1a, 0x0d, 0x11, 0x91, 0x54, 0x43, 0x7b, 0xe6, 0x49, 0x27, 0x84, 0xf6,
+	0xc6, 0x3f, 0x4c, 0x41, 0x89, 0x4d, 0xc6, 0x50, 0xf9, 0x64, 0x40, 0x9c, 0x5e, 0xfa, 0x20, 0x53,
+	0x1c, 0x5d, 0x3a, 0x47, 0x15, 0xbf, 0xcb, 0x15, 0x4f, 0xb8, 0x54, 0xe6, 0x2c, 0x2e, 0xf5, 0x29,
+	0x80, 0x15, 0x45, 0x81, 0xfb, 0x7c, 0x11, 0x49, 0x2f, 0x54, 0x82, 0xe8, 0x86, 0xe0, 0x11, 0x05,
+	0xa6, 0xbf, 0x4b, 0x2d, 0x9b, 0x3f, 0x56, 0x24, 0x36, 0x95, 0x44, 0x63, 0x1b, 0xbf, 0x93, 0x81,
+	0xdc, 0x53, 0xda, 0xf1, 0x6c, 0xcb, 0x20, 0x29, 0xbd, 0xbd, 0xa1, 0x36, 0x5c, 0xca, 0x56, 0x5b,
+	0xfa, 0x0e, 0x0e, 0x4d, 0xd6, 0xd4, 0x0a, 0x8e, 0xcf, 0x21, 0x18, 0x76, 0x40, 0xb9, 0x84, 0xa3,
+	0x10, 0x1f, 0x58, 0xde, 0xa1, 0x13, 0xd6, 0x32, 0xab, 0xf1, 0x84, 0x72, 0x09, 0x47, 0xe9, 0xb7,
+	0x20, 0x13, 0x3a, 0x2c, 0xf6, 0x4d, 0x14, 0x92, 0x67, 0xee, 0x44, 0x04, 0xf9, 0x54, 0x61, 0xe7,
+	0x35, 0x8b, 0x7d, 0xcb, 0x2b, 0x14, 0x76, 0x5e, 0x47, 0x84, 0x22, 0xea, 0x37, 0x20, 0xcf, 0x54,
+	0x4a, 0xf6, 0xb9, 0x29, 0xde, 0xe7, 0xd6, 0x3f, 0x81, 0x1c, 0x55, 0x01, 0xd9, 0xcf, 0x9d, 0x43,
+	0xd7, 0xa3, 0xec, 0x2c, 0x61, 0x09, 0x74, 0x33, 0xc7, 0x9b, 0x50, 0x8f, 0xcc, 0x12, 0xfc, 0x5b,
+	0xbf, 0x0f, 0x79, 0xa6, 0xb3, 0xfe, 0x7d, 0xc8, 0x51, 0xad, 0xf9, 0xc8, 0x74, 0x71, 0x65, 0xd1,
+	0x08, 0xc3, 0xd4, 0xaf, 0x40, 0x66, 0xe0, 0xd0, 0xe1, 0xda, 0x8d, 0x9c, 0x19, 0x15, 0x29, 0x11,
+	0xfa, 0xbf, 0x7e, 0x0d, 0xb2, 0xa8, 0xf0, 0x9a, 0xa9, 0xf9, 0x5d, 0xde, 0xbe, 0x00, 0xf2, 0x83,
+	0x66, 0x63, 0xbf, 0x41, 0xb4, 0xef, 0xe1, 0x7f, 0xd2, 0xe8, 0xee, 0xd1, 0xa0, 0xb3, 0x00, 0x99,
+	0x81, 0x39, 0x64, 0xd3, 0x95, 0xa1, 0xf9, 0xf5, 0x50, 0xcb, 0x18, 0x7f, 0x84, 0x6e, 0x2a, 0x5c,
+	0x41, 0xf6, 0x48, 0x29, 0x65, 0x5a, 0x22, 0x2a, 0x3c, 0xfd, 0x16, 0x15, 0x9e, 0x79, 0xcb, 0x0a,
+	0xcf, 0xbe, 0x4d, 0x85, 0xe7, 0xff, 0x6f, 0x55, 0xb8, 0xf1, 0x3b, 0x25, 0x28, 0x8a, 0x56, 0xf4,
+	0xff, 0x47, 0xd9, 0x73, 0xa7, 0x94, 0x7d, 0xcd, 0x3c, 0xee, 0xe7, 0x60, 0xd3, 0x9a, 0x4e, 0x7d,
+	0x9b, 0xf6, 0xe4, 0x63, 0xd7, 0x3b, 0xf0, 0xf9, 0xc0, 0xf6, 0xee, 0xf1, 0xee, 0x64, 0xa7, 0x21,
+	0x91, 0xb4, 0xcf, 0xdf, 0xb0, 0x12, 0x69, 0xbd, 0x05, 0xe5, 0xc0, 0x09, 0x31, 0xfc, 0x47, 0x52,
+	0xad, 0xb8, 0x1c, 0xc0, 0xca, 0x7c, 0x48, 0x8c, 0x62, 0x91, 0x9b, 0x22, 0x86, 0x71, 0xc3, 0xc4,
+	0x0d, 0x5f, 0xf0, 0x8e, 0xf4, 0xea, 0x0a, 0xf1, 0x96, 0x1b, 0xf2, 0x78, 0x19, 0x81, 0xfa, 0xcf,
+	0x60, 0x5f, 0xf8, 0xd2, 0xb7, 0x69, 0xbc, 0xc3, 0xa2, 0xe6, 0xad, 0x95, 0x1f, 0xe5, 0x18, 0x36,
+	0xe2, 0x49, 0x11, 0xfd, 0x3e, 0xe4, 0xc3, 0x23, 0x2b, 0x70, 0x26, 0x74, 0x60, 0x2d, 0xdf, 0xbb,
+	0xbe, 0x42, 0x78, 0x40, 0x01, 0x54, 0x92, 0x83, 0xeb, 0x37, 0x61, 0x23, 0x69, 0x0f, 0xf4, 0x13,
+	0x6a, 0x62, 0xb6, 0xae, 0x41, 0xff, 0xd7, 0xbf, 0x81, 0xcd, 0xa5, 0xd2, 0x26, 0xa7, 0xc7, 0xa9,
+	0xe5, 0xe9, 0x71, 0x3c, 0x66, 0xa6, 0x4f, 0x1e, 0x33, 0xeb, 0x7f, 0x25, 0x0b, 0x45, 0x61, 0x0a,
+	0xbd, 0x0d, 0xe5, 0x39, 0x0e, 0x46, 0x61, 0xe4, 0x78, 0xb6, 0xc3, 0x23, 0xe5, 0x0f, 0x4e, 0x30,
+	0xde, 0x4e, 0x3f, 0x86, 0x13, 0x55, 0x16, 0x35, 0x78, 0xe9, 0x4f, 0x17, 0x33, 0xe7, 0xb8, 0x06,
+	0x4f, 0x29, 0x9d, 0x70, 0xbe, 0xfe, 0x40, 0x86, 0x24, 0x99, 0xb5, 0x75, 0x2d, 0xbf, 0x37, 0xa0,
+	0x69, 0x11, 0xb2, 0xd4, 0x1f, 0x42, 0x59, 0xd1, 0xe0, 0xd8, 0x18, 0x9e, 0x30, 0x52, 0x7a, 0xc9,
+	0x48, 0xf5, 0xdf, 0x48, 0x43, 0x9e, 0xe5, 0xa7, 0x4c, 0xcd, 0xd3, 0xc9, 0xa9, 0xf9, 0x3a, 0x0d,
+	0xd4, 0xb6, 0xf9, 0x50, 0x99, 0xf7, 0x94, 0xcf, 0x24, 0xdc, 0xb7, 0xa2, 0x23, 0x3e, 0x41, 0xfa,
+	0x19, 0xc8, 0xcd, 0xfc, 0x85, 0x17, 0xf1, 0xc2, 0x6f, 0x9f, 0x41, 0xba, 0x83, 0x78, 0xc2, 0xc4,
+	0xea, 0x75, 0xc8, 0x62, 0x6e, 0xcc, 0x6d, 0xfc, 0x48, 0x74, 0x2f, 0xf8, 0xbf, 0x7e, 0x15, 0x72,
+	0x14, 0xbb, 0x8a, 0x69, 0x5c, 0xe5, 0x9d, 0x79, 0x11, 0xb2, 0xfd, 0xc6, 0xf0, 0xb1, 0x96, 0xd2,
+	0x4b, 0x90, 0xeb, 0xf4, 0x46, 0xdd, 0xa1, 0x96, 0xae, 0x6f, 0x42, 0x35, 0xe1, 0xe9, 0xf5, 0x0a,
+	0x40, 0xec, 0xbd, 0xc6, 0x5f, 0x4e, 0x43, 0x6d, 0x18, 0x58, 0x07, 0x07, 0xae, 0x8d, 0xf1, 0x6a,
+	0xe0, 0x4f, 0x07, 0x91, 0x15, 0xb9, 0x61, 0xe4, 0xda, 0xe1, 0xb1, 0x4a, 0xa8, 0x41, 0xe1, 0xb9,
+	0x65, 0xbf, 0x98, 0xfa, 0x87, 0xd4, 0x42, 0x59, 0x22, 0x92, 0x74, 0x9c, 0x7b, 0x13, 0xf1, 0x01,
+	0x39, 0x4b, 0x58, 0x02, 0xa9, 0x93, 0xc0, 0x9f, 0xb3, 0x9e, 0x2b, 0x4b, 0x58, 0x02, 0x27, 0x24,
+	0x18, 0x5a, 0x4e, 0xdd, 0x99, 0x1b, 0xb1, 0xb9, 0x7c, 0x96, 0x28, 0x14, 0xfc, 0xca, 0xdc, 0xb2,
+	0x5f, 0x38, 0x11, 0x9b, 0xb2, 0x67, 0x89, 0x48, 0x62, 0xe1, 0xbf, 0x9d, 0x3a, 0x1e, 0xed, 0x0a,
+	0xb2, 0x84, 0xfe, 0x47, 0x74, 0x60, 0x45, 0xce, 0xf3, 0x79, 0x48, 0x3b, 0x98, 0x2c, 0x11, 0x49,
+	0xc1, 0x99, 0xcf, 0xc3, 0x5a, 0x29, 0xe6, 0xcc, 0xe7, 0x74, 0x65, 0x37, 0x70, 0xbe, 0x5d, 0x38,
+	0x0b, 0xba, 0xa2, 0x84, 0x2c, 0x99, 0x36, 0x7e, 0x9c, 0x83, 0x4a, 0x7b, 0xae, 0x18, 0xe1, 0x06,
+	0xc0, 0xae, 0x1f, 0xbc, 0xb2, 0x82, 0x89, 0xeb, 0x1d, 0xd2, 0x86, 0x94, 0x21, 0x70, 0x20, 0x29,
+	0xc8, 0x6f, 0x39, 0x07, 0xd6, 0x62, 0x1a, 0x0d, 0x87, 0xfb, 0xd4, 0x2e, 0x19, 0x02, 0x13, 0x49,
+	0x41, 0x7e, 0xdb, 0x23, 0x8e, 0xed, 0xb8, 0x2f, 0xb9, 0x7d, 0x32, 0x04, 0x5c, 0x49, 0xc1, 0x35,
+	0xe9, 0xb6, 0xf7, 0x78, 0x12, 0x98, 0x41, 0xe0, 0x07, 0xcc, 0x54, 0x19, 0x52, 0x76, 0x63, 0x92,
+	0x6e, 0x40, 0xa5, 0xed, 0x35, 0x26, 0x22, 0x4d, 0x4d, 0x96, 0x21, 0x15, 0x57, 0xa1, 0xe9, 0x37,
+	0xa1, 0x8a, 0x5a, 0xb6, 0xac, 0xc8, 0x3a, 0x0c, 0xac, 0x19, 0x33, 0x5d, 0x86, 0x54, 0x0f, 0x54,
+	0xa2, 0xbe, 0x0d, 0x9b, 0x6d, 0x6f, 0xe4, 0xbd, 0xf0, 0xfc, 0x57, 0x5e, 0x1f, 0x37, 0x16, 0x58,
+	0x7c, 0x9a, 0xc1, 0x75, 0x9a, 0x04, 0x99, 0x69, 0x8d, 0x93, 0x02, 0x2b, 0x98, 0x30, 0xcb, 0x52,
+	0xad, 0x05, 0x85, 0xf3, 0x9d, 0xa9, 0x8b, 0x21, 0x6f, 0xad, 0x24, 0xf9, 0x9c, 0x82, 0xa5, 0xea,
+	0x2d, 0x22, 0x82, 0x56, 0x0d, 0x23, 0x66, 0xe5, 0x0c, 0x29, 0xfb, 0x31, 0x89, 0x23, 0xe4, 0x27,
+	0xca, 0x12, 0x21, 0xbf, 0xc1, 0x10, 0x5d, 0x9f, 0xf8, 0x34, 0x46, 0xad, 0x48, 0x84, 0x20, 0xa1,
+	0x65, 0x88, 0x63, 0x85, 0x33, 0xbe, 0x86, 0x49, 0xe7, 0x24, 0x19, 0x52, 0x09, 0x14, 0x1a, 0x6a,
+	0x4a, 0x31, 0xc4, 0xf9, 0x76, 0xc2, 0x26, 0x1e, 0x19, 0x02, 0x81, 0xa4, 0xa0, 0x33, 0x50, 0x7e,
+	0xef, 0x49, 0x48, 0xe7, 0xa6, 0x19, 0x74, 0x06, 0x96, 0x96, 0xb2, 0xb8, 0xb6, 0x14, 0xd6, 0x34,
+	0x45, 0x96, 0x52, 0xd0, 0xc5, 0x76, 0x03, 0xeb, 0x10, 0x45, 0xcf, 0x51, 0x66, 0xe1, 0x80, 0x25,
+	0xb1, 0xbf, 0x42, 0x0e, 0x13, 0xd4, 0x29, 0xaf, 0x74, 0x20, 0x08, 0x58, 0x32, 0xe4, 0x36, 0x03,
+	0xc7, 0xc2, 0x92, 0x9d, 0x67, 0x25, 0x3b, 0x88, 0x49, 0xc6, 0xdf, 0x2e, 0xc0, 0x46, 0xdb, 0x9e,
+	0xa9, 0x8e, 0x78, 0x09, 0xf2, 0x6d, 0xaf, 0x13, 0x1e, 0x86, 0xdc, 0x09, 0xf3, 0x2e, 0x4d, 0x61,
+	0x01, 0xda, 0x1e, 0x77, 0x0d, 0xe6, 0x7e, 0x45, 0xd7, 0x53, 0x5d, 0xa7, 0x19, 0x2e, 0x66, 0x9c,
+	0x9f, 0x11, 0xae, 0x13, 0xd3, 0xf4, 0xf7, 0x61, 0x03, 0xab, 0x32, 0x8c, 0x46, 0x5e, 0xe0, 0x58,
+	0xf6, 0x91, 0xf0, 0xc1, 0x0d, 0x37, 0x41, 0x65, 0x8e, 0x8a, 0x56, 0x35, 0x5f, 0xdb, 0x13, 0xe1,
+	0x85, 0x65, 0x37, 0x26, 0x31, 0x44, 0xdf, 0x0a, 0x66, 0xfd, 0xc0, 0x7f, 0x2e, 0x5c, 0xb0, 0xec,
+	0xc6, 0x24, 0xa6, 0xcf, 0x20, 0xb0, 0x7f, 0xb4, 0x70, 0x3c, 0xfb, 0x48, 0x78, 0x5f, 0xc5, 0x55,
+	0x68, 0x2c, 0x17, 0xe2, 0x4c, 0xdc, 0xc0, 0xb1, 0x23, 0xe1, 0x7b, 0x65, 0x37, 0x26, 0xa1, 0xd9,
+	0xdb, 0x9e, 0x69, 0x1f, 0xf9, 0xc2, 0xf3, 0x0a, 0x2e, 0x4b, 0x32, 0xb7, 0xc4, 0xbf, 0xc4, 0x99,
+	0x0b, 0xaf, 0x03, 0x57, 0x52, 0xd8, 0xf7, 0x51, 0xe1, 0x30, 0xb2, 0x66, 0x73, 0xe1, 0x75, 0x15,
+	0x57, 0xa1, 0xb1, 0x46, 0x22, 0xd3, 0x34, 0xa3, 0x8a, 0x68, 0x24, 0x09, 0x32, 0xd3, 0x14, 0x1b,
+	0x61, 0xc7, 0x0a, 0x5f, 0x84, 0xdc, 0xfb, 0xca, 0x6e, 0x4c, 0x62, 0xb6, 0x15, 0x49, 0x9a, 0xd5,
+	0x86, 0xb0, 0xad, 0x4a, 0xc5, 0x12, 0xf5, 0x16, 0x11, 0xad, 0x5c, 0xe6, 0x83, 0x05, 0x9f, 0x25,
+	0xd1, 0x91, 0x7a, 0x8b, 0x88, 0x57, 0x1f, 0xf3, 0xc0, 0x92, 0x2f, 0x08, 0xa8, 0x2b, 0x36, 0x22,
+	0xb5, 0xf2, 0x98, 0x23, 0x6e, 0xfa, 0x49, 0x32, 0x96, 0xbc, 0xb7, 0x88, 0xe2, 0xea, 0x63, 0x3e,
+	0x59, 0xf1, 0x15, 0x1a, 0xc7, 0xc4, 0x15, 0x78, 0x5e, 0x62, 0xe2, 0x1a, 0xbc, 0x09, 0xd5, 0xde,
+	0x22, 0x52, 0xaa, 0xf0, 0x02, 0xeb, 0x68, 0x7c, 0x95, 0xc8, 0x73, 0x8a, 0x2b, 0xf1, 0xa2, 0xcc,
+	0x29, 0xae, 0xc5, 0x3a, 0x14, 0xb1, 0x64, 0xb4, 0x1a, 0x2f, 0x31, 0xbf, 0xf5, 0x79, 0x9a, 0x37,
+	0x7d, 0x59, 0x91, 0x97, 0x65, 0xd3, 0x97, 0x35, 0xc9, 0xf4, 0x50, 0xaa, 0xb2, 0x26, 0xf5, 0x88,
+	0x89, 0xfa, 0x6d, 0xd0, 0x54, 0x14, 0xcd, 0xec, 0x0a, 0x05, 0x6a, 0xfe, 0x12, 0x9d, 0xeb, 0x1c,
+	0x57, 0x67, 0x5d, 0xea, 0x1c, 0xd7, 0x27, 0xb3, 0x77, 0xa2, 0x42, 0xaf, 0x4a, 0x7b, 0xab, 0x64,
+	0xe3, 0x1f, 0x67, 0xa0, 0x3a, 0xb4, 0xd5, 0xf6, 0x8b, 0x9d, 0x55, 0xe4, 0x37, 0xa6, 0x87, 0x7e,
+	0xe0, 0x46, 0x47, 0x33, 0xde, 0x8a, 0x2b, 0x81, 0x42, 0xc3, 0x36, 0x4e, 0x22, 0xbf, 0xe3, 0x7a,
+	0xbc, 0x25, 0xe7, 0x03, 0x9a, 0x12, 0x74, 0xeb, 0x75, 0x2d, 0x13, 0xd3, 0xad, 0xd7, 0xe8, 0x37,
+	0x1d, 0xeb, 0x75, 0xd3, 0xf7, 0x3c, 0xde, 0x68, 0x0b, 0x33, 0x96, 0x44, 0x0b, 0x36, 0x6c, 0x5c,
+	0x17, 0xef, 0xcd, 0x1d, 0x4f, 0xb6, 0x56, 0x2b, 0x26, 0xa1, 0x3e, 0x7d, 0x2b, 0x0c, 0x25, 0x84,
+	0x35, 0xd7, 0xca, 0x5c, 0xa1, 0x21, 0xa6, 0x11, 0x45, 0xce, 0x6c, 0x1e, 0xb1, 0x9e, 0x8c, 0xb7,
+	0x57, 0x4b, 0xa1, 0xe1, 0x97, 0xcc, 0x30, 0xb2, 0x9e, 0x13, 0x27, 0x74, 0xe2, 0xf6, 0xea, 0xc4,
+	0x24, 0xf4, 0xe1, 0xe6, 0x22, 0x08, 0x28, 0x8a, 0xb7, 0xd8, 0x92, 0x2d, 0x08, 0xac, 0x5f, 0x1b,
+	0x38, 0x87, 0xa2, 0xbd, 0xe6, 0x5d, 0x9a, 0xe2, 0x6d, 0x82, 0x32, 0xca, 0xb2, 0x4d, 0x50, 0xce,
+	0x16, 0x94, 0x89, 0x13, 0x05, 0x96, 0x17, 0x52, 0x2e, 0x1f, 0x18, 0x82, 0x98, 0xc4, 0xf2, 0x34,
+	0x83, 0x40, 0x34, 0xca, 0x3c, 0xed, 0x11, 0x45, 0x9e, 0x24, 0x8c, 0x44, 0x43, 0x2c, 0xf8, 0x2c,
+	0x79, 0xac, 0xa7, 0xdc, 0x3c, 0xde, 0x53, 0x1a, 0xbf, 0x99, 0x86, 0xea, 0x68, 0xa2, 0xd6, 0x29,
+	0xed, 0x01, 0xe2, 0x41, 0x37, 0x25, 0x7a, 0x00, 0x49, 0xc2, 0x2f, 0x76, 0xfd, 0xbe, 0x1f, 0x44,
+	0xa2, 0x73, 0x2e, 0x78, 0x2c, 0x99, 0xe8, 0xb7, 0x33, 0xc7, 0xfb, 0x6d, 0x6c, 0xd7, 0x32, 0xe3,
+	0xac, 0xf4, 0xc5, 0x38, 0x67, 0xf4, 0x27, 0xfb, 0xe5, 0xf3, 0xc5, 0x41, 0x32, 0x2c, 0x08, 0x14,
+	0x1a, 0x62, 0x06, 0xde, 0x24, 0xc6, 0xf0, 0x3a, 0x0e, 0xbd, 0x49, 0x02, 0x93, 0x28, 0x79, 0x61,
+	0xc5, 0x18, 0x81, 0x98, 0x43, 0xcf, 0x0f, 0x9c, 0x49, 0x67, 0x31, 0x8d, 0x5c, 0x5e, 0xc9, 0x15,
+	0x57, 0xa1, 0x19, 0xbf, 0x9b, 0x82, 0x8d, 0x41, 0xb7, 0xd3, 0x57, 0xcc, 0x73, 0x17, 0x8a, 0xee,
+	0x7c, 0x8c, 0xdb, 0x04, 0xe1, 0xf1, 0xc5, 0x7a, 0x35, 0xca, 0x22, 0x05, 0x97, 0xa6, 0x70, 0xa5,
+	0x1d, 0x5c, 0x7b, 0x26, 0x84, 0xd2, 0xcb, 0x7b, 0xaf, 0xc9, 0x31, 0x91, 0x94, 0x5c, 0x9e, 0xc6,
+	0x6d, 0x9f, 0x52, 0x64, 0x0b, 0xb9, 0xcc, 0xf2, 0xfa, 0x66, 0xa2, 0x29, 0x92, 0x62, 0x64, 0xc7,
+	0x52, 0x8b, 0x89, 0x90, 0xca, 0x2e, 0x4b, 0x25, 0x2a, 0x9b, 0x14, 0x17, 0x2c, 0x19, 0x1a, 0xff,
+	0x60, 0x13, 0x74, 0x11, 0xd4, 0x2b, 0xc5, 0xbd, 0x06, 0xa5, 0x48, 0x74, 0x29, 0x7c, 0x41, 0x28,
+	0x26, 0xb0, 0x29, 0x8c, 0x6f, 0x3b, 0x61, 0xe8, 0x84, 0xb5, 0x1b, 0xb8, 0x85, 0x45, 0x62, 0x02,
+	0xfa, 0x49, 0x74, 0x14, 0x38, 0xd6, 0x24, 0xac, 0xbd, 0x43, 0x79, 0x22, 0xa9, 0x7f, 0x0c, 0xe7,
+	0xed, 0xf9, 0x22, 0x1c, 0x2f, 0x42, 0xbe, 0x8f, 0x8b, 0x3b, 0x5a, 0x7c, 0x43, 0x8e, 0x68, 0xc8,
+	0x1a, 0x85, 0x6c, 0x1b, 0x77, 0xe0, 0x50, 0x9b, 0x5f, 0xa4, 0xf0, 0xf0, 0x4d, 0x18, 0x39, 0x33,
+	0x45, 0x80, 0xee, 0xca, 0x11, 0x1d, 0x99, 0x03, 0xca, 0x93, 0x22, 0xd7, 0x01, 0xa8, 0x08, 0x0d,
+	0xc0, 0xd9, 0x7e, 0x1c, 0x29, 0x21, 0x65, 0x1f, 0x09, 0xfa, 0xfb, 0xb0, 0x49, 0xd9, 0x5e, 0xc0,
+	0xd7, 0x7e, 0x99, 0x8f, 0x54, 0x49, 0x15, 0xc9, 0xdd, 0x80, 0xad, 0xee, 0x62, 0x67, 0x7b, 0x4e,
+	0xe0, 0xa2, 0xa3, 0xc0, 0x8f, 0xa2, 0xa9, 0xc3, 0x96, 0xdf, 0xab, 0x64, 0x93, 0x21, 0x87, 0x82,
+	0xac, 0x7f, 0x01, 0x35, 0x8a, 0x95, 0x40, 0x45, 0xd1, 0x12, 0x55, 0x80, 0x96, 0x42, 0x0a, 0x48,
+	0x5d, 0xdf, 0x87, 0xcd, 0x19, 0x16, 0xcb, 0x8f, 0xac, 0xe9, 0x98, 0xcd, 0x39, 0x6e, 0xd2, 0x10,
+	0xbe, 0x3a, 0x73, 0x66, 0x43, 0xa4, 0x3e, 0x42, 0x22, 0x9a, 0x21, 0xc6, 0xcd, 0x9c, 0x59, 0xf8,
+	0x8a, 0xa3, 0x6f, 0x51, 0xb4, 0x2e, 0xd0, 0x1d, 0x64, 0x31, 0x11, 0x9e, 0x35, 0xb5, 0x02, 0x07,
+	0xe7, 0x65, 0xd6, 0xd4, 0x14, 0x0c, 0xf7, 0x09, 0x5c, 0x40, 0x5c, 0xe8, 0x1f, 0x44, 0x09, 0xf0,
+	0xfb, 0x14, 0x7c, 0x6e, 0xe6, 0xcc, 0x06, 0xfe, 0x41, 0xa4, 0x08, 0xdc, 0x84, 0x0d, 0x14, 0xc0,
+	0x9d, 0x12, 0x0e, 0x65, 0xb3, 0x8e, 0xca, 0xcc, 0x99, 0xe1, 0x66, 0x49, 0x02, 0x65, 0x79, 0xbe,
+	0xc7, 0x51, 0x65, 0x89, 0x6a, 0x78, 0xbe, 0x97, 0x50, 0x92, 0xee, 0xc2, 0x70, 0xd8, 0x07, 0x52,
+	0xc9, 0x26, 0x52, 0x19, 0xce, 0x00, 0x24, 0x8c, 0x83, 0x30, 0xe4, 0x28, 0x36, 0xd1, 0x2a, 0xcf,
+	0x9c, 0x19, 0x09, 0xc3, 0x84, 0x8d, 0x66, 0xd6, 0x7c, 0xee, 0x4c, 0x54, 0xf5, 0x2a, 0xd2, 0x46,
+	0x1d, 0xca, 0x3b, 0xa6, 0x64, 0xf8, 0xca, 0x9a, 0x73, 0xec, 0xb6, 0x54, 0x72, 0xf0, 0xca, 0x9a,
+	0x33, 0xd4, 0x3d, 0x96, 0xf1, 0xc2, 0x73, 0x5e, 0xba, 0x36, 0xdd, 0x66, 0xe2, 0xe0, 0x0f, 0x29,
+	0xf8, 0xfc, 0xcc, 0x99, 0x8d, 0x62, 0x1e, 0x93, 0xf9, 0x02, 0x6a, 0xd4, 0xfa, 0xfe, 0xab, 0xf1,
+	0x3c, 0x70, 0xc2, 0x70, 0x11, 0x38, 0x63, 0x1b, 0xe7, 0xbc, 0x4e, 0x50, 0xdb, 0xa2, 0x62, 0x98,
+	0xe7, 0xbe, 0xff, 0xaa, 0xcf, 0xb9, 0x4d, 0xc6, 0xd4, 0x7f, 0x08, 0x57, 0x69, 0x29, 0x9c, 0x89,
+	0xbb, 0x98, 0x1d, 0x97, 0x7d, 0x97, 0xca, 0x62, 0xde, 0x1d, 0x8a, 0x58, 0x16, 0x6f, 0xc0, 0x75,
+	0x6a, 0xd0, 0xc0, 0x8d, 0x5c, 0xdb, 0x9a, 0x1e, 0xcf, 0xc0, 0xa0, 0x19, 0xd4, 0xd1, 0xbc, 0x1c,
+	0xb3, 0x9c, 0xc5, 0x36, 0x68, 0xb8, 0xf2, 0x94, 0x70, 0x86, 0x3a, 0x95, 0xda, 0x40, 0xba, 0xe2,
+	0x09, 0xef, 0xc3, 0x26, 0x45, 0x2e, 0x42, 0x67, 0xc2, 0x81, 0x57, 0x59, 0xed, 0x21, 0x79, 0x14,
+	0x3a, 0x13, 0x86, 0xfb, 0x08, 0xb2, 0x73, 0x27, 0x38, 0xa8, 0x55, 0x97, 0xfb, 0xbf, 0xbe, 0x13,
+	0x1c, 0x28, 0x5d, 0x12, 0x45, 0x61, 0xa5, 0x78, 0x4e, 0x34, 0x0e, 0x5e, 0x8f, 0xc5, 0xc4, 0x79,
+	0x83, 0x55, 0x8a, 0xe7, 0x44, 0xe4, 0x75, 0x9f, 0xd1, 0xf4, 0x2d, 0xa8, 0x70, 0x14, 0xfb, 0xf0,
+	0x26, 0xc5, 0x00, 0xc5, 0x48, 0x9f, 0xe1, 0x08, 0x27, 0x8e, 0x37, 0xb3, 0xa4, 0x4c, 0x21, 0x72,
+	0xa2, 0x29, 0xbe, 0x85, 0xb3, 0xf9, 0xb9, 0x33, 0xa9, 0x9d, 0x53, 0xbe, 0xd5, 0x62, 0x34, 0x81,
+	0x8a, 0x62, 0x8d, 0x74, 0x89, 0x1a, 0x2e, 0x6b, 0x14, 0x09, 0x8d, 0xce, 0x4b, 0x8d, 0x86, 0x49,
+	0x8d, 0x22, 0xa9, 0xd1, 0x05, 0xa9, 0xd1, 0x70, 0x49, 0xa3, 0x28, 0xd6, 0xe8, 0xa2, 0xf2, 0x2d,
+	0xa1, 0xd1, 0x97, 0x70, 0x85, 0xa2, 0xec, 0xf9, 0x38, 0x88, 0xa2, 0xf1, 0xcc, 0xb5, 0x03, 0x1f,
+	0x7b, 0x9b, 0xf1, 0xfc, 0xfe, 0x1d, 0x1a, 0x7e, 0xa6, 0xc8, 0x45, 0x14, 0xb0, 0xe7, 0x24, 0x8a,
+	0x3a, 0x82, 0xdb, 0xbf, 0x7f, 0xe7, 0x04, 0xc9, 0xaf, 0xee, 0xd4, 0x2e, 0xaf, 0x95, 0xfc, 0xea,
+	0x44, 0xc9, 0xfb, 0xb5, 0xda, 0x7a, 0xc9, 0xfb, 0x27, 0x49, 0x7e, 0x55, 0xbb, 0xb2, 0x5e, 0xf2,
+	0x2b, 0xfd, 0x21, 0xd4, 0x85, 0x24, 0x0b, 0xf6, 0xc6, 0xb6, 0xef, 0x79, 0x8e, 0x8d, 0xcb, 0x8d,
+	0x61, 0xed, 0x1a, 0x15, 0xbd, 0xcc, 0x44, 0x59, 0x7c, 0xd8, 0x8c, 0xd9, 0xfa, 0xcf, 0xc2, 0x75,
+	0x21, 0x4c, 0xbb, 0xe3, 0x57, 0x96, 0x1b, 0x25, 0xe4, 0xaf, 0x53, 0xf9, 0x2b, 0x4c, 0x1e, 0xfb,
+	0xe4, 0x67, 0x96, 0x1b, 0xa9, 0x39, 0x1c, 0xc2, 0x0d, 0x9a, 0x03, 0x5b, 0x52, 0x1a, 0xdb, 0x6c,
+	0x4d, 0x69, 0x1c, 0x4a, 0x97, 0xad, 0xbd, 0xb7, 0x7c, 0x84, 0x67, 0xdd, 0xf2, 0x13, 0xb9, 0x8a,
+	0x9f, 0x59, 0xc3, 0xd4, 0x1f, 0xc3, 0x79, 0xfc, 0x50, 0xe8, 0xf1, 0x58, 0x81, 0xe7, 0x7e, 0x7b,
+	0xb9, 0xc1, 0x24, 0x23, 0x12, 0x72, 0xce, 0x73, 0xa2, 0x81, 0xa7, 0xc6, 0x10, 0xc6, 0x6f, 0x67,
+	0xa1, 0x2a, 0x06, 0xf3, 0x51, 0x68, 0x1d, 0x3a, 0xb8, 0x82, 0x2c, 0x36, 0x94, 0xc5, 0x09, 0x81,
+	0x15, 0x2b, 0xc8, 0x14, 0x2b, 0x77, 0xa1, 0x49, 0x2c, 0x82, 0xe7, 0xe7, 0xe8, 0xb8, 0x53, 0x4b,
+	0xaf, 0xdd, 0x89, 0x63, 0x80, 0xfa, 0xdf, 0xcf, 0x40, 0x51, 0xe4, 0xa0, 0x3f, 0x84, 0x6a, 0xbc,
+	0xe1, 0x8d, 0x2b, 0xef, 0x6c, 0xcb, 0xfb, 0xd2, 0xea, 0xad, 0x72, 0x52, 0x71, 0x94, 0x14, 0xee,
+	0x00, 0xf2, 0xe5, 0x77, 0x67, 0x72, 0xc2, 0x77, 0x63, 0x90, 0xfe, 0x03, 0x00, 0xc5, 0x70, 0x2c,
+	0x62, 0xba, 0x76, 0x5c, 0x44, 0x31, 0x9e, 0x82, 0xc7, 0x6d, 0x76, 0xb9, 0xa3, 0x3d, 0xa6, 0x7b,
+	0xa6, 0xe9, 0xf5, 0xa7, 0x37, 0xcb, 0x12, 0xda, 0x9e, 0xe8, 0x0f, 0x21, 0x17, 0xd1, 0x09, 0x16,
+	0xdb, 0x74, 0xbc, 0x75, 0x9a, 0x65, 0x77, 0xf0, 0x20, 0x2a, 0x61, 0x32, 0xf5, 0xdf, 0xc0, 0xc3,
+	0x53, 0x56, 0xf8, 0x62, 0xe5, 0x26, 0xcc, 0x16, 0x5d, 0xaf, 0x64, 0x87, 0x29, 0x95, 0x55, 0x6a,
+	0x76, 0x90, 0x95, 0xae, 0x60, 0xbe, 0xfd, 0x3e, 0x69, 0xbc, 0xfe, 0x9e, 0x3d, 0x65, 0xcf, 0xfa,
+	0x9f, 0x6a, 0xb0, 0x91, 0xec, 0x9e, 0x4f, 0x09, 0x08, 0xeb, 0x89, 0xc3, 0x93, 0xc8, 0x94, 0x69,
+	0x9c, 0xc0, 0xd8, 0x6f, 0xec, 0xa9, 0x5c, 0x51, 0xe5, 0x29, 0xfd, 0x73, 0xb8, 0x1c, 0x46, 0xd6,
+	0x14, 0x03, 0x26, 0x46, 0x19, 0x1f, 0x04, 0xbe, 0x17, 0xe1, 0x76, 0x22, 0x5b, 0x64, 0xbd, 0xc8,
+	0xd9, 0x4d, 0xca, 0xdd, 0xe5, 0x4c, 0xfd, 0x33, 0xb8, 0xb4, 0x24, 0x87, 0x4b, 0xb7, 0x28, 0xc6,
+	0xe2, 0x82, 0x0b, 0x09, 0xb1, 0x47, 0x8c, 0x87, 0x61, 0xbf, 0xeb, 0x85, 0x51, 0xb0, 0xe0, 0xcd,
+	0x9f, 0x85, 0x43, 0x09, 0x9a, 0xfe, 0x21, 0x68, 0x2c, 0x18, 0x09, 0x9c, 0x03, 0x27, 0x70, 0x3c,
+	0xdb, 0x61, 0xe1, 0x61, 0x96, 0x6c, 0x52, 0x3a, 0x91, 0x64, 0xfd, 0x5d, 0xa8, 0x30, 0xe8, 0xcc,
+	0xa5, 0x41, 0x30, 0x5b, 0xb0, 0x2d, 0x53, 0x5a, 0x87, 0x92, 0xd0, 0x26, 0xcf, 0x03, 0xcb, 0xb3,
+	0x8f, 0x1c, 0xb1, 0x6a, 0x2b, 0xd3, 0xfa, 0x7b, 0x50, 0x65, 0xff, 0x85, 0x3c, 0x8f, 0xa2, 0x18,
+	0x91, 0x67, 0x70, 0x1d, 0xe0, 0xf9, 0x22, 0xe4, 0x85, 0xe4, 0x11, 0x54, 0xe9, 0xf9, 0x22, 0x64,
+	0x05, 0x43, 0x76, 0xe0, 0x1c, 0x08, 0x36, 0x8b, 0x73, 0x4a, 0x81, 0x73, 0xc0, 0xd9, 0x57, 0x01,
+	0xe3, 0xde, 0xb1, 0x3d, 0xf5, 0xed, 0x17, 0x74, 0xf0, 0x4d, 0x91, 0xa2, 0x3d, 0x5f, 0x34, 0x31,
+	0x8d, 0xb2, 0xe8, 0x84, 0x9c, 0xbb, 0x41, 0xb9, 0x25, 0xa4, 0x30, 0xf6, 0x3b, 0x50, 0x9e, 0x5b,
+	0x87, 0x78, 0x4c, 0x6d, 0x31, 0x8d, 0xe4, 0xf0, 0x8a, 0xa4, 0x5d, 0x4a, 0xc1, 0xe2, 0xcf, 0x5c,
+	0xcf, 0x0f, 0x04, 0x82, 0x8f, 0xae, 0x94, 0xa6, 0x40, 0xac, 0x5f, 0x88, 0x21, 0xe7, 0x38, 0xc4,
+	0xfa, 0x05, 0x09, 0x41, 0x7b, 0x63, 0xa5, 0xbe, 0x8e, 0xc6, 0xe1, 0x2b, 0x37, 0xa2, 0x96, 0xd2,
+	0xb9, 0xbd, 0x19, 0x7d, 0xc0, 0xc9, 0xfa, 0x2d, 0xd8, 0xc0, 0xd2, 0xcc, 0xdc, 0x43, 0xe6, 0x55,
+	0x62, 0x84, 0xc5, 0xb8, 0xbd, 0x23, 0x89, 0x98, 0xa3, 0x35, 0x75, 0x0f, 0xe9, 0x81, 0x25, 0xf1,
+	0x61, 0x36, 0xce, 0x6e, 0x4a, 0x7a, 0xfc, 0x71, 0x67, 0xb6, 0x98, 0x52, 0x41, 0x01, 0x65, 0xa3,
+	0xed, 0xa6, 0xa4, 0x73, 0xe8, 0xfb, 0xb0, 0x39, 0xbd, 0x3b, 0x9e, 0xb0, 0x0a, 0x9f, 0xfa, 0x38,
+	0xb1, 0xb9, 0xc4, 0xbe, 0x3e, 0xbd, 0xdb, 0xa2, 0xd4, 0x7d, 0x24, 0x62, 0x10, 0x9a, 0xc4, 0x89,
+	0xda, 0xbd, 0x4c, 0xd1, 0xba, 0x8a, 0xe6, 0x75, 0xbc, 0x0d, 0x5a, 0x2c, 0x12, 0x46, 0x7e, 0xe0,
+	0xb0, 0xe5, 0x9f, 0x2c, 0xd9, 0x10, 0xe8, 0x01, 0xa5, 0xea, 0x9f, 0xc2, 0xa5, 0x25, 0xa4, 0xc8,
+	0xfd, 0x0a, 0x8b, 0x44, 0x13, 0x78, 0x9e, 0xfd, 0x1d, 0xb8, 0x10, 0x0b, 0xcd, 0xd1, 0xad, 0x99,
+	0x95, 0xeb, 0x49, 0x85, 0xfa, 0x92, 0xa3, 0x7f, 0x05, 0x57, 0x8e, 0x4b, 0x88, 0x2f, 0xb1, 0x00,
+	0xef, 0xd2, 0xb2, 0x18, 0xff, 0x18, 0x33, 0x93, 0xab, 0x9a, 0xe9, 0x9a, 0x30, 0x53, 0xfb, 0x98,
+	0x99, 0xdc, 0xe3, 0x66, 0xba, 0x2e, 0xb4, 0x6a, 0x2f, 0x9b, 0x89, 0x95, 0xc3, 0x3d, 0x56, 0x8e,
+	0x1b, 0x49, 0x89, 0x63, 0xe5, 0x70, 0x57, 0x97, 0xe3, 0x1d, 0x51, 0x8e, 0xf6, 0xaa, 0x72, 0x5c,
+	0x85, 0xd2, 0x74, 0x6a, 0xf3, 0x12, 0xb0, 0x78, 0xbd, 0x38, 0x9d, 0xda, 0x4c, 0x79, 0x2c, 0x24,
+	0x67, 0x8a, 0xdc, 0xde, 0xe5, 0x85, 0x64, 0x90, 0xb8, 0xf1, 0x22, 0x8e, 0x57, 0x29, 0x0b, 0xbc,
+	0x31, 0x5b, 0x5e, 0x9b, 0x58, 0xef, 0x82, 0x2d, 0xf2, 0x79, 0x8f, 0xd7, 0x3b, 0x07, 0xf1, 0x8c,
+	0x6e, 0x01, 0x52, 0xd4, 0x42, 0xdf, 0x94, 0xdf, 0x53, 0xca, 0xbb, 0x03, 0xe7, 0x55, 0x98, 0xc8,
+	0x93, 0x4d, 0x11, 0xcf, 0x29, 0xd8, 0x58, 0xbf, 0x49, 0x34, 0x7d, 0xce, 0x4b, 0xc9, 0xe6, 0x7b,
+	0x25, 0xa4, 0xb0, 0x62, 0xe2, 0x3c, 0x40, 0xb0, 0x45, 0x5e, 0x1f, 0xf0, 0x79, 0x00, 0x07, 0xf1,
+	0x8c, 0xde, 0x81, 0x32, 0x45, 0xf2, 0x92, 0xb2, 0x39, 0x14, 0xcd, 0x9b, 0x17, 0xf5, 0x36, 0x9c,
+	0x8b, 0x01, 0x22, 0x2f, 0x36, 0x7b, 0xda, 0x94, 0x30, 0x9e, 0xd9, 0x07, 0x40, 0x49, 0x6a, 0x69,
+	0x6f, 0xc7, 0x5f, 0x55, 0x8a, 0x7b, 0x07, 0x2e, 0x24, 0x80, 0x22, 0xdf, 0xef, 0x33, 0x87, 0x50,
+	0xd1, 0x71, 0x81, 0xdd, 0xb8, 0xc0, 0x1f, 0xb1, 0x02, 0xbb, 0x6a, 0x81, 0xdd, 0xe5, 0x02, 0x7f,
+	0xcc, 0x3e, 0xed, 0x26, 0x0b, 0xfc, 0x2e, 0xf0, 0x6e, 0x9a, 0x67, 0xb5, 0xc3, 0x3a, 0x36, 0x46,
+	0x63, 0x99, 0x7d, 0x04, 0xba, 0x02, 0x11, 0xd9, 0x7d, 0x42, 0x81, 0x5a, 0x0c, 0x8c, 0x35, 0xf3,
+	0xfc, 0x89, 0x68, 0x32, 0x77, 0x98, 0x66, 0x48, 0x91, 0x9a, 0x49, 0xb6, 0xc8, 0xea, 0x2e, 0xd3,
+	0x4c, 0x80, 0xe2, 0xaa, 0xa0, 0x48, 0x5e, 0x15, 0xf7, 0xf8, 0x1c, 0xc4, 0x9f, 0x38, 0x71, 0x55,
+	0xc4, 0x00, 0x91, 0xd7, 0xa7, 0xac, 0x2a, 0x24, 0x2c, 0xae, 0x0a, 0x8a, 0x55, 0xaa, 0xe2, 0xb3,
+	0xf8, 0xab, 0xc9, 0xaa, 0x48, 0x00, 0x45, 0xbe, 0xf7, 0x59, 0x55, 0xa8, 0x68, 0x96, 0xb5, 0xe1,
+	0x42, 0x81, 0xef, 0x9d, 0xe9, 0x1f, 0x41, 0xd1, 0xc2, 0x23, 0x57, 0xec, 0x58, 0xe4, 0x9a, 0xc3,
+	0x58, 0x05, 0x0a, 0x69, 0x2f, 0xc5, 0x3c, 0xe9, 0x33, 0xc4, 0x3c, 0xc6, 0x1f, 0x03, 0xe4, 0xe8,
+	0xfd, 0x21, 0x7e, 0xe0, 0x2b, 0xb5, 0x7c, 0x3d, 0x85, 0x5f, 0x2e, 0xa2, 0x21, 0xd5, 0xf2, 0x79,
+	0xcb, 0xf4, 0x56, 0xfa, 0x8c, 0xe7, 0x2d, 0xd5, 0x62, 0x64, 0xb6, 0xd2, 0xa7, 0x14, 0x43, 0x3d,
+	0x18, 0x97, 0x5d, 0x3a, 0x18, 0xf7, 0x0e, 0x64, 0xf0, 0xf6, 0x00, 0x3b, 0x61, 0x52, 0x55, 0xd6,
+	0xef, 0xc8, 0x3e, 0x41, 0xce, 0x77, 0x38, 0x72, 0x99, 0x3c, 0x1f, 0x57, 0x38, 0xdb, 0xf9, 0xb8,
+	0x2f, 0xa0, 0xa2, 0x1c, 0x59, 0xc5, 0x78, 0x29, 0xb3, 0xf6, 0xcc, 0x6a, 0x39, 0x3e, 0xb3, 0x1a,
+	0xae, 0xb8, 0x75, 0x52, 0x7a, 0xbb, 0x5b, 0x27, 0xf5, 0xbf, 0x5b, 0x80, 0x52, 0x6f, 0xee, 0xf0,
+	0xf0, 0xf1, 0x5e, 0xe2, 0x82, 0xc2, 0x8d, 0xa5, 0x9a, 0xdb, 0x91, 0x40, 0xf5, 0xf0, 0xc3, 0x97,
+	0x18, 0xe9, 0x2e, 0x3c, 0x5b, 0x1c, 0x7f, 0xd8, 0x5a, 0x2f, 0xb5, 0x4f, 0x71, 0x84, 0xe3, 0xf5,
+	0xc7, 0x50, 0x61, 0xff, 0xc6, 0x87, 0x81, 0xbf, 0x98, 0xf3, 0xa3, 0x3a, 0xb7, 0x4e, 0x93, 0xdf,
+	0x43, 0x30, 0x29, 0x4f, 0xe3, 0x84, 0xfe, 0x10, 0x0a, 0xec, 0xec, 0x8f, 0x38, 0x42, 0xf2, 0xee,
+	0xfa, 0x4c, 0xd8, 0x39, 0x1a, 0x87, 0x08, 0x09, 0xbd, 0x01, 0xa5, 0x85, 0x27, 0xc4, 0xb3, 0xcb,
+	0xc7, 0xec, 0x97, 0xc5, 0x47, 0x02, 0x4a, 0x62, 0x29, 0xb4, 0x81, 0x4d, 0x77, 0x60, 0x6b, 0xb9,
+	0xd3, 0x6c, 0xc0, 0x76, 0x6a, 0x09, 0xc7, 0xa3, 0xe6, 0x13, 0x27, 0x8c, 0x02, 0xff, 0x4d, 0x2d,
+	0x7f, 0x9a, 0xe6, 0x2d, 0x06, 0x24, 0x42, 0xa2, 0xfe, 0x10, 0xf2, 0xcc, 0x24, 0xfa, 0x5d, 0x1e,
+	0x63, 0xe2, 0xfc, 0x4f, 0xcc, 0x3d, 0xf5, 0xa5, 0xa9, 0x0c, 0x3d, 0xaf, 0x14, 0xf1, 0x7f, 0x61,
+	0xfd, 0x0d, 0x94, 0x15, 0x7b, 0xe2, 0x95, 0x2c, 0xe1, 0x59, 0xa7, 0x4c, 0x20, 0x25, 0x4e, 0xff,
+	0x9c, 0x7f, 0x95, 0x55, 0x1f, 0x6b, 0xc1, 0x97, 0x93, 0x5f, 0xa5, 0x99, 0xc7, 0x9f, 0xa6, 0xc9,
+	0xfa, 0x43, 0xec, 0x93, 0x98, 0xe5, 0x12, 0x2d, 0x2c, 0x75, 0x86, 0x16, 0x56, 0xff, 0x21, 0x94,
+	0x64, 0x1d, 0x7c, 0x07, 0xf1, 0xcf, 0x21, 0xcf, 0xaa, 0x40, 0xff, 0x08, 0x0a, 0xec, 0x00, 0xd2,
+	0x49, 0x92, 0x02, 0x52, 0xff, 0x02, 0x0a, 0xdc, 0xfe, 0x6f, 0x27, 0x68, 0x1c, 0xac, 0x3a, 0x93,
+	0x0c, 0x90, 0xdf, 0x6f, 0x8c, 0xba, 0x4d, 0x3c, 0x75, 0xa3, 0x41, 0x85, 0xfd, 0x1f, 0xef, 0x91,
+	0xde, 0xa8, 0xaf, 0xe5, 0x11, 0x4a, 0xcc, 0x81, 0x49, 0x9e, 0xe2, 0x85, 0x9d, 0x2a, 0x94, 0x46,
+	0x5d, 0x91, 0xcc, 0xa0, 0x64, 0x93, 0x98, 0x78, 0x97, 0x27, 0xcb, 0x4e, 0x36, 0x0f, 0x86, 0xa4,
+	0xf7, 0x8d, 0x96, 0x33, 0xfe, 0x46, 0x1a, 0x37, 0x64, 0x5e, 0x3a, 0x41, 0xe8, 0x9c, 0xb9, 0x13,
+	0xe6, 0x1d, 0x60, 0x7a, 0x6d, 0x07, 0xb8, 0xdc, 0x4b, 0x67, 0xbe, 0x53, 0x2f, 0x9d, 0x3d, 0x75,
+	0xb0, 0x39, 0xde, 0x91, 0xe5, 0xb6, 0xd2, 0x6f, 0xd3, 0x91, 0x25, 0x3d, 0x21, 0x7f, 0x96, 0xe1,
+	0xea, 0xb7, 0xb2, 0x50, 0x14, 0x0d, 0x63, 0xe5, 0xba, 0xc0, 0x87, 0x50, 0x60, 0x8d, 0x6a, 0xfd,
+	0xe2, 0x40, 0x9e, 0xb6, 0xa7, 0xb7, 0x1d, 0x93, 0x12, 0xba, 0x66, 0xcf, 0x32, 0xac, 0xa8, 0xad,
+	0x33, 0xb7, 0x95, 0x3a, 0x53, 0xeb, 0xfc, 0x3f, 0xbb, 0x97, 0x50, 0x3a, 0xf3, 0xbd, 0x84, 0x2f,
+	0xa1, 0x72, 0x44, 0xef, 0x14, 0x8d, 0xe9, 0x7d, 0xbf, 0xe3, 0x37, 0x27, 0x94, 0x1b, 0x47, 0xa4,
+	0x7c, 0x14, 0x27, 0xf0, 0xaa, 0xc6, 0x0b, 0x77, 0x3a, 0x1d, 0xcf, 0xe9, 0x3d, 0x1d, 0x7e, 0xbb,
+	0x40, 0x19, 0xf6, 0xe2, 0x3b, 0x3c, 0x04, 0x5e, 0xc8, 0xff, 0xf2, 0x9a, 0x42, 0x5e, 0xb9, 0xa6,
+	0x10, 0xaf, 0xb7, 0xc0, 0x29, 0x37, 0xf5, 0x12, 0x17, 0x1a, 0xca, 0x67, 0xbd, 0xd0, 0x60, 0x7c,
+	0x05, 0xd5, 0x44, 0x7f, 0x46, 0x57, 0xeb, 0xe8, 0x7a, 0xd4, 0xfa, 0xde, 0x96, 0x01, 0x8c, 0x9f,
+	0xe6, 0x4e, 0x58, 0x7c, 0x7a, 0x0b, 0x27, 0xfb, 0xee, 0x8d, 0x71, 0xe9, 0x4e, 0x4c, 0x76, 0xd9,
+	0xd0, 0x6b, 0xee, 0xc4, 0xa8, 0x5e, 0x9d, 0x3b, 0xd5, 0xab, 0x3f, 0xa4, 0xd7, 0x7f, 0x23, 0x3c,
+	0x1b, 0x8c, 0x67, 0x28, 0xcf, 0x27, 0xcb, 0x81, 0xcb, 0x5b, 0x0e, 0x61, 0x88, 0x64, 0x03, 0x28,
+	0x9c, 0xa5, 0x01, 0xdc, 0x51, 0xae, 0x91, 0x15, 0x97, 0xc3, 0x23, 0x91, 0xff, 0x22, 0x8c, 0x2f,
+	0x97, 0xe9, 0x4d, 0x38, 0xcf, 0xfe, 0x8f, 0x17, 0xf3, 0x89, 0x15, 0x39, 0x63, 0xa6, 0x5c, 0x69,
+	0x2b, 0xb5, 0x4e, 0xb9, 0x73, 0x0c, 0x3f, 0xa2, 0x70, 0x4a, 0xc2, 0xc9, 0x45, 0x32, 0x93, 0xc5,
+	0xc2, 0x65, 0x07, 0x7c, 0x2b, 0x44, 0x53, 0xe1, 0xa3, 0x85, 0x3b, 0x79, 0x8b, 0xeb, 0xa2, 0xdf,
+	0xf1, 0x56, 0x4d, 0xa2, 0x85, 0x56, 0xcf, 0xdc, 0x42, 0xc5, 0xed, 0xaf, 0x8d, 0xf8, 0xf6, 0x97,
+	0xf1, 0x2b, 0x15, 0x80, 0xd8, 0x6e, 0xaa, 0x1b, 0xa6, 0x4e, 0x71, 0x43, 0x59, 0xcf, 0xe9, 0x53,
+	0xeb, 0xb9, 0x06, 0x85, 0x99, 0x13, 0xe2, 0xb2, 0x2c, 0xbf, 0x1c, 0x23, 0x92, 0xfa, 0xa7, 0xf2,
+	0xcc, 0x6f, 0x69, 0xf9, 0x62, 0x57, 0xac, 0xd5, 0xd2, 0x61, 0x5f, 0x14, 0x0a, 0x1c, 0x2b, 0xf4,
+	0xbd, 0x1a, 0x9c, 0x20, 0x44, 0x28, 0x84, 0x70, 0xa8, 0xec, 0x2d, 0x32, 0x4a, 0x6f, 0x91, 0x74,
+	0xec, 0xd3, 0x06, 0xa7, 0xa5, 0xd6, 0x53, 0x38, 0x63, 0xeb, 0x49, 0xac, 0xe2, 0xe6, 0xf9, 0xb2,
+	0x9f, 0x20, 0xd0, 0x3a, 0x41, 0x5f, 0x2a, 0x33, 0xb5, 0xf0, 0x3f, 0x9a, 0x8b, 0x75, 0x8f, 0x6f,
+	0x68, 0x27, 0x5a, 0x24, 0x22, 0xa9, 0x78, 0x56, 0xe5, 0x14, 0xcf, 0x6a, 0x81, 0x26, 0x2b, 0x7e,
+	0xcc, 0x3c, 0x94, 0x7b, 0xca, 0x95, 0x15, 0x9e, 0xc2, 0x5b, 0xcd, 0xa6, 0x9d, 0x24, 0xe8, 0x3f,
+	0x04, 0x6d, 0xc1, 0x8e, 0x80, 0xd1, 0x5d, 0x58, 0x54, 0x9b, 0x5f, 0xbe, 0x5a, 0x75, 0xab, 0x7f,
+	0x53, 0xc1, 0x22, 0xd1, 0x78, 0x24, 0xcf, 0x55, 0x9f, 0x83, 0x2a, 0xbb, 0x52, 0x3c, 0xee, 0x34,
+	0x06, 0x43, 0x13, 0x2f, 0x8c, 0x68, 0x50, 0xe1, 0xa4, 0xc6, 0x9e, 0xd9, 0xc5, 0x4b, 0x59, 0xe7,
+	0x61, 0x93, 0x53, 0xcc, 0xaf, 0xcd, 0xe6, 0x68, 0xd8, 0x23, 0x5a, 0xda, 0xf8, 0x97, 0x79, 0xc8,
+	0xb3, 0xaa, 0xd4, 0x0d, 0xb8, 0x41, 0xcc, 0xc6, 0xa0, 0xd7, 0x1d, 0xf3, 0x5b, 0x9f, 0x12, 0x37,
+	0xde, 0x6d, 0xb4, 0xf7, 0xcd, 0x96, 0xf6, 0xbd, 0x04, 0xa6, 0x3b, 0x6c, 0xb4, 0xbb, 0x26, 0x19,
+	0xf3, 0xb0, 0x8a, 0x63, 0x2e, 0xea, 0xef, 0xc0, 0xd5, 0xe3, 0x98, 0x76, 0xa7, 0x3d, 0x6c, 0xe0,
+	0x25, 0x67, 0xed, 0xbc, 0x7e, 0x13, 0xb6, 0x4e, 0x00, 0x8c, 0x5b, 0xed, 0xc1, 0x13, 0xed, 0x82,
+	0xfe, 0x3e, 0x18, 0x27, 0xa1, 0x3a, 0x66, 0xa7, 0x47, 0xbe, 0xd1, 0x8a, 0xfa, 0x0d, 0xa8, 0x1f,
+	0xc3, 0xf5, 0x89, 0x69, 0x76, 0xfa, 0x43, 0xb3, 0xa5, 0x9d, 0x5b, 0xa9, 0xf2, 0xa8, 0xdf, 0x6a,
+	0x0c, 0x4d, 0xa1, 0xf2, 0x25, 0x7d, 0x1b, 0x6e, 0x72, 0x8c, 0x2c, 0x32, 0x31, 0xf7, 0xda, 0x83,
+	0x21, 0x61, 0x1f, 0x1b, 0xb6, 0x3b, 0x66, 0x6f, 0x34, 0xd4, 0x2e, 0xeb, 0xb7, 0xe1, 0xfd, 0xe3,
+	0xc8, 0x95, 0xd8, 0x9a, 0xa2, 0x99, 0xc4, 0x0e, 0x4d, 0xd2, 0x69, 0x77, 0x1b, 0xa8, 0x59, 0x4a,
+	0xdf, 0x82, 0x6b, 0xcb, 0xfc, 0x51, 0x97, 0xe5, 0x65, 0x12, 0xb3, 0xa5, 0xa5, 0xf5, 0x6b, 0x50,
+	0xe3, 0x88, 0x5d, 0xd2, 0xe8, 0x98, 0xcf, 0x7a, 0xe4, 0xc9, 0x98, 0x98, 0x9d, 0xde, 0x53, 0xb3,
+	0xa5, 0x65, 0xb0, 0x42, 0x39, 0x77, 0xaf, 0x39, 0x36, 0x09, 0xe9, 0x11, 0x2d, 0xab, 0x7c, 0xb4,
+	0xdd, 0x7d, 0xda, 0xd8, 0x6f, 0xb7, 0x62, 0xd1, 0x76, 0x4b, 0xcb, 0xe9, 0x57, 0xe0, 0xe2, 0x12,
+	0xbf, 0xb7, 0xbb, 0x6b, 0x92, 0x81, 0x96, 0x57, 0x44, 0x99, 0x17, 0x61, 0x4d, 0x34, 0x7b, 0xdd,
+	0xae, 0xd9, 0x44, 0x7d, 0x0b, 0x8a, 0x28, 0x31, 0x9b, 0xbd, 0x6e, 0xb3, 0xbd, 0xdf, 0x66, 0x55,
+	0x5a, 0x52, 0x14, 0x95, 0x97, 0xdb, 0xc7, 0x22, 0x0e, 0xd7, 0xf5, 0xeb, 0x70, 0x85, 0x73, 0xa9,
+	0x2f, 0x26, 0xf3, 0x05, 0xbd, 0x06, 0x17, 0x12, 0x6c, 0x51, 0xc2, 0xb2, 0x5e, 0x87, 0x4b, 0x4b,
+	0x9c, 0xc1, 0xb0, 0x41, 0x50, 0xaa, 0x72, 0x4c, 0x4a, 0x7c, 0xae, 0xaa, 0x7c, 0x8e, 0xde, 0xcd,
+	0xa7, 0xf1, 0xbe, 0x28, 0xad, 0x76, 0x45, 0x71, 0x08, 0x85, 0x3d, 0xea, 0x36, 0x46, 0xc3, 0xc7,
+	0x3d, 0xd2, 0xfe, 0x79, 0xb3, 0xa5, 0xd5, 0xd9, 0x85, 0xff, 0x18, 0x23, 0x84, 0x37, 0x94, 0x82,
+	0x52, 0x46, 0x42, 0x6c, 0x73, 0x59, 0x4c, 0xa8, 0xa4, 0x19, 0x9f, 0x42, 0x61, 0xd7, 0x9d, 0x46,
+	0x0e, 0xdd, 0x7f, 0xdc, 0x08, 0x9c, 0x83, 0x45, 0xe8, 0x8c, 0xe3, 0x97, 0x4e, 0xe8, 0xb3, 0x0f,
+	0xf7, 0x49, 0x95, 0x31, 0xf8, 0xad, 0x77, 0xe3, 0x97, 0x52, 0x50, 0x56, 0xee, 0x2e, 0xeb, 0x3f,
+	0x80, 0xd2, 0x4b, 0x2b, 0x70, 0xb1, 0xfd, 0x8b, 0x78, 0xe8, 0xc6, 0xca, 0x5b, 0xce, 0x3b, 0x4f,
+	0x39, 0x8c, 0xc4, 0x02, 0xf5, 0xcf, 0xa0, 0x28, 0xc8, 0x2b, 0x43, 0x24, 0x79, 0x17, 0x2d, 0xad,
+	0xde, 0x45, 0xfb, 0x14, 0x4a, 0xf2, 0xbd, 0x12, 0xbc, 0x1a, 0xf7, 0xc2, 0x79, 0xc3, 0xa5, 0xf0,
+	0xef, 0x1a, 0xa1, 0x3f, 0x05, 0x20, 0x85, 0x70, 0x71, 0xbc, 0x34, 0x17, 0x29, 0xae, 0xf6, 0xca,
+	0xd7, 0x50, 0x62, 0x94, 0xf1, 0x08, 0xa0, 0x19, 0x38, 0x13, 0xc7, 0x8b, 0x5c, 0x6b, 0xba, 0x7c,
+	0x07, 0x27, 0x9d, 0xbc, 0x83, 0x83, 0x57, 0xe7, 0x1d, 0x3b, 0x70, 0x22, 0x7e, 0xf3, 0x84, 0xa7,
+	0x0c, 0x13, 0xca, 0x71, 0x1e, 0xb8, 0x37, 0x57, 0xb6, 0xe3, 0x24, 0xd7, 0x43, 0x19, 0x5e, 0x62,
+	0x2c, 0x51, 0x81, 0xc6, 0x33, 0x28, 0x11, 0x2b, 0x72, 0xd8, 0x61, 0x2b, 0x0d, 0x32, 0xdf, 0xce,
+	0x79, 0x85, 0x11, 0xfc, 0xbb, 0x7c, 0xf5, 0x65, 0x49, 0xb7, 0x3a, 0x14, 0xf1, 0xa5, 0x0b, 0x5b,
+	0xbc, 0x54, 0x92, 0x25, 0x32, 0x6d, 0xfc, 0x56, 0x0a, 0x40, 0xe6, 0x8c, 0x57, 0x0b, 0xf3, 0xfc,
+	0xd2, 0xc5, 0x31, 0x13, 0x49, 0x14, 0xe1, 0x10, 0x3c, 0xc2, 0x63, 0x1d, 0x1e, 0x06, 0xce, 0x21,
+	0x06, 0x4b, 0xfc, 0x3a, 0xc3, 0x18, 0x35, 0x63, 0xe7, 0xce, 0xce, 0x4b, 0x26, 0xbf, 0xfc, 0xf0,
+	0xa3, 0x79, 0xa8, 0xff, 0x00, 0xea, 0xc7, 0x65, 0x96, 0xb4, 0xab, 0x2d, 0x0b, 0x36, 0x85, 0xb6,
+	0x7f, 0x90, 0x86, 0x5c, 0x7b, 0x66, 0x1d, 0xc6, 0x97, 0xe9, 0x8e, 0xdd, 0x1c, 0xa5, 0x6c, 0x75,
+	0xcd, 0x6a, 0x1b, 0xb2, 0xd6, 0x7c, 0x6e, 0xf3, 0x89, 0xef, 0x31, 0x64, 0x63, 0x3e, 0xb7, 0x09,
+	0x45, 0xe0, 0x35, 0xba, 0x89, 0x6f, 0xbf, 0x70, 0x56, 0x5c, 0xbb, 0x63, 0xd8, 0x16, 0xe5, 0x12,
+	0x8e, 0xd2, 0xaf, 0x41, 0x9e, 0x6e, 0x73, 0xb0, 0x20, 0x5b, 0xdc, 0x8a, 0xe7, 0xb4, 0xfa, 0x10,
+	0xb2, 0x98, 0xf7, 0x4a, 0x2f, 0xdf, 0xe0, 0xbb, 0xd0, 0x29, 0x7e, 0x6b, 0x26, 0x1e, 0xf2, 0x33,
+	0xa7, 0xdc, 0xe0, 0x22, 0x90, 0x67, 0x5a, 0xac, 0xcc, 0xf7, 0x33, 0x80, 0xd8, 0x6b, 0x8e, 0x97,
+	0x58, 0xf1, 0x2e, 0x05, 0x67, 0x5c, 0x8b, 0x2f, 0x07, 0x35, 0xfa, 0xfd, 0x26, 0xbb, 0x39, 0xdd,
+	0xea, 0x35, 0x9f, 0x98, 0x38, 0x36, 0xff, 0x61, 0x0e, 0xf2, 0xec, 0x12, 0x97, 0xfe, 0x21, 0x7f,
+	0x28, 0x27, 0x43, 0x8d, 0x7e, 0x71, 0xf9, 0x92, 0x97, 0xfa, 0x46, 0x0e, 0xee, 0x2b, 0xca, 0xd0,
+	0x84, 0x5e, 0x98, 0x62, 0x7a, 0x56, 0x25, 0x95, 0x5e, 0x64, 0xba, 0x0a, 0x25, 0x5c, 0xa1, 0x1d,
+	0x2b, 0x4f, 0x49, 0xd0, 0x25, 0x5b, 0xca, 0xbc, 0x05, 0x39, 0x77, 0x26, 0xe2, 0xc9, 0xf2, 0xbd,
+	0xcd, 0xa5, 0xea, 0x20, 0x8c, 0xab, 0x7f, 0x22, 0xc3, 0xcb, 0xdc, 0x72, 0x94, 0xcc, 0xf5, 0x5a,
+	0xba, 0x47, 0xf6, 0x6b, 0x59, 0x19, 0xb2, 0xdc, 0x49, 0x2c, 0x82, 0x5e, 0x5b, 0x23, 0xa9, 0xba,
+	0x53, 0x1b, 0xaa, 0xac, 0xfa, 0xc7, 0x89, 0x1b, 0x6f, 0x37, 0xd7, 0x89, 0xb2, 0xda, 0x62, 0x34,
+	0x52, 0x99, 0x28, 0x29, 0x7c, 0xb9, 0x26, 0xb4, 0xbc, 0xc9, 0x73, 0xff, 0xf5, 0x58, 0x3e, 0xdc,
+	0x94, 0x58, 0x8f, 0x4c, 0xe6, 0x34, 0x60, 0x58, 0x34, 0x0d, 0x29, 0x87, 0x71, 0xa2, 0xfe, 0x0a,
+	0x2a, 0xea, 0x57, 0xb0, 0x2f, 0x9a, 0x04, 0x78, 0xc7, 0x86, 0x5f, 0x15, 0xe4, 0xa9, 0x95, 0xef,
+	0x04, 0x3d, 0x84, 0x0d, 0xc6, 0x1d, 0xfb, 0x73, 0xb6, 0xff, 0x9b, 0x59, 0xf6, 0x9a, 0xb8, 0x13,
+	0x25, 0x55, 0x86, 0xed, 0x31, 0x68, 0xfd, 0x27, 0x29, 0x28, 0x2b, 0x5a, 0xe9, 0x3f, 0x48, 0x58,
+	0x73, 0xfb, 0x0c, 0x05, 0x51, 0x2d, 0x1b, 0xbf, 0x28, 0x92, 0x16, 0x2f, 0x8a, 0x18, 0x1f, 0xae,
+	0x5a, 0x50, 0x2b, 0x42, 0x76, 0x60, 0xee, 0xef, 0x32, 0x3f, 0xed, 0x37, 0x08, 0x06, 0x96, 0x69,
+	0xe3, 0xcb, 0x55, 0xd0, 0x73, 0x50, 0x65, 0x8e, 0x3c, 0x7e, 0xda, 0xdb, 0x1f, 0x75, 0x4c, 0xb6,
+	0x04, 0x37, 0x68, 0x74, 0x5b, 0x8f, 0x7a, 0x5f, 0x8f, 0xe9, 0x55, 0xb8, 0xb4, 0x71, 0x29, 0x7e,
+	0x8d, 0x89, 0x3c, 0xd3, 0x52, 0xf4, 0xb7, 0xa7, 0xa5, 0x8d, 0x7f, 0x95, 0x81, 0x72, 0xd7, 0x89,
+	0xe4, 0xdb, 0x4b, 0x8f, 0xa0, 0xe2, 0xce, 0xc7, 0xfc, 0x4e, 0xbe, 0xdc, 0x24, 0x78, 0x27, 0x2e,
+	0xa6, 0x02, 0xde, 0x69, 0xf7, 0xc5, 0x2d, 0xfe, 0xb2, 0x3b, 0x6f, 0x08, 0x19, 0x59, 0x07, 0x79,
+	0xe5, 0x99, 0x86, 0x4b, 0x90, 0xa7, 0xab, 0xaa, 0xec, 0xb8, 0x49, 0x89, 0xf0, 0xd4, 0xd9, 0xcf,
+	0x95, 0xe8, 0xbb, 0x50, 0xc5, 0x3b, 0xfe, 0xf4, 0x98, 0xa6, 0xeb, 0x1d, 0x8a, 0x79, 0xf6, 0xbb,
+	0xab, 0x55, 0xc3, 0xb3, 0xe5, 0x1d, 0x86, 0x24, 0x95, 0x79, 0x9c, 0x08, 0xeb, 0x07, 0x50, 0x92,
+	0x7a, 0xeb, 0x0f, 0xa0, 0x48, 0x5f, 0x96, 0xb3, 0xfd, 0xe9, 0xf1, 0x4d, 0x82, 0x44, 0x7e, 0x1c,
+	0x45, 0x24, 0x9e, 0x6e, 0x0a, 0x4a, 0x53, 0x89, 0xcb, 0x98, 0xd2, 0x0e, 0xf5, 0x19, 0x94, 0x15,
+	0x25, 0xe2, 0x5e, 0x20, 0x7e, 0x10, 0x86, 0xf5, 0x02, 0x7e, 0x10, 0x2d, 0xf5, 0x24, 0x88, 0x60,
+	0xcf, 0x34, 0x28, 0x3d, 0x09, 0xc2, 0xea, 0x8a, 0xb6, 0xec, 0xb1, 0x14, 0x99, 0x36, 0x6e, 0x40,
+	0x51, 0xe8, 0x88, 0xce, 0xd3, 0xee, 0xbf, 0xfc, 0x8c, 0xbd, 0x25, 0xd3, 0xee, 0xbf, 0xfc, 0x5c,
+	0x4b, 0x1b, 0xff, 0x3c, 0x07, 0x1b, 0xf1, 0x43, 0x4c, 0xb4, 0xae, 0xf7, 0x96, 0x5e, 0x95, 0xc2,
+	0xe1, 0x70, 0x43, 0x6d, 0x9b, 0x49, 0xfc, 0xda, 0x67, 0xa5, 0x8c, 0x5f, 0xce, 0x25, 0x9e, 0x85,
+	0x5a, 0x5a, 0x19, 0xce, 0x35, 0x1f, 0xe3, 0xdf, 0xdf, 0x2d, 0xe8, 0xe7, 0xa0, 0xd2, 0x6a, 0x34,
+	0xc7, 0xbd, 0xa7, 0x26, 0x21, 0xed, 0x96, 0xa9, 0xfd, 0x5e, 0x41, 0xbf, 0x00, 0x9b, 0x48, 0x22,
+	0x66, 0xa3, 0x35, 0x1e, 0x98, 0x0d, 0xd2, 0x7c, 0xac, 0xfd, 0xeb, 0x82, 0x5e, 0x86, 0xfc, 0x6e,
+	0xef, 0x59, 0xd7, 0x24, 0xda, 0xbf, 0x61, 0x89, 0x81, 0x39, 0x6c, 0xb7, 0xb4, 0x7f, 0x5b, 0xd0,
+	0x4b, 0x90, 0xc5, 0x17, 0xa0, 0xb4, 0x7f, 0x47, 0xe9, 0x03, 0x73, 0xb8, 0xd7, 0x6e, 0x69, 0xbf,
+	0x2f, 0x12, 0xa3, 0x76, 0x4b, 0xfb, 0xf7, 0x05, 0xbd, 0x02, 0x85, 0x81, 0x39, 0xec, 0x37, 0x1b,
+	0x7d, 0xed, 0x3f, 0xd0, 0x4f, 0xec, 0xb7, 0xbb, 0xa3, 0xaf, 0xc7, 0xed, 0x4e, 0x67, 0x34, 0xc4,
+	0x87, 0xa5, 0xb4, 0x3f, 0x28, 0xe8, 0x17, 0x41, 0xeb, 0x9a, 0xc3, 0xf1, 0xa3, 0x76, 0x17, 0x3f,
+	0x4c, 0x9e, 0xb6, 0x9b, 0xa6, 0xf6, 0x1f, 0x0b, 0xba, 0x0e, 0x55, 0x4a, 0x26, 0xbd, 0x46, 0xab,
+	0xd9, 0x18, 0x0c, 0xb5, 0xff, 0x54, 0xd0, 0x37, 0xa0, 0x84, 0xb4, 0x46, 0xab, 0xd3, 0xee, 0x6a,
+	0xff, 0x99, 0x66, 0x8f, 0x69, 0xd2, 0x78, 0xa6, 0xfd, 0x97, 0x82, 0x5e, 0x85, 0x62, 0xbb, 0xdf,
+	0x1c, 0xef, 0xf7, 0x9a, 0x4f, 0xb4, 0xff, 0x4a, 0xc1, 0x98, 0x64, 0xda, 0xff, 0x61, 0x41, 0xdf,
+	0x04, 0x18, 0x7c, 0x33, 0x18, 0x77, 0x7a, 0xad, 0xd1, 0xbe, 0xa9, 0xfd, 0x37, 0x0a, 0x40, 0x02,
+	0x69, 0x3c, 0x6b, 0xf7, 0xb4, 0xff, 0x2e, 0x01, 0xcd, 0xc7, 0xa4, 0xd7, 0x1b, 0x6a, 0x7f, 0x24,
+	0x09, 0xfd, 0x21, 0x69, 0x34, 0x4d, 0xed, 0x8f, 0xa5, 0x44, 0xbf, 0xd1, 0x6c, 0x0e, 0xb5, 0xff,
+	0x21, 0xd3, 0x4c, 0x9f, 0xff, 0x49, 0x35, 0xc0, 0xf4, 0x23, 0x94, 0xff, 0x5f, 0x32, 0xd9, 0xc5,
+	0x12, 0xfd, 0x09, 0x35, 0x3a, 0xfd, 0x1e, 0x9f, 0x2d, 0x68, 0x7f, 0xa6, 0x28, 0x10, 0x38, 0x7f,
+	0xd2, 0x7e, 0xa9, 0xa8, 0x9f, 0x87, 0x0d, 0x9a, 0x1c, 0x7e, 0x83, 0x13, 0xb7, 0xdd, 0xf6, 0x9e,
+	0xf6, 0x67, 0x8b, 0x58, 0x6f, 0x9d, 0x27, 0xdd, 0x5e, 0x4b, 0xfb, 0x65, 0xfa, 0x7f, 0xdf, 0x6c,
+	0x0c, 0x4c, 0xed, 0xc7, 0x45, 0x5d, 0x83, 0x72, 0x63, 0xd4, 0x6a, 0x0f, 0xc7, 0xcf, 0x48, 0x7b,
+	0x68, 0x6a, 0xbf, 0x52, 0x44, 0x93, 0x31, 0x0a, 0xce, 0xfa, 0x48, 0x6f, 0x5f, 0xfb, 0x73, 0x45,
+	0x5e, 0x03, 0xbb, 0x58, 0x03, 0x7f, 0xbe, 0x88, 0x2a, 0x74, 0xd4, 0x7a, 0xff, 0x0b, 0x45, 0x2c,
+	0x03, 0x92, 0x58, 0x19, 0xfe, 0x62, 0x91, 0xd6, 0xdf, 0x37, 0x83, 0xfd, 0xde, 0x9e, 0xf6, 0x93,
+	0x22, 0x5a, 0xe0, 0x59, 0xe3, 0x89, 0x39, 0xc6, 0x17, 0x19, 0x3a, 0xda, 0x5f, 0xa2, 0x9f, 0x78,
+	0x84, 0x06, 0x1e, 0x0f, 0x46, 0x83, 0xbe, 0xd9, 0x6d, 0x69, 0xbf, 0x4a, 0x41, 0xec, 0xb3, 0xe8,
+	0x3b, 0xda, 0xaf, 0x15, 0x8d, 0x2e, 0x94, 0xf6, 0x5d, 0x6f, 0xf1, 0x9a, 0xfa, 0x76, 0x03, 0x36,
+	0xa5, 0x8b, 0xbe, 0x11, 0xc7, 0x08, 0x97, 0xb6, 0x12, 0x93, 0xee, 0x4d, 0x36, 0xec, 0x44, 0xda,
+	0xf8, 0xed, 0x0c, 0x00, 0xa1, 0xd1, 0x20, 0xcd, 0xf1, 0x3e, 0x14, 0x82, 0x44, 0xdc, 0xa8, 0xde,
+	0xc1, 0x97, 0x30, 0xfe, 0x97, 0x08, 0x6c, 0xfd, 0x4f, 0xd2, 0x90, 0x67, 0x34, 0xfd, 0xb3, 0xc4,
+	0xd0, 0xb1, 0x75, 0x82, 0xf8, 0xd2, 0x90, 0x71, 0x64, 0x05, 0x13, 0x7e, 0xd5, 0x98, 0xfe, 0x47,
+	0x1a, 0x1e, 0xbb, 0xe7, 0xb1, 0x24, 0xfd, 0x6f, 0xfc, 0x7a, 0x7a, 0xcd, 0x63, 0x31, 0x64, 0xbf,
+	0x33, 0x1c, 0x37, 0xf0, 0x39, 0x8b, 0x2a, 0x94, 0x68, 0xa2, 0xd9, 0x23, 0xb8, 0x13, 0x53, 0x81,
+	0x22, 0x4b, 0xf6, 0x47, 0x5a, 0x46, 0x32, 0x5b, 0x8d, 0x61, 0x43, 0xcb, 0xe2, 0xcb, 0x68, 0x34,
+	0xb9, 0x3b, 0x68, 0xff, 0x3c, 0x7f, 0x29, 0x8d, 0xa6, 0xb1, 0x1a, 0x70, 0x0a, 0xab, 0x41, 0x85,
+	0xa6, 0x3b, 0x66, 0x87, 0xba, 0x3e, 0x3a, 0x5a, 0x95, 0x51, 0x06, 0x7b, 0x3f, 0x1a, 0x99, 0x23,
+	0x53, 0x2b, 0xca, 0x3c, 0xa9, 0x2f, 0x96, 0xf4, 0x4d, 0x28, 0xb3, 0x64, 0x6f, 0xb7, 0xbd, 0x6f,
+	0x6a, 0x20, 0x33, 0xed, 0xf6, 0x49, 0xaf, 0xa9, 0x95, 0xa5, 0x46, 0x64, 0x30, 0xd0, 0x2a, 0x12,
+	0x4e, 0x86, 0x7d, 0xd2, 0xee, 0x69, 0x55, 0x85, 0x40, 0x5d, 0x77, 0x83, 0xce, 0xcb, 0x91, 0x30,
+	0x68, 0xef, 0xa1, 0x5b, 0xe0, 0xd3, 0x82, 0x9b, 0x32, 0xd3, 0xc1, 0xb0, 0xd1, 0x7c, 0xa2, 0x69,
+	0xc6, 0x8f, 0x53, 0x50, 0x18, 0x0e, 0xbf, 0xa1, 0x95, 0xf8, 0x43, 0x28, 0xbf, 0x72, 0xbd, 0x89,
+	0xff, 0x6a, 0x1c, 0xba, 0xbf, 0x28, 0xde, 0x03, 0x50, 0x42, 0x22, 0x8e, 0xdb, 0x79, 0x46, 0x41,
+	0x03, 0xf7, 0x17, 0x1d, 0x02, 0xaf, 0xe4, 0xff, 0xfa, 0x03, 0x80, 0x98, 0xc3, 0x2e, 0xa1, 0xbf,
+	0x0a, 0xc5, 0xf3, 0x5e, 0xf8, 0x1f, 0x97, 0xbc, 0x6c, 0x0c, 0x04, 0xbc, 0x90, 0x77, 0xe1, 0x22,
+	0x69, 0xfc, 0x9d, 0x22, 0x54, 0x13, 0x2b, 0x9a, 0x4a, 0x60, 0x96, 0x4e, 0x06, 0x66, 0x09, 0x98,
+	0xea, 0x0b, 0xb7, 0xe3, 0x9d, 0x3a, 0x76, 0x82, 0xe1, 0xf8, 0x23, 0x04, 0x02, 0xb0, 0x74, 0x50,
+	0x20, 0xf9, 0x64, 0xcf, 0x83, 0xa5, 0x59, 0x80, 0xb1, 0xee, 0xdb, 0x2c, 0xe6, 0x62, 0x0f, 0x3c,
+	0x30, 0x09, 0xfd, 0x0b, 0xc8, 0x51, 0x30, 0x8f, 0x44, 0xdf, 0x5d, 0x27, 0xda, 0x41, 0x32, 0xdb,
+	0x36, 0xa0, 0x08, 0xfd, 0x01, 0x3d, 0xc4, 0xce, 0x16, 0xfb, 0xe9, 0xb6, 0x6e, 0x61, 0xf9, 0x69,
+	0x17, 0x65, 0xc0, 0xa5, 0xc7, 0xd6, 0x45, 0x02, 0xa7, 0x61, 0x30, 0xc5, 0xb6, 0xcd, 0x5a, 0x72,
+	0x71, 0xf9, 0x51, 0x0f, 0xd9, 0xee, 0x49, 0x69, 0x2a, 0xfe, 0xe2, 0x32, 0x27, 0x6b, 0x84, 0x4c,
+	0xa8, 0xb4, 0x1c, 0xf3, 0xc5, 0xad, 0x8e, 0x40, 0x30, 0x15, 0xff, 0x71, 0x2d, 0x35, 0x12, 0x5d,
+	0x06, 0x2c, 0xaf, 0xa5, 0x72, 0xff, 0x20, 0x85, 0x88, 0x75, 0x12, 0xf5, 0x5f, 0xcf, 0x02, 0xc4,
+	0x46, 0xc2, 0x59, 0x3a, 0x0b, 0xe7, 0xf9, 0x33, 0x33, 0x34, 0xa1, 0xff, 0x1c, 0x14, 0x78, 0x69,
+	0xf8, 0xfb, 0x9b, 0xb7, 0x4f, 0xb7, 0xb7, 0x30, 0xc6, 0x83, 0xec, 0xe3, 0xde, 0x60, 0x48, 0x44,
+	0x06, 0xfa, 0x60, 0x39, 0x0c, 0x62, 0xc7, 0x77, 0x77, 0xce, 0x90, 0xe3, 0xda, 0x98, 0x08, 0x1f,
+	0xbd, 0x9c, 0x07, 0xee, 0x4b, 0x77, 0xea, 0x1c, 0xca, 0x99, 0x9e, 0x78, 0xf4, 0x32, 0x66, 0xe0,
+	0x61, 0x10, 0xb9, 0x72, 0xb0, 0xe2, 0xb1, 0xa4, 0x78, 0x81, 0x41, 0x81, 0xe1, 0x11, 0xa4, 0x03,
+	0x3f, 0xc0, 0x07, 0xb3, 0x16, 0xd3, 0xe9, 0x98, 0x59, 0x87, 0xbe, 0x02, 0x47, 0x36, 0x28, 0xbd,
+	0xbf, 0x98, 0x4e, 0xd9, 0x7c, 0xf7, 0x03, 0xa8, 0x32, 0xe7, 0x1d, 0xf3, 0xd0, 0xbe, 0x20, 0x5f,
+	0xf4, 0xaa, 0x30, 0x46, 0x8b, 0xd2, 0xff, 0x5f, 0x87, 0x56, 0x9f, 0x42, 0x81, 0x57, 0x06, 0x7d,
+	0x9b, 0xaf, 0x37, 0xe0, 0x0f, 0x6f, 0x3d, 0x22, 0xed, 0xd6, 0x9e, 0xc9, 0x5e, 0x07, 0xea, 0xf6,
+	0xba, 0xb8, 0x9b, 0x5d, 0x84, 0xec, 0x68, 0x60, 0x12, 0x2d, 0x5b, 0xbf, 0x07, 0x25, 0xd9, 0x02,
+	0xe2, 0x59, 0x5e, 0xea, 0xa4, 0x59, 0x9e, 0x71, 0x3d, 0x7e, 0x8e, 0x88, 0x4f, 0x4d, 0xd9, 0x1b,
+	0x16, 0xe6, 0xa0, 0x37, 0xd0, 0xd2, 0xc6, 0xbf, 0x48, 0xc1, 0xe6, 0xd2, 0x4a, 0xf7, 0x8a, 0xf3,
+	0xe7, 0xa9, 0x33, 0x9e, 0x3f, 0x3f, 0xd6, 0x1c, 0x53, 0x67, 0x6f, 0x8e, 0xf7, 0xa1, 0x6c, 0xd3,
+	0x00, 0x9e, 0x35, 0x93, 0xe3, 0x93, 0xf0, 0x43, 0x79, 0x4c, 0x02, 0x6c, 0xf9, 0x1f, 0x4f, 0xbf,
+	0xc9, 0x8d, 0x87, 0x39, 0x7f, 0xba, 0xb5, 0x1a, 0x9f, 0x00, 0xea, 0xbb, 0x13, 0xe3, 0x00, 0x20,
+	0x16, 0xd6, 0x3f, 0xa3, 0x0d, 0x67, 0x6c, 0x4f, 0xc5, 0xbd, 0xc9, 0xab, 0xab, 0xbe, 0x81, 0x8a,
+	0x36, 0x71, 0xa6, 0xe0, 0xd1, 0xdf, 0xba, 0x01, 0x79, 0x46, 0xa1, 0xbd, 0xf1, 0xd4, 0x0a, 0x43,
+	0x7e, 0x40, 0xac, 0x4a, 0x44, 0xd2, 0xb8, 0x8b, 0x47, 0x4d, 0xe8, 0xbc, 0xe2, 0x03, 0x39, 0x03,
+	0x61, 0x06, 0xd8, 0x5c, 0x9a, 0x81, 0xc8, 0x83, 0xed, 0x9f, 0x40, 0x8e, 0x12, 0x4e, 0x5e, 0x9c,
+	0x8b, 0x9f, 0x30, 0x34, 0xfe, 0x56, 0x0a, 0xb2, 0xd4, 0xb9, 0x2e, 0x41, 0xde, 0x5b, 0xcc, 0x9e,
+	0xf3, 0x27, 0x6d, 0xab, 0x84, 0xa7, 0x94, 0xc9, 0xaa, 0xfa, 0x9e, 0xdd, 0x5a, 0x47, 0xd4, 0x1f,
+	0x01, 0xbc, 0x74, 0x43, 0x97, 0x9f, 0x28, 0xc8, 0xd2, 0xae, 0xc4, 0x58, 0xb3, 0xcd, 0xb6, 0xf3,
+	0x54, 0x22, 0x89, 0x22, 0xa5, 0x4c, 0xb8, 0x72, 0xa7, 0x1c, 0xe4, 0xff, 0x18, 0x72, 0xec, 0x86,
+	0xee, 0x4d, 0xc8, 0x61, 0xc3, 0x11, 0x06, 0xda, 0x50, 0x5a, 0xbc, 0x1f, 0x44, 0x84, 0x31, 0x8d,
+	0xbf, 0x97, 0x86, 0x6a, 0x42, 0x83, 0x25, 0x75, 0xd9, 0x28, 0xf7, 0xb6, 0xea, 0xae, 0x32, 0xd1,
+	0x56, 0xf2, 0x15, 0x4a, 0x66, 0xa5, 0xa5, 0xe7, 0x26, 0x8b, 0xe2, 0x09, 0x22, 0x31, 0xf6, 0x89,
+	0xb4, 0xfa, 0x5c, 0x5d, 0x2e, 0xf9, 0x5c, 0xdd, 0x2d, 0x51, 0xce, 0xfc, 0x72, 0x2b, 0xa5, 0x76,
+	0xe0, 0x05, 0x55, 0x2c, 0x58, 0x38, 0xc5, 0x82, 0x9f, 0x03, 0xc4, 0xc5, 0xc2, 0x60, 0x48, 0x6e,
+	0x10, 0xf0, 0x37, 0x3f, 0xf7, 0x47, 0x74, 0x0f, 0x89, 0x3e, 0x8e, 0x6c, 0x7e, 0x3d, 0x34, 0x49,
+	0xb7, 0xb1, 0x4f, 0xa7, 0xf9, 0xf0, 0xcc, 0x71, 0x0f, 0x8f, 0x22, 0xf1, 0x62, 0xe9, 0x2b, 0x9a,
+	0xe2, 0x57, 0x27, 0x78, 0x4a, 0xbe, 0xab, 0x94, 0x8e, 0xdf, 0x55, 0x32, 0xfe, 0x49, 0x0a, 0xca,
+	0x4f, 0x59, 0x71, 0xa8, 0xac, 0x52, 0x58, 0xe6, 0xae, 0xb2, 0xb0, 0xf4, 0x82, 0x80, 0x3b, 0x9d,
+	0x8c, 0x27, 0x56, 0x24, 0xf2, 0x28, 0x51, 0x4a, 0x0b, 0x77, 0x3a, 0x25, 0x9b, 0x6e, 0x95, 0xb1,
+	0x3b, 0xb3, 0x8c, 0x8d, 0x1b, 0x62, 0x31, 0x9b, 0xee, 0xc3, 0x66, 0x15, 0x69, 0xbc, 0x84, 0xab,
+	0x5f, 0x86, 0xc2, 0xa1, 0x1b, 0x8d, 0xc3, 0x23, 0x8b, 0xdb, 0x38, 0x7f, 0xe8, 0x46, 0x83, 0x23,
+	0x0b, 0xe5, 0x90, 0xc1, 0x8e, 0xb1, 0xf2, 0x05, 0x82, 0xd2, 0xa1, 0x1b, 0x3d, 0xa2, 0x04, 0x21,
+	0x17, 0x59, 0x87, 0xb5, 0x82, 0x94, 0x1b, 0x5a, 0x87, 0xc6, 0x1d, 0xc8, 0xee, 0x4e, 0xad, 0xc3,
+	0xd3, 0x96, 0xd3, 0x95, 0xc6, 0xf7, 0x9b, 0x29, 0xc8, 0x12, 0x7f, 0xcd, 0x0a, 0x7c, 0x6c, 0xd2,
+	0x74, 0xc2, 0xa4, 0xf7, 0x01, 0xe4, 0x31, 0x03, 0x31, 0xb2, 0xae, 0x39, 0x8f, 0xa0, 0x00, 0xdf,
+	0xfe, 0xfc, 0x8b, 0x71, 0x0f, 0xf2, 0x1d, 0x27, 0x0a, 0x5c, 0xfb, 0xf4, 0x12, 0x89, 0xd7, 0xf4,
+	0x8c, 0xbf, 0x9e, 0x82, 0x22, 0xde, 0x40, 0x95, 0x4f, 0xcb, 0xc6, 0x2b, 0x8e, 0xf4, 0x3f, 0x8a,
+	0x79, 0x53, 0xd7, 0x63, 0x41, 0x46, 0x8e, 0xb0, 0x04, 0x22, 0x69, 0xac, 0x2b, 0xe6, 0x0a, 0x18,
+	0xb9, 0x6e, 0x43, 0x6e, 0x46, 0x2b, 0x36, 0xbb, 0x76, 0x0f, 0x94, 0x01, 0x50, 0x9a, 0x2e, 0x87,
+	0xd2, 0xb7, 0x86, 0xf9, 0xba, 0xa7, 0x06, 0x99, 0x05, 0x7f, 0xff, 0xb0, 0x44, 0xf0, 0x2f, 0x52,
+	0x0e, 0xf9, 0x4e, 0x72, 0x89, 0xe0, 0xdf, 0xdb, 0x7f, 0x1a, 0xf2, 0x7c, 0x84, 0xba, 0x04, 0x7a,
+	0x8b, 0xb4, 0x9f, 0x9a, 0x64, 0xdc, 0xed, 0x0d, 0xc7, 0x62, 0x57, 0x29, 0xa5, 0xeb, 0xb0, 0xc1,
+	0xe9, 0x64, 0xd4, 0xe5, 0xaf, 0x82, 0xc7, 0xb4, 0xc6, 0xa3, 0x1e, 0xc5, 0x65, 0x14, 0xda, 0x60,
+	0xd8, 0xeb, 0xf7, 0xcd, 0x96, 0x96, 0xbd, 0xfd, 0xab, 0x69, 0x28, 0xc9, 0xcd, 0x79, 0x9c, 0x8a,
+	0xd0, 0x4d, 0xa0, 0xc1, 0xb0, 0xb1, 0x87, 0xf9, 0xe4, 0x71, 0x2a, 0x22, 0x28, 0x64, 0x88, 0xa4,
+	0xef, 0x49, 0x90, 0xf8, 0x58, 0x4a, 0x52, 0xf8, 0x8b, 0xd2, 0x5a, 0x51, 0x8a, 0xed, 0xb6, 0xbb,
+	0xed, 0xc1, 0x63, 0xba, 0x2f, 0xb8, 0x09, 0x65, 0x46, 0x62, 0x1b, 0x98, 0x19, 0x49, 0x40, 0x29,
+	0xd4, 0x05, 0xa7, 0x1b, 0x94, 0xc0, 0xb6, 0x05, 0x71, 0xfa, 0x5d, 0xa2, 0xe9, 0x7d, 0x8c, 0x13,
+	0x72, 0xf2, 0x2b, 0x2d, 0xc2, 0x94, 0x2f, 0xe1, 0xf3, 0xd4, 0x7c, 0xcf, 0x8a, 0x98, 0x8d, 0xe6,
+	0x63, 0xba, 0x20, 0x01, 0x52, 0x6c, 0x0f, 0x03, 0x89, 0x32, 0xee, 0x00, 0xca, 0xe4, 0xf8, 0xd1,
+	0x37, 0xe3, 0x5e, 0xdf, 0x24, 0x0d, 0xdc, 0x48, 0xae, 0xc8, 0x1c, 0xe5, 0x36, 0xdc, 0xa3, 0xeb,
+	0x70, 0xde, 0x0f, 0x0e, 0x77, 0x70, 0x07, 0xe1, 0xc8, 0x91, 0x75, 0xf9, 0x28, 0xcf, 0x1e, 0x31,
+	0xfa, 0xdf, 0x03, 0x00, 0x20, 0x58, 0x4f, 0x57, 0x43, 0x61, 0x00, 0x00,
 }
@@ -0,0 +1,15 @@
+package types
+
+// AutoscalePolicy scales instances of the app within the bounds to keep the
+// metric per instance around the target.
+type AutoscalePolicy struct {
+	AppId                    string
+	MinInstances             int32
+	MaxInstances             int32
+	Metric                   string  // cpu, mem, requests or prometheus
+	Target                   float64 // cpu or mem utilization in percentage, requests per second, or value of the query per instance
+	Query                    string  // prometheus query of the prometheus metric, values of the series returned summed up
+	IntervalSeconds          int64   // between evaluations, 30 by default
+	ScaleUpCooldownSeconds   int64   // since last scaling before scaling up again, 60 by default
+	ScaleDownCooldownSeconds int64   // since last scaling before scaling down, 300 by default
+}
@@ -0,0 +1,23 @@
+package types
+
+// Job runs tasks of the template to completion as a batch job, the template
+// is an app version with its app id and instances taken care of by the job.
+type Job struct {
+	ID                    string
+	Completions           int32 // tasks finished successfully for the job to succeed, 1 by default
+	Parallelism           int32 // tasks running at the same time, 1 by default
+	BackoffLimit          int32 // failed tasks tolerated before the job fails
+	ActiveDeadlineSeconds int64 // job fails if still running after that, 0 for no deadline
+	Template              *Version
+}
+
+// CronJob runs a job of its template on the schedule.
+type CronJob struct {
+	ID                      string
+	Schedule                string // standard cron schedule of 5 fields, e.g. "*/15 9-17 * * mon-fri"
+	TimeZone                string // location of the schedule, e.g. "Asia/Shanghai", local time by default
+	ConcurrencyPolicy       string // allow, forbid or replace the running job, allow by default
+	HistoryLimit            int32  // finished jobs kept, 3 by default
+	StartingDeadlineSeconds int64  // run missed after failover started if not later than that, 0 for no limit
+	JobTemplate             *Job   // id of the job ignored, each run gets its own
+}
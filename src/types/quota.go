@@ -0,0 +1,12 @@
+package types
+
+// Quota limits resources taken by apps running as the RunAs, limits of zero
+// are not enforced.
+type Quota struct {
+	RunAs     string
+	Cpus      float64
+	Mem       float64
+	Disk      float64 // persistent volumes included
+	Instances int32
+	Ips       int32 // ips of fixed mode apps
+}
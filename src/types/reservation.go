@@ -0,0 +1,11 @@
+package types
+
+// Reservation of agent resources dynamically reserved for a mesos role, or
+// unreserved back to the unreserved pool
+type Reservation struct {
+	AgentId string
+	Role    string
+	Cpus    float64
+	Mem     float64
+	Disk    float64
+}
@@ -0,0 +1,13 @@
+package types
+
+// ScalingSchedule scales the app to the instances each time the schedule
+// fires, bounds given are applied to the autoscaler of the app too.
+type ScalingSchedule struct {
+	ID           string
+	AppId        string
+	Schedule     string // standard cron schedule of 5 fields, e.g. "0 8 * * mon-fri"
+	TimeZone     string // location of the schedule, local time by default
+	Instances    int32
+	MinInstances int32 // optional, bounds of the autoscaler from then on
+	MaxInstances int32 // optional
+}
@@ -6,7 +6,8 @@ type Version struct {
 	ID                string
 	AppId             string
 	PerviousVersionID string
-	Command           string
+	Command           string   // run by shell, overrides entrypoint of MESOS container image, ignored by DOCKER container
+	Args              []string // argv of command-only app, or arguments to entrypoint of the image
 	Cpus              float64
 	Mem               float64
 	Disk              float64
@@ -15,6 +16,7 @@ type Version struct {
 	Container         *Container
 	Labels            map[string]string
 	HealthChecks      []*HealthCheck
+	ReadinessCheck    *ReadinessCheck
 	Env               map[string]string
 	KillPolicy        *KillPolicy
 	UpdatePolicy      *UpdatePolicy
@@ -22,12 +24,30 @@ type Version struct {
 	Uris              []string
 	Ip                []string
 	Mode              string
+	PlacementStrategy string
+	Priority          int32 // pending tasks of apps with higher priority get offers first
+
+	// restart policy, delay before relaunching a failed task is
+	// BackoffSeconds * BackoffFactor ^ restarts, capped by MaxLaunchDelaySeconds
+	BackoffSeconds        float64
+	BackoffFactor         float64
+	MaxLaunchDelaySeconds float64
+	MaxRestarts           int32 // 0 for unlimited
+
+	// unhealthy policy, task is killed and relaunched after reported unhealthy
+	// that many times in a row or for that long, 0 to disable either
+	MaxConsecutiveUnhealthy int32
+	MaxUnhealthySeconds     float64
 }
 
+// Container is either a docker container, or a docker image run by the mesos
+// containerizer if Type is MESOS, docker network and port mappings apply to
+// the former only.
 type Container struct {
-	Type    string
-	Docker  *Docker
-	Volumes []*Volume
+	Type       string
+	Docker     *Docker
+	Volumes    []*Volume
+	Entrypoint string // overrides entrypoint of the image
 }
 
 type Docker struct {
@@ -54,10 +74,22 @@ type Volume struct {
 	ContainerPath string
 	HostPath      string
 	Mode          string
+	Persistent    *PersistentVolume // local persistent volume instead of host path if set
+}
+
+// PersistentVolume is created on reserved disk of the agent the slot first
+// launched on, the slot is relaunched only there afterwards.
+type PersistentVolume struct {
+	Size float64 // MB
 }
 
+// KillPolicy of tasks, durations in milliseconds. task running is taken out
+// of the proxy and DNS first, and killed once the requests proxied to it
+// complete or after DrainDuration at most, then mesos waits Duration for it
+// to stop before killing it forcibly.
 type KillPolicy struct {
-	Duration int64
+	Duration      int64
+	DrainDuration int64
 }
 
 type UpdatePolicy struct {
@@ -65,6 +97,8 @@ type UpdatePolicy struct {
 	MaxRetries   int32
 	MaxFailovers int32
 	Action       string
+	Mode         string // manual or auto, manual by default
+	BatchSize    string // instances count or percentage e.g. 25% updated per batch in auto mode
 }
 
 type HealthCheck struct {
@@ -74,6 +108,7 @@ type HealthCheck struct {
 	AppID               string
 	Protocol            string
 	PortName            string
+	Port                int32 // port on the container ip for fixed mode app
 	Command             *Command
 	Path                string
 	ConsecutiveFailures uint32
@@ -82,6 +117,18 @@ type HealthCheck struct {
 	TimeoutSeconds      float64
 }
 
+// ReadinessCheck tells when a task is ready to serve, probed by swan itself.
+// task is added to service discovery and counted as updated by rolling
+// update only once ready.
+type ReadinessCheck struct {
+	Protocol        string // http or tcp
+	PortName        string
+	Port            int32  // port on the container ip for fixed mode app
+	Path            string // for http, ready if responded with 2xx or 3xx
+	IntervalSeconds float64
+	TimeoutSeconds  float64
+}
+
 type Command struct {
 	Value string
 }
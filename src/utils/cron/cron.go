@@ -0,0 +1,203 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a standard cron schedule of 5 fields, minute, hour, day of
+// month, month and day of week, each of which is a bit set of the values
+// matching the field.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// day of month and day of week are OR'ed unless either of them is *
+	domStar, dowStar bool
+}
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minutes = bounds{0, 59, nil}
+	hours   = bounds{0, 23, nil}
+	doms    = bounds{1, 31, nil}
+	months  = bounds{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dows = bounds{0, 7, map[string]uint{ // both 0 and 7 are sunday
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Parse parses a schedule like "*/15 9-17 * * mon-fri"
+func Parse(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.New(fmt.Sprintf("invalid cron schedule %s, expected 5 fields", spec))
+	}
+
+	schedule := &Schedule{
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+
+	var err error
+	for i, field := range []struct {
+		bits   *uint64
+		bounds bounds
+	}{
+		{&schedule.minute, minutes},
+		{&schedule.hour, hours},
+		{&schedule.dom, doms},
+		{&schedule.month, months},
+		{&schedule.dow, dows},
+	} {
+		if *field.bits, err = parseField(fields[i], field.bounds); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid cron schedule %s: %s", spec, err))
+		}
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1
+	}
+
+	return schedule, nil
+}
+
+// comma separated list of ranges
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		rangeBits, err := parseRange(expr, b)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= rangeBits
+	}
+
+	return bits, nil
+}
+
+// one of *, value, low-high, with an optional /step
+func parseRange(expr string, b bounds) (uint64, error) {
+	rangeAndStep := strings.Split(expr, "/")
+	if len(rangeAndStep) > 2 {
+		return 0, errors.New(fmt.Sprintf("too many slashes in %s", expr))
+	}
+
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	if len(lowAndHigh) > 2 {
+		return 0, errors.New(fmt.Sprintf("too many hyphens in %s", expr))
+	}
+
+	var start, end uint
+	if lowAndHigh[0] == "*" {
+		if len(lowAndHigh) > 1 {
+			return 0, errors.New(fmt.Sprintf("invalid range %s", expr))
+		}
+
+		start, end = b.min, b.max
+	} else {
+		var err error
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+
+		end = start
+		if len(lowAndHigh) == 2 {
+			if end, err = parseValue(lowAndHigh[1], b); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	step := uint(1)
+	if len(rangeAndStep) == 2 {
+		n, err := strconv.Atoi(rangeAndStep[1])
+		if err != nil || n < 1 {
+			return 0, errors.New(fmt.Sprintf("invalid step in %s", expr))
+		}
+		step = uint(n)
+
+		if len(lowAndHigh) == 1 { // e.g. 5/15 is 5-59/15 for minutes
+			end = b.max
+		}
+	}
+
+	if start < b.min || end > b.max || start > end {
+		return 0, errors.New(fmt.Sprintf("%s out of range [%d, %d]", expr, b.min, b.max))
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (uint, error) {
+	if n, found := b.names[strings.ToLower(value)]; found {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, errors.New(fmt.Sprintf("invalid value %s", value))
+	}
+
+	return uint(n), nil
+}
+
+// Next returns the first time matching the schedule after t, in the
+// location of t. zero time if no match found within 5 years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
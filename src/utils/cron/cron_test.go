@@ -0,0 +1,53 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "*/15 9-17 * * mon-fri", "0 0 1,15 * *", "5/10 * * jan-mar 7", "0 12 * * SUN"} {
+		_, err := Parse(spec)
+		assert.Nil(t, err, spec)
+	}
+
+	for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"5-1 * * * *", "*/0 * * * *", "a * * * *", "1-2-3 * * * *", "*-5 * * * *"} {
+		_, err := Parse(spec)
+		assert.NotNil(t, err, spec)
+	}
+}
+
+func TestNext(t *testing.T) {
+	base := time.Date(2017, time.March, 1, 10, 7, 30, 0, time.UTC) // wednesday
+
+	for spec, expected := range map[string]time.Time{
+		"* * * * *":         time.Date(2017, time.March, 1, 10, 8, 0, 0, time.UTC),
+		"*/15 * * * *":      time.Date(2017, time.March, 1, 10, 15, 0, 0, time.UTC),
+		"0 9 * * *":         time.Date(2017, time.March, 2, 9, 0, 0, 0, time.UTC),
+		"30 8 * * sat,sun":  time.Date(2017, time.March, 4, 8, 30, 0, 0, time.UTC),
+		"0 0 1 * *":         time.Date(2017, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 feb *":      time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"0 0 15 * fri":      time.Date(2017, time.March, 3, 0, 0, 0, 0, time.UTC), // day of month or day of week
+		"0 0 * * 7":         time.Date(2017, time.March, 5, 0, 0, 0, 0, time.UTC),
+		"7 10 1 mar wed":    time.Date(2017, time.March, 8, 10, 7, 0, 0, time.UTC),
+		"59 23 31 dec *":    time.Date(2017, time.December, 31, 23, 59, 0, 0, time.UTC),
+		"0 0 31 apr,jun *":  time.Time{},
+		"*/20 10-11 * * *":  time.Date(2017, time.March, 1, 10, 20, 0, 0, time.UTC),
+		"0 10-11/2 * * mon": time.Date(2017, time.March, 6, 10, 0, 0, 0, time.UTC),
+	} {
+		schedule, err := Parse(spec)
+		assert.Nil(t, err, spec)
+		assert.Equal(t, expected, schedule.Next(base), spec)
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	schedule, _ := Parse("0 9 * * *")
+
+	next := schedule.Next(time.Date(2017, time.March, 1, 2, 0, 0, 0, time.UTC).In(loc))
+	assert.Equal(t, time.Date(2017, time.March, 2, 1, 0, 0, 0, time.UTC), next.UTC())
+}
@@ -0,0 +1,101 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Timer calls fire with the scheduled time each time the schedule matches
+// in its location, till stopped.
+type Timer struct {
+	schedule *Schedule
+	location *time.Location
+	fire     func(scheduled time.Time)
+
+	lock  sync.Mutex
+	timer *time.Timer
+}
+
+// NewTimer parses the schedule, in the time zone given or local time if
+// empty. the timer is started by Start.
+func NewTimer(spec, timeZone string, fire func(scheduled time.Time)) (*Timer, error) {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.Local
+	if len(timeZone) > 0 {
+		if location, err = time.LoadLocation(timeZone); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid time zone %s: %s", timeZone, err))
+		}
+	}
+
+	return &Timer{schedule: schedule, location: location, fire: fire}, nil
+}
+
+// Next returns the first scheduled time after t, zero time if none
+func (t *Timer) Next(after time.Time) time.Time {
+	return t.schedule.Next(after.In(t.location))
+}
+
+// LastMissed returns the most recent scheduled time after since and not
+// after now, zero time if none
+func (t *Timer) LastMissed(since, now time.Time) time.Time {
+	var missed time.Time
+	for next := t.Next(since); !next.IsZero() && !next.After(now); next = t.Next(next) {
+		missed = next
+	}
+
+	return missed
+}
+
+// Start fires at each scheduled time after the time given
+func (t *Timer) Start(after time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.stop()
+	t.scheduleNext(after)
+}
+
+// Stop stops firing, fire is not called once Stop returned
+func (t *Timer) Stop() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.stop()
+}
+
+// caller should hold the lock
+func (t *Timer) stop() {
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+}
+
+// caller should hold the lock
+func (t *Timer) scheduleNext(after time.Time) {
+	next := t.Next(after)
+	if next.IsZero() {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(next.Sub(time.Now()), func() {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+
+		// stopped or restarted while waiting for the lock
+		if t.timer != timer {
+			return
+		}
+
+		t.fire(next)
+		t.scheduleNext(next)
+	})
+	t.timer = timer
+}
@@ -0,0 +1,35 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimerLastMissed(t *testing.T) {
+	timer, err := NewTimer("0 * * * *", "UTC", nil)
+	assert.Nil(t, err)
+
+	since := time.Date(2017, time.March, 1, 9, 30, 0, 0, time.UTC)
+	now := time.Date(2017, time.March, 1, 12, 10, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC), timer.LastMissed(since, now))
+	assert.True(t, timer.LastMissed(time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC), now).IsZero())
+
+	_, err = NewTimer("0 * * * *", "Nowhere/Nothing", nil)
+	assert.NotNil(t, err)
+}
+
+func TestTimerFires(t *testing.T) {
+	fired := make(chan time.Time, 1)
+	timer, _ := NewTimer("* * * * *", "", func(scheduled time.Time) { fired <- scheduled })
+
+	// next minute is a minute after the one started at
+	start := time.Now().Add(-time.Minute)
+	timer.Start(start)
+	scheduled := <-fired
+	assert.Equal(t, timer.Next(start), scheduled)
+
+	timer.Stop()
+	assert.Nil(t, timer.timer)
+}